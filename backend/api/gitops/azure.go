@@ -19,9 +19,18 @@ func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvider
 		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
 	}
 
-	if strings.ToLower(pushEvent.Resource.Status) != "completed" {
+	var preview bool
+	switch strings.ToLower(pushEvent.Resource.Status) {
+	case "active":
+		// The test merge of the active pull request succeeds upon new pushes.
+		preview = true
+	case "completed":
+	default:
 		return nil, errors.Errorf("invalid pull request status: %v", pushEvent.Resource.Status)
 	}
+	if pushEvent.Resource.LastMergeSourceCommit == nil {
+		return nil, errors.Errorf("missing last merge source commit")
+	}
 	if strings.ToLower(pushEvent.Resource.MergeStatus) != "succeeded" {
 		return nil, errors.Errorf("invalid pull request merge status: %v", pushEvent.Resource.MergeStatus)
 	}
@@ -52,7 +61,10 @@ func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvider
 		url:         pushEvent.Resource.Links.Web.Href,
 		title:       pushEvent.Resource.Title,
 		description: pushEvent.Resource.Description,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		// The SQL review is reported to the head commit of the source branch instead of the merge commit.
+		commitSHA: pushEvent.Resource.LastMergeSourceCommit.CommitID,
+		preview:   preview,
+		changes:   getChangesByFileList(mrFiles, vcsConnector.Payload),
 	}
 
 	for _, file := range prInfo.changes {
@@ -63,7 +75,7 @@ func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvider
 			ctx,
 			vcsConnector.Payload.ExternalId,
 			file.path,
-			vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: pushEvent.Resource.LastMergeCommit.CommitID})
+			vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: pushEvent.Resource.LastMergeSourceCommit.CommitID})
 		if err != nil {
 			return nil, errors.Errorf("failed read file content, merge request %q, file %q, error %v", pushEvent.Resource.Links.Web, file.path, err)
 		}
@@ -13,11 +13,13 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
-func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
+func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, eventType string, body []byte) (*pullRequestInfo, error) {
 	var pushEvent bitbucket.PullRequestPushEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
 		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
 	}
+	// The created and updated events are delivered before merging.
+	preview := eventType != "pullrequest:fulfilled"
 
 	if pushEvent.PullRequest.Destination.Branch.Name != vcsConnector.Payload.Branch {
 		return nil, errors.Errorf("committed to branch %q, want branch %q", pushEvent.PullRequest.Destination.Branch.Name, vcsConnector.Payload.Branch)
@@ -33,7 +35,9 @@ func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProv
 		url:         pushEvent.PullRequest.Links.HTML.Href,
 		title:       pushEvent.PullRequest.Title,
 		description: pushEvent.PullRequest.Description,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitSHA:   pushEvent.PullRequest.Source.Commit.Hash,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
+		preview:     preview,
 	}
 
 	for _, file := range prInfo.changes {
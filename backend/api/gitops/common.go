@@ -10,6 +10,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -22,7 +23,10 @@ type pullRequestInfo struct {
 	title       string
 	description string
 	url         string
+	commitSHA   string
 	changes     []*fileChange
+	// preview is true if the pull request is not merged yet, the changes are reviewed and previewed on the pull request instead of creating an issue.
+	preview bool
 }
 
 type fileChange struct {
@@ -33,7 +37,7 @@ type fileChange struct {
 	content     string
 }
 
-func getChangesByFileList(files []*vcs.PullRequestFile, rootDir string) []*fileChange {
+func getChangesByFileList(files []*vcs.PullRequestFile, vcsConnector *storepb.VCSConnector) []*fileChange {
 	changes := []*fileChange{}
 	for _, v := range files {
 		if v.IsDeleted {
@@ -43,10 +47,10 @@ func getChangesByFileList(files []*vcs.PullRequestFile, rootDir string) []*fileC
 		if !strings.HasPrefix(prFilePath, "/") {
 			prFilePath = fmt.Sprintf("/%s", prFilePath)
 		}
-		if filepath.Dir(prFilePath) != rootDir {
+		if !isFileInDirectory(prFilePath, vcsConnector.BaseDirectory, vcsConnector.IncludeSubdirectories) {
 			continue
 		}
-		change, err := getFileChange(v.Path)
+		change, err := getFileChangeByLayout(v.Path, vcsConnector.FileLayout)
 		if err != nil {
 			slog.Error("failed to get file change info", slog.String("path", v.Path), log.BBError(err))
 		}
@@ -58,6 +62,18 @@ func getChangesByFileList(files []*vcs.PullRequestFile, rootDir string) []*fileC
 	return changes
 }
 
+// isFileInDirectory returns whether the file is directly in the directory, or in the subdirectories if includeSubdirectories is set.
+func isFileInDirectory(filePath, directory string, includeSubdirectories bool) bool {
+	fileDir := filepath.Dir(filePath)
+	if fileDir == directory {
+		return true
+	}
+	if !includeSubdirectories {
+		return false
+	}
+	return strings.HasPrefix(fileDir, strings.TrimSuffix(directory, "/")+"/")
+}
+
 func getFileChange(path string) (*fileChange, error) {
 	filename := filepath.Base(path)
 	if filepath.Ext(filename) != ".sql" {
@@ -0,0 +1,61 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// giteaPreviewActions are the pull request actions that trigger the SQL review and the SDL preview before merging.
+var giteaPreviewActions = []string{"opened", "synchronized", "reopened"}
+
+func getGiteaPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
+	var pullRequestEvent gitea.PullRequestEvent
+	if err := json.Unmarshal(body, &pullRequestEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal pull request event, error %v", err)
+	}
+	preview := false
+	switch {
+	case pullRequestEvent.Action == closeAction && pullRequestEvent.PullRequest.Merged:
+	case slices.Contains(giteaPreviewActions, pullRequestEvent.Action):
+		preview = true
+	default:
+		return nil, errors.Errorf("skip webhook event action, got %s, want %v or closed with merged", pullRequestEvent.Action, giteaPreviewActions)
+	}
+
+	if pullRequestEvent.PullRequest.Base.Ref != vcsConnector.Payload.Branch {
+		return nil, errors.Errorf("skip branch, got %q, want %q", pullRequestEvent.PullRequest.Base.Ref, vcsConnector.Payload.Branch)
+	}
+
+	mrFiles, err := vcs.Get(storepb.VCSType_GITEA, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken}).ListPullRequestFile(ctx, vcsConnector.Payload.ExternalId, fmt.Sprintf("%d", pullRequestEvent.Number))
+	if err != nil {
+		return nil, errors.Errorf("failed to list merge %q request files, error %v", pullRequestEvent.PullRequest.HTMLURL, err)
+	}
+
+	prInfo := &pullRequestInfo{
+		email:       pullRequestEvent.PullRequest.User.Email,
+		url:         pullRequestEvent.PullRequest.HTMLURL,
+		title:       pullRequestEvent.PullRequest.Title,
+		description: pullRequestEvent.PullRequest.Body,
+		commitSHA:   pullRequestEvent.PullRequest.Head.SHA,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
+		preview:     preview,
+	}
+
+	for _, file := range prInfo.changes {
+		content, err := vcs.Get(storepb.VCSType_GITEA, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken}).ReadFileContent(ctx, vcsConnector.Payload.ExternalId, file.path, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: pullRequestEvent.PullRequest.Head.SHA})
+		if err != nil {
+			return nil, errors.Errorf("failed read file content, merge request %q, file %q, error %v", pullRequestEvent.PullRequest.HTMLURL, file.path, err)
+		}
+		file.content = convertFileContentToUTF8String(content)
+	}
+	return prInfo, nil
+}
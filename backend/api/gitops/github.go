@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 
@@ -17,13 +18,21 @@ const (
 	closeAction = "closed"
 )
 
+// gitHubPreviewActions are the pull request actions that trigger the SQL review and the SDL preview before merging.
+var gitHubPreviewActions = []string{"opened", "synchronize", "reopened"}
+
 func getGitHubPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
 	var pushEvent github.PullRequestPushEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
 		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
 	}
-	if pushEvent.Action != closeAction || !pushEvent.PullRequest.Merged {
-		return nil, errors.Errorf("skip webhook event action, got %s, want closed with merged", pushEvent.Action)
+	preview := false
+	switch {
+	case pushEvent.Action == closeAction && pushEvent.PullRequest.Merged:
+	case slices.Contains(gitHubPreviewActions, pushEvent.Action):
+		preview = true
+	default:
+		return nil, errors.Errorf("skip webhook event action, got %s, want %v or closed with merged", pushEvent.Action, gitHubPreviewActions)
 	}
 
 	if pushEvent.PullRequest.Base.Ref != vcsConnector.Payload.Branch {
@@ -40,7 +49,9 @@ func getGitHubPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 		url:         pushEvent.PullRequest.HTMLURL,
 		title:       pushEvent.PullRequest.Title,
 		description: pushEvent.PullRequest.Body,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitSHA:   pushEvent.PullRequest.Head.SHA,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
+		preview:     preview,
 	}
 
 	for _, file := range prInfo.changes {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 
@@ -18,6 +19,9 @@ const (
 	mergeAction            = "merge"
 )
 
+// gitLabPreviewActions are the merge request actions that trigger the SQL review and the SDL preview before merging.
+var gitLabPreviewActions = []string{"open", "update", "reopen"}
+
 func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
 	var pushEvent gitlab.MergeRequestPushEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
@@ -26,8 +30,13 @@ func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 	if pushEvent.ObjectKind != mergeRequestObjectKind {
 		return nil, errors.Errorf("skip webhook event type, got %s, want push", pushEvent.ObjectKind)
 	}
-	if pushEvent.ObjectAttributes.Action != mergeAction {
-		return nil, errors.Errorf("skip webhook event action, got %s, want merge", pushEvent.ObjectAttributes.Action)
+	preview := false
+	switch {
+	case pushEvent.ObjectAttributes.Action == mergeAction:
+	case slices.Contains(gitLabPreviewActions, pushEvent.ObjectAttributes.Action):
+		preview = true
+	default:
+		return nil, errors.Errorf("skip webhook event action, got %s, want %v or merge", pushEvent.ObjectAttributes.Action, gitLabPreviewActions)
 	}
 
 	if pushEvent.ObjectAttributes.TargetBranch != vcsConnector.Payload.Branch {
@@ -44,7 +53,9 @@ func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 		url:         pushEvent.ObjectAttributes.URL,
 		title:       pushEvent.ObjectAttributes.Title,
 		description: pushEvent.ObjectAttributes.Description,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitSHA:   pushEvent.ObjectAttributes.LastCommit.ID,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
+		preview:     preview,
 	}
 
 	for _, file := range prInfo.changes {
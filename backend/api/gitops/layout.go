@@ -0,0 +1,335 @@
+package gitops
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	// flywayVersionedRE matches the Flyway versioned migration, e.g. V1.2__create_table.sql.
+	flywayVersionedRE = regexp.MustCompile(`^V([0-9]+(?:[._][0-9]+)*)__(.*)\.sql$`)
+	// flywayRepeatableRE matches the Flyway repeatable migration, e.g. R__create_view.sql.
+	flywayRepeatableRE = regexp.MustCompile(`^R__(.*)\.sql$`)
+	// liquibaseChangeSetRE matches the changeset line in the Liquibase formatted SQL changelog, e.g. --changeset author:id.
+	liquibaseChangeSetRE = regexp.MustCompile(`^--\s*changeset\s+([^:\s]+):(\S+)`)
+)
+
+const liquibaseFormattedSQLHeader = "--liquibase formatted sql"
+
+func getFileChangeByLayout(path string, layout storepb.VCSConnector_FileLayout) (*fileChange, error) {
+	switch layout {
+	case storepb.VCSConnector_FLYWAY:
+		return getFlywayFileChange(path)
+	case storepb.VCSConnector_LIQUIBASE:
+		return getLiquibaseFileChange(path)
+	case storepb.VCSConnector_SDL:
+		return getSDLFileChange(path)
+	default:
+		return getFileChange(path)
+	}
+}
+
+// getFlywayFileChange gets the file change of the Flyway versioned and repeatable migrations.
+// The undo and baseline migrations are ignored.
+func getFlywayFileChange(path string) (*fileChange, error) {
+	filename := filepath.Base(path)
+	if matches := flywayVersionedRE.FindStringSubmatch(filename); matches != nil {
+		return &fileChange{
+			path: path,
+			// Flyway treats the underscores in the version as dots.
+			version:     strings.ReplaceAll(matches[1], "_", "."),
+			changeType:  v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+			description: strings.ReplaceAll(matches[2], "_", " "),
+		}, nil
+	}
+	if matches := flywayRepeatableRE.FindStringSubmatch(filename); matches != nil {
+		// The repeatable migration has no version, it's applied every time its content changes.
+		return &fileChange{
+			path:        path,
+			changeType:  v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+			description: strings.ReplaceAll(matches[1], "_", " "),
+		}, nil
+	}
+	return nil, nil
+}
+
+// getLiquibaseFileChange gets the file change of the Liquibase changelog.
+// The changelog is converted to the changes of its changeSets once the content is read, see resolveLiquibaseChanges.
+func getLiquibaseFileChange(path string) (*fileChange, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xml", ".yaml", ".yml", ".json", ".sql":
+		return &fileChange{
+			path:       path,
+			changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// resolveFileChanges resolves the file changes with the file content by the layout.
+func resolveFileChanges(changes []*fileChange, layout storepb.VCSConnector_FileLayout) ([]*fileChange, error) {
+	switch layout {
+	case storepb.VCSConnector_FLYWAY:
+		sortFlywayChanges(changes)
+		return changes, nil
+	case storepb.VCSConnector_LIQUIBASE:
+		return resolveLiquibaseChanges(changes)
+	default:
+		return changes, nil
+	}
+}
+
+// sortFlywayChanges sorts the versioned migrations by the version as Flyway does,
+// and the repeatable migrations are applied after all versioned migrations.
+func sortFlywayChanges(changes []*fileChange) {
+	slices.SortStableFunc(changes, func(a, b *fileChange) int {
+		switch {
+		case a.version == "" && b.version == "":
+			return strings.Compare(a.description, b.description)
+		case a.version == "":
+			return 1
+		case b.version == "":
+			return -1
+		default:
+			return compareFlywayVersion(a.version, b.version)
+		}
+	})
+}
+
+// compareFlywayVersion compares the dot separated numeric versions, e.g. 1.2 < 1.10.
+func compareFlywayVersion(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// liquibaseChangeSet is the changeSet of the Liquibase changelog.
+type liquibaseChangeSet struct {
+	id         string
+	author     string
+	statements []string
+}
+
+// resolveLiquibaseChanges converts each changeSet in the Liquibase changelogs to a change.
+// The changeSet ID is used as the schema version.
+func resolveLiquibaseChanges(changes []*fileChange) ([]*fileChange, error) {
+	var results []*fileChange
+	for _, change := range changes {
+		changeSets, err := parseLiquibaseChangelog(change.path, change.content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse Liquibase changelog %q", change.path)
+		}
+		for _, changeSet := range changeSets {
+			results = append(results, &fileChange{
+				path:        change.path,
+				version:     changeSet.id,
+				changeType:  v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+				description: fmt.Sprintf("changeSet %s by %s", changeSet.id, changeSet.author),
+				content:     strings.Join(changeSet.statements, "\n"),
+			})
+		}
+	}
+	return results, nil
+}
+
+func parseLiquibaseChangelog(path, content string) ([]*liquibaseChangeSet, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xml":
+		return parseLiquibaseXMLChangelog(content)
+	case ".yaml", ".yml", ".json":
+		// JSON is a subset of YAML.
+		return parseLiquibaseYAMLChangelog(content)
+	case ".sql":
+		return parseLiquibaseFormattedSQLChangelog(content)
+	default:
+		return nil, errors.Errorf("unsupported changelog format %q", filepath.Ext(path))
+	}
+}
+
+// liquibaseIgnoredChanges are the changeSet elements that don't change the database.
+var liquibaseIgnoredChanges = map[string]bool{
+	"comment":       true,
+	"rollback":      true,
+	"preConditions": true,
+	"validCheckSum": true,
+}
+
+type liquibaseXMLChangelog struct {
+	ChangeSets []*liquibaseXMLChangeSet `xml:"changeSet"`
+}
+
+type liquibaseXMLChangeSet struct {
+	ID     string `xml:"id,attr"`
+	Author string `xml:"author,attr"`
+	SQL    []struct {
+		Text string `xml:",chardata"`
+	} `xml:"sql"`
+	Others []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+func parseLiquibaseXMLChangelog(content string) ([]*liquibaseChangeSet, error) {
+	var changelog liquibaseXMLChangelog
+	if err := xml.Unmarshal([]byte(content), &changelog); err != nil {
+		return nil, err
+	}
+	var changeSets []*liquibaseChangeSet
+	for _, xmlChangeSet := range changelog.ChangeSets {
+		for _, other := range xmlChangeSet.Others {
+			if !liquibaseIgnoredChanges[other.XMLName.Local] {
+				return nil, errors.Errorf("unsupported change %q in changeSet %q, only sql changes are supported", other.XMLName.Local, xmlChangeSet.ID)
+			}
+		}
+		changeSet := &liquibaseChangeSet{
+			id:     xmlChangeSet.ID,
+			author: xmlChangeSet.Author,
+		}
+		for _, sql := range xmlChangeSet.SQL {
+			changeSet.statements = append(changeSet.statements, strings.TrimSpace(sql.Text))
+		}
+		changeSets = append(changeSets, changeSet)
+	}
+	return changeSets, nil
+}
+
+type liquibaseYAMLChangelog struct {
+	DatabaseChangeLog []struct {
+		ChangeSet *struct {
+			ID      string                 `yaml:"id"`
+			Author  string                 `yaml:"author"`
+			Changes []map[string]yaml.Node `yaml:"changes"`
+		} `yaml:"changeSet"`
+	} `yaml:"databaseChangeLog"`
+}
+
+func parseLiquibaseYAMLChangelog(content string) ([]*liquibaseChangeSet, error) {
+	var changelog liquibaseYAMLChangelog
+	if err := yaml.Unmarshal([]byte(content), &changelog); err != nil {
+		return nil, err
+	}
+	var changeSets []*liquibaseChangeSet
+	for _, entry := range changelog.DatabaseChangeLog {
+		// The entries may also be include, includeAll, property and so on.
+		if entry.ChangeSet == nil {
+			continue
+		}
+		changeSet := &liquibaseChangeSet{
+			id:     entry.ChangeSet.ID,
+			author: entry.ChangeSet.Author,
+		}
+		for _, change := range entry.ChangeSet.Changes {
+			for changeType, node := range change {
+				if changeType != "sql" {
+					if liquibaseIgnoredChanges[changeType] {
+						continue
+					}
+					return nil, errors.Errorf("unsupported change %q in changeSet %q, only sql changes are supported", changeType, changeSet.id)
+				}
+				var sql struct {
+					SQL string `yaml:"sql"`
+				}
+				if err := node.Decode(&sql); err != nil {
+					return nil, errors.Wrapf(err, "failed to decode sql change in changeSet %q", changeSet.id)
+				}
+				changeSet.statements = append(changeSet.statements, strings.TrimSpace(sql.SQL))
+			}
+		}
+		changeSets = append(changeSets, changeSet)
+	}
+	return changeSets, nil
+}
+
+// parseLiquibaseFormattedSQLChangelog parses the Liquibase formatted SQL changelog.
+// The SQL files without the "--liquibase formatted sql" header are not changelogs and ignored.
+func parseLiquibaseFormattedSQLChangelog(content string) ([]*liquibaseChangeSet, error) {
+	var changeSets []*liquibaseChangeSet
+	var current *liquibaseChangeSet
+	var lines []string
+	flush := func() {
+		if current != nil {
+			if statement := strings.TrimSpace(strings.Join(lines, "\n")); statement != "" {
+				current.statements = append(current.statements, statement)
+			}
+			changeSets = append(changeSets, current)
+		}
+		lines = nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+	headerFound := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if !headerFound {
+			if trimmed == "" {
+				continue
+			}
+			if !strings.HasPrefix(strings.ToLower(trimmed), liquibaseFormattedSQLHeader) {
+				return nil, nil
+			}
+			headerFound = true
+			continue
+		}
+		if matches := liquibaseChangeSetRE.FindStringSubmatch(trimmed); matches != nil {
+			flush()
+			current = &liquibaseChangeSet{
+				id:     matches[2],
+				author: matches[1],
+			}
+			continue
+		}
+		if isLiquibaseFormattedSQLDirective(trimmed) {
+			continue
+		}
+		if current != nil {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return changeSets, nil
+}
+
+func isLiquibaseFormattedSQLDirective(line string) bool {
+	if !strings.HasPrefix(line, "--") {
+		return false
+	}
+	directive := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "--")))
+	for _, prefix := range []string{"rollback", "comment", "precondition", "validcheck", "ignorelines"} {
+		if strings.HasPrefix(directive, prefix) {
+			return true
+		}
+	}
+	return false
+}
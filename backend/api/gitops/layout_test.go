@@ -0,0 +1,208 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestGetFlywayFileChange(t *testing.T) {
+	tests := []struct {
+		path string
+		want *fileChange
+	}{
+		{
+			path: "db/migration/V1__create_table.sql",
+			want: &fileChange{path: "db/migration/V1__create_table.sql", version: "1", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "create table"},
+		},
+		{
+			path: "db/migration/V1_2_1__add_column.sql",
+			want: &fileChange{path: "db/migration/V1_2_1__add_column.sql", version: "1.2.1", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "add column"},
+		},
+		{
+			path: "db/migration/R__create_view.sql",
+			want: &fileChange{path: "db/migration/R__create_view.sql", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "create view"},
+		},
+		{
+			path: "db/migration/U1__create_table.sql",
+		},
+		{
+			path: "db/migration/B1__baseline.sql",
+		},
+		{
+			path: "db/migration/README.md",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := getFlywayFileChange(test.path)
+		a.NoError(err)
+		a.Equal(test.want, got, test.path)
+	}
+}
+
+func TestSortFlywayChanges(t *testing.T) {
+	a := require.New(t)
+	changes := []*fileChange{
+		{path: "R__b.sql", description: "b"},
+		{path: "V1.10__c.sql", version: "1.10"},
+		{path: "R__a.sql", description: "a"},
+		{path: "V1.2__b.sql", version: "1.2"},
+		{path: "V1__a.sql", version: "1"},
+	}
+	got, err := resolveFileChanges(changes, storepb.VCSConnector_FLYWAY)
+	a.NoError(err)
+	var paths []string
+	for _, change := range got {
+		paths = append(paths, change.path)
+	}
+	a.Equal([]string{"V1__a.sql", "V1.2__b.sql", "V1.10__c.sql", "R__a.sql", "R__b.sql"}, paths)
+}
+
+func TestResolveLiquibaseChanges(t *testing.T) {
+	tests := []struct {
+		path    string
+		content string
+		want    []*fileChange
+		wantErr bool
+	}{
+		{
+			path: "changelog.xml",
+			content: `<?xml version="1.0" encoding="UTF-8"?>
+<databaseChangeLog xmlns="http://www.liquibase.org/xml/ns/dbchangelog">
+  <changeSet id="1" author="alice">
+    <comment>create table</comment>
+    <sql>CREATE TABLE t(id INT);</sql>
+    <rollback>DROP TABLE t;</rollback>
+  </changeSet>
+  <changeSet id="2" author="bob">
+    <sql>ALTER TABLE t ADD COLUMN name TEXT;</sql>
+    <sql>CREATE INDEX idx_t_name ON t(name);</sql>
+  </changeSet>
+</databaseChangeLog>`,
+			want: []*fileChange{
+				{path: "changelog.xml", version: "1", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "changeSet 1 by alice", content: "CREATE TABLE t(id INT);"},
+				{path: "changelog.xml", version: "2", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "changeSet 2 by bob", content: "ALTER TABLE t ADD COLUMN name TEXT;\nCREATE INDEX idx_t_name ON t(name);"},
+			},
+		},
+		{
+			path: "changelog.xml",
+			content: `<databaseChangeLog>
+  <changeSet id="1" author="alice">
+    <createTable tableName="t"/>
+  </changeSet>
+</databaseChangeLog>`,
+			wantErr: true,
+		},
+		{
+			path: "changelog.yaml",
+			content: `databaseChangeLog:
+  - changeSet:
+      id: 1
+      author: alice
+      changes:
+        - sql:
+            sql: CREATE TABLE t(id INT);
+`,
+			want: []*fileChange{
+				{path: "changelog.yaml", version: "1", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "changeSet 1 by alice", content: "CREATE TABLE t(id INT);"},
+			},
+		},
+		{
+			path:    "changelog.json",
+			content: `{"databaseChangeLog": [{"changeSet": {"id": "1", "author": "alice", "changes": [{"sql": {"sql": "CREATE TABLE t(id INT);"}}]}}]}`,
+			want: []*fileChange{
+				{path: "changelog.json", version: "1", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "changeSet 1 by alice", content: "CREATE TABLE t(id INT);"},
+			},
+		},
+		{
+			path: "changelog.sql",
+			content: `--liquibase formatted sql
+
+--changeset alice:1
+--comment: create table
+CREATE TABLE t(id INT);
+--rollback DROP TABLE t;
+
+--changeset bob:2
+ALTER TABLE t ADD COLUMN name TEXT;
+`,
+			want: []*fileChange{
+				{path: "changelog.sql", version: "1", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "changeSet 1 by alice", content: "CREATE TABLE t(id INT);"},
+				{path: "changelog.sql", version: "2", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE, description: "changeSet 2 by bob", content: "ALTER TABLE t ADD COLUMN name TEXT;"},
+			},
+		},
+		{
+			path:    "plain.sql",
+			content: "CREATE TABLE t(id INT);",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := resolveFileChanges([]*fileChange{{path: test.path, content: test.content}}, storepb.VCSConnector_LIQUIBASE)
+		if test.wantErr {
+			a.Error(err, test.path)
+			continue
+		}
+		a.NoError(err, test.path)
+		a.Equal(test.want, got, test.path)
+	}
+}
+
+func TestIsFileInDirectory(t *testing.T) {
+	tests := []struct {
+		path                  string
+		directory             string
+		includeSubdirectories bool
+		want                  bool
+	}{
+		{path: "migrations/V1__a.sql", directory: "migrations", want: true},
+		{path: "migrations/v1/V1__a.sql", directory: "migrations", want: false},
+		{path: "migrations/v1/V1__a.sql", directory: "migrations", includeSubdirectories: true, want: true},
+		{path: "migrations2/V1__a.sql", directory: "migrations", includeSubdirectories: true, want: false},
+		{path: "other/V1__a.sql", directory: "migrations", includeSubdirectories: true, want: false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, isFileInDirectory(test.path, test.directory, test.includeSubdirectories), test.path)
+	}
+}
+
+func TestGetSDLFileChange(t *testing.T) {
+	a := require.New(t)
+	got, err := getFileChangeByLayout("schema/employee.sql", storepb.VCSConnector_SDL)
+	a.NoError(err)
+	a.Equal(&fileChange{path: "schema/employee.sql", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL, description: "employee"}, got)
+
+	got, err = getFileChangeByLayout("schema/README.md", storepb.VCSConnector_SDL)
+	a.NoError(err)
+	a.Nil(got)
+}
+
+func TestGetSDLFilePaths(t *testing.T) {
+	a := require.New(t)
+	files := []string{
+		"schema/tables/t2.sql",
+		"schema/README.md",
+		"schema/t1.sql",
+		"schema/tables/t1.sql",
+		"other/t3.sql",
+	}
+	a.Equal([]string{"schema/t1.sql"}, getSDLFilePaths(files, &storepb.VCSConnector{BaseDirectory: "/schema"}))
+	a.Equal([]string{"schema/t1.sql", "schema/tables/t1.sql", "schema/tables/t2.sql"}, getSDLFilePaths(files, &storepb.VCSConnector{BaseDirectory: "/schema", IncludeSubdirectories: true}))
+}
+
+func TestConcatenateSDLFiles(t *testing.T) {
+	a := require.New(t)
+	got := concatenateSDLFiles(
+		[]string{"schema/t1.sql", "schema/t2.sql", "schema/empty.sql"},
+		[]string{"CREATE TABLE t1 (id INT);\n", "\nCREATE TABLE t2 (id INT);", ""},
+	)
+	a.Equal("-- schema/t1.sql\nCREATE TABLE t1 (id INT);\n\n-- schema/t2.sql\nCREATE TABLE t2 (id INT);\n\n-- schema/empty.sql\n", got)
+}
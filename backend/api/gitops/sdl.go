@@ -0,0 +1,177 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	runnerutils "github.com/bytebase/bytebase/backend/runner/utils"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxSDLPreviewDatabases is the max number of databases previewed in the pull request comment.
+	maxSDLPreviewDatabases = 10
+)
+
+// getSDLFileChange gets the file change of the declarative schema file.
+// The files hold the desired full schema together, so there is no version in the file name.
+func getSDLFileChange(path string) (*fileChange, error) {
+	filename := filepath.Base(path)
+	if filepath.Ext(filename) != ".sql" {
+		return nil, nil
+	}
+	return &fileChange{
+		path:        path,
+		changeType:  v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL,
+		description: strings.TrimSuffix(filename, filepath.Ext(filename)),
+	}, nil
+}
+
+// getSDLDesiredSchemaChange gets the desired full schema of the databases at the commit.
+// The schema could be split into multiple declarative schema files, e.g. one file per table, so the changed files alone
+// are not the full schema. All declarative schema files under the base directory are concatenated in the order of the paths.
+func getSDLDesiredSchemaChange(ctx context.Context, provider vcs.Provider, vcsConnector *storepb.VCSConnector, commitSHA string) (*fileChange, error) {
+	refInfo := vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: commitSHA}
+	files, err := provider.ListDirectoryFile(ctx, vcsConnector.GetExternalId(), vcsConnector.GetBaseDirectory(), refInfo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list files in base directory %q", vcsConnector.GetBaseDirectory())
+	}
+	paths := getSDLFilePaths(files, vcsConnector)
+	if len(paths) == 0 {
+		return nil, errors.Errorf("no declarative schema file is found in base directory %q", vcsConnector.GetBaseDirectory())
+	}
+
+	var contents []string
+	for _, path := range paths {
+		content, err := provider.ReadFileContent(ctx, vcsConnector.GetExternalId(), path, refInfo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %q", path)
+		}
+		contents = append(contents, convertFileContentToUTF8String(content))
+	}
+	return &fileChange{
+		path:        vcsConnector.GetBaseDirectory(),
+		changeType:  v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL,
+		description: "Update the declarative schema",
+		content:     concatenateSDLFiles(paths, contents),
+	}, nil
+}
+
+// getSDLFilePaths gets the sorted paths of the declarative schema files in the base directory.
+func getSDLFilePaths(files []string, vcsConnector *storepb.VCSConnector) []string {
+	var paths []string
+	for _, file := range files {
+		filePath := file
+		if !strings.HasPrefix(filePath, "/") {
+			filePath = fmt.Sprintf("/%s", filePath)
+		}
+		if !isFileInDirectory(filePath, vcsConnector.GetBaseDirectory(), vcsConnector.GetIncludeSubdirectories()) {
+			continue
+		}
+		if change, _ := getSDLFileChange(file); change == nil {
+			continue
+		}
+		paths = append(paths, file)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// concatenateSDLFiles concatenates the declarative schema files into one schema, the files are separated by the comments of their paths.
+func concatenateSDLFiles(paths, contents []string) string {
+	var buf strings.Builder
+	for i, path := range paths {
+		if i > 0 {
+			_, _ = buf.WriteString("\n")
+		}
+		_, _ = fmt.Fprintf(&buf, "-- %s\n", path)
+		content := strings.TrimSpace(contents[i])
+		if content != "" {
+			_, _ = fmt.Fprintf(&buf, "%s\n", content)
+		}
+	}
+	return buf.String()
+}
+
+// getSDLPreviewComment gets the pull request comment previewing the DDL of the declarative schema files.
+// The DDL is computed against the current schema of the target databases.
+func (s *Service) getSDLPreviewComment(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, changes []*fileChange) (string, error) {
+	databases, err := s.listTargetDatabases(ctx, project, vcsConnector)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	_, _ = buf.WriteString("Bytebase Bot: the schema changes of this pull request against the current database schema.\n")
+	for i, database := range databases {
+		if i >= maxSDLPreviewDatabases {
+			_, _ = fmt.Fprintf(&buf, "\n%d more databases are not previewed.\n", len(databases)-maxSDLPreviewDatabases)
+			break
+		}
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+		}
+		if instance == nil {
+			continue
+		}
+		for _, change := range changes {
+			_, _ = fmt.Fprintf(&buf, "\n#### `%s` on `%s`\n", change.path, common.FormatDatabase(database.InstanceID, database.DatabaseName))
+			diff, err := runnerutils.ComputeDatabaseSchemaDiff(ctx, instance, database, s.dbFactory, change.content)
+			switch {
+			case err != nil:
+				_, _ = fmt.Fprintf(&buf, "Failed to compute the schema diff: %v\n", err)
+			case strings.TrimSpace(diff) == "":
+				_, _ = buf.WriteString("No schema change.\n")
+			default:
+				_, _ = fmt.Fprintf(&buf, "```sql\n%s\n```\n", strings.TrimSpace(diff))
+			}
+		}
+	}
+	if len(databases) == 0 {
+		_, _ = buf.WriteString("\nNo target database is found.\n")
+	}
+	return buf.String(), nil
+}
+
+// listTargetDatabases lists the databases the changes are applied to, which are the databases in the database group
+// of the VCS connector if set, otherwise all databases in the project.
+func (s *Service) listTargetDatabases(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage) ([]*store.DatabaseMessage, error) {
+	dbg := vcsConnector.Payload.GetDatabaseGroup()
+	if dbg == "" {
+		return s.listDatabases(ctx, project)
+	}
+	projectID, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(dbg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project id and database group id from %q", dbg)
+	}
+	if projectID != project.ResourceID {
+		return nil, errors.Errorf("project id %q in databaseGroup %q does not match project id %q", projectID, dbg, project.ResourceID)
+	}
+	databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database group %q", databaseGroupID)
+	}
+	if databaseGroup == nil {
+		return nil, errors.Errorf("database group %q not found", databaseGroupID)
+	}
+	allDatabases, err := s.listDatabases(ctx, project)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
+	}
+	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get matched databases in database group %q", databaseGroupID)
+	}
+	return matchedDatabases, nil
+}
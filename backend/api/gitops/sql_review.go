@@ -0,0 +1,151 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/runner/plancheck"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// sqlReviewCommitStatusContext is the context of the commit status reported for SQL review.
+const sqlReviewCommitStatusContext = "bytebase/sql-review"
+
+// fileSQLReview is the SQL review results of a changed file against a target database.
+type fileSQLReview struct {
+	path    string
+	results []*storepb.PlanCheckRunResult_Result
+	// failed is true if the SQL review fails to run.
+	failed bool
+}
+
+// reviewPullRequest runs the SQL review of the changed files against the target databases before the pull request is merged,
+// and reports the results to the head commit as the line comments and the commit status, so that the pull request can be blocked by the branch protection.
+func (s *Service) reviewPullRequest(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) error {
+	databases, err := s.listTargetDatabases(ctx, project, vcsConnector)
+	if err != nil {
+		return err
+	}
+
+	var reviews []*fileSQLReview
+	for _, database := range databases {
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+		}
+		if instance == nil {
+			continue
+		}
+		for _, change := range prInfo.changes {
+			results, err := plancheck.AdviseStatement(ctx, s.store, s.sheetManager, s.dbFactory, s.licenseService, instance, database, convertToChangeDatabaseType(change.changeType), change.content)
+			if err != nil {
+				slog.Warn("failed to run SQL review for pull request",
+					slog.String("pull_request", prInfo.url),
+					slog.String("path", change.path),
+					slog.String("database", common.FormatDatabase(database.InstanceID, database.DatabaseName)),
+					log.BBError(err))
+			}
+			reviews = append(reviews, &fileSQLReview{path: change.path, results: results, failed: err != nil})
+		}
+	}
+	comments, status := buildSQLReviewReport(reviews, prInfo.commitSHA)
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	repositoryID := vcsConnector.Payload.GetExternalId()
+	pullRequestID := getPullRequestID(prInfo.url)
+	for _, comment := range comments {
+		if err := provider.CreatePullRequestLineComment(ctx, repositoryID, pullRequestID, comment); err != nil {
+			slog.Warn("failed to create pull request line comment",
+				slog.String("pull_request", prInfo.url),
+				slog.String("path", comment.Path),
+				slog.Int("line", comment.Line),
+				log.BBError(err))
+		}
+	}
+	if err := provider.CreateCommitStatus(ctx, repositoryID, prInfo.commitSHA, status); err != nil {
+		return errors.Wrapf(err, "failed to create commit status")
+	}
+	return nil
+}
+
+// buildSQLReviewReport builds the pull request line comments from the SQL review advices,
+// and the commit status summarizing the SQL reviews.
+func buildSQLReviewReport(reviews []*fileSQLReview, commitSHA string) ([]*vcs.PullRequestLineComment, *vcs.CommitStatus) {
+	type commentKey struct {
+		path  string
+		line  int
+		title string
+	}
+	var comments []*vcs.PullRequestLineComment
+	seen := make(map[commentKey]bool)
+	errorCount, warningCount, failedCount := 0, 0, 0
+	for _, review := range reviews {
+		if review.failed {
+			failedCount++
+			continue
+		}
+		for _, result := range review.results {
+			switch result.Status {
+			case storepb.PlanCheckRunResult_Result_ERROR:
+				errorCount++
+			case storepb.PlanCheckRunResult_Result_WARNING:
+				warningCount++
+			default:
+				continue
+			}
+			line := max(int(result.GetSqlReviewReport().GetLine()), 1)
+			key := commentKey{path: review.path, line: line, title: result.Title}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			comments = append(comments, &vcs.PullRequestLineComment{
+				CommitID: commitSHA,
+				Path:     review.path,
+				Line:     line,
+				Body:     fmt.Sprintf("**[SQL Review %s] %s**\n\n%s", strings.ToLower(result.Status.String()), result.Title, result.Content),
+			})
+		}
+	}
+
+	status := &vcs.CommitStatus{
+		State:   vcs.CommitStatusSuccess,
+		Context: sqlReviewCommitStatusContext,
+	}
+	switch {
+	case errorCount > 0 || failedCount > 0:
+		status.State = vcs.CommitStatusFailure
+	case warningCount > 0:
+		status.State = vcs.CommitStatusWarning
+	}
+	if failedCount > 0 {
+		status.Description = fmt.Sprintf("SQL review found %d error(s) and %d warning(s), %d check(s) failed to run", errorCount, warningCount, failedCount)
+	} else {
+		status.Description = fmt.Sprintf("SQL review found %d error(s) and %d warning(s)", errorCount, warningCount)
+	}
+	return comments, status
+}
+
+func convertToChangeDatabaseType(t v1pb.Plan_ChangeDatabaseConfig_Type) storepb.PlanCheckRunConfig_ChangeDatabaseType {
+	switch t {
+	case v1pb.Plan_ChangeDatabaseConfig_MIGRATE:
+		return storepb.PlanCheckRunConfig_DDL
+	case v1pb.Plan_ChangeDatabaseConfig_MIGRATE_GHOST:
+		return storepb.PlanCheckRunConfig_DDL_GHOST
+	case v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL:
+		return storepb.PlanCheckRunConfig_SDL
+	case v1pb.Plan_ChangeDatabaseConfig_DATA:
+		return storepb.PlanCheckRunConfig_DML
+	default:
+		return storepb.PlanCheckRunConfig_CHANGE_DATABASE_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,81 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestBuildSQLReviewReport(t *testing.T) {
+	advice := func(status storepb.PlanCheckRunResult_Result_Status, title string, line int32) *storepb.PlanCheckRunResult_Result {
+		return &storepb.PlanCheckRunResult_Result{
+			Status:  status,
+			Title:   title,
+			Content: title + " content",
+			Report: &storepb.PlanCheckRunResult_Result_SqlReviewReport_{
+				SqlReviewReport: &storepb.PlanCheckRunResult_Result_SqlReviewReport{Line: line},
+			},
+		}
+	}
+	path := "bbtest/0001_create_table.sql"
+
+	tests := []struct {
+		name         string
+		reviews      []*fileSQLReview
+		wantComments []*vcs.PullRequestLineComment
+		wantState    vcs.CommitStatusState
+		wantDesc     string
+	}{
+		{
+			name: "success",
+			reviews: []*fileSQLReview{
+				{path: path, results: []*storepb.PlanCheckRunResult_Result{{Status: storepb.PlanCheckRunResult_Result_SUCCESS, Title: "OK"}}},
+			},
+			wantState: vcs.CommitStatusSuccess,
+			wantDesc:  "SQL review found 0 error(s) and 0 warning(s)",
+		},
+		{
+			name: "warning",
+			reviews: []*fileSQLReview{
+				{path: path, results: []*storepb.PlanCheckRunResult_Result{advice(storepb.PlanCheckRunResult_Result_WARNING, "column.no-null", 0)}},
+			},
+			wantComments: []*vcs.PullRequestLineComment{
+				{CommitID: "sha", Path: path, Line: 1, Body: "**[SQL Review warning] column.no-null**\n\ncolumn.no-null content"},
+			},
+			wantState: vcs.CommitStatusWarning,
+			wantDesc:  "SQL review found 0 error(s) and 1 warning(s)",
+		},
+		{
+			name: "error with duplicated advices across databases",
+			reviews: []*fileSQLReview{
+				{path: path, results: []*storepb.PlanCheckRunResult_Result{advice(storepb.PlanCheckRunResult_Result_ERROR, "table.require-pk", 3)}},
+				{path: path, results: []*storepb.PlanCheckRunResult_Result{advice(storepb.PlanCheckRunResult_Result_ERROR, "table.require-pk", 3)}},
+			},
+			wantComments: []*vcs.PullRequestLineComment{
+				{CommitID: "sha", Path: path, Line: 3, Body: "**[SQL Review error] table.require-pk**\n\ntable.require-pk content"},
+			},
+			wantState: vcs.CommitStatusFailure,
+			wantDesc:  "SQL review found 2 error(s) and 0 warning(s)",
+		},
+		{
+			name: "failed review",
+			reviews: []*fileSQLReview{
+				{path: path, failed: true},
+			},
+			wantState: vcs.CommitStatusFailure,
+			wantDesc:  "SQL review found 0 error(s) and 0 warning(s), 1 check(s) failed to run",
+		},
+	}
+
+	for _, test := range tests {
+		a := require.New(t)
+		comments, status := buildSQLReviewReport(test.reviews, "sha")
+		a.Equal(test.wantComments, comments, test.name)
+		a.Equal(test.wantState, status.State, test.name)
+		a.Equal(test.wantDesc, status.Description, test.name)
+		a.Equal(sqlReviewCommitStatusContext, status.Context, test.name)
+	}
+}
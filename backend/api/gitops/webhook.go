@@ -121,14 +121,12 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		case storepb.VCSType_BITBUCKET:
 			eventType := c.Request().Header.Get("X-Event-Key")
 			switch eventType {
-			case "pullrequest:created", "pullrequest:updated":
-				return c.String(http.StatusOK, "OK")
-			case "pullrequest:fulfilled":
+			case "pullrequest:created", "pullrequest:updated", "pullrequest:fulfilled":
 			default:
 				return c.String(http.StatusOK, "OK")
 			}
 
-			prInfo, err = getBitBucketPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+			prInfo, err = getBitBucketPullRequestInfo(ctx, vcsProvider, vcsConnector, eventType, body)
 			if err != nil {
 				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
 			}
@@ -142,11 +140,65 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			if err != nil {
 				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
 			}
+		case storepb.VCSType_GITEA:
+			// Forgejo sends both the X-Forgejo-* and the X-Gitea-* headers.
+			secretToken := c.Request().Header.Get("X-Gitea-Signature")
+			// Gitea signs the body in the same way as GitHub, except that the signature has no "sha256=" prefix.
+			ok, err := validateGitHubWebhookSignature256(secretToken, vcsConnector.Payload.WebhookSecretToken, body)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to validate webhook signature %q, error %v", secretToken, err))
+			}
+			if !ok {
+				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook secret token %q", secretToken))
+			}
+			if eventType := c.Request().Header.Get("X-Gitea-Event"); eventType != "pull_request" {
+				return c.String(http.StatusOK, "OK")
+			}
+
+			prInfo, err = getGiteaPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+			}
 		default:
 			return nil
 		}
+		changes, err := resolveFileChanges(prInfo.changes, vcsConnector.Payload.FileLayout)
+		if err != nil {
+			return c.String(http.StatusOK, fmt.Sprintf("failed to resolve file changes for pull request %s, error %v", prInfo.url, err))
+		}
+		prInfo.changes = changes
 		if len(prInfo.changes) == 0 {
-			return c.String(http.StatusOK, fmt.Sprintf("no relevant file change directly under the base directory %q for pull request %q", vcsConnector.Payload.BaseDirectory, prInfo.url))
+			return c.String(http.StatusOK, fmt.Sprintf("no relevant file change under the base directory %q for pull request %q", vcsConnector.Payload.BaseDirectory, prInfo.url))
+		}
+		provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+		if prInfo.preview {
+			// The SQL review runs before merging, so that the pull request can be blocked by the branch protection.
+			if err := s.reviewPullRequest(ctx, project, vcsProvider, vcsConnector, prInfo); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to review pull request %s, error %v", prInfo.url, err))
+			}
+			if vcsConnector.Payload.FileLayout != storepb.VCSConnector_SDL {
+				return nil
+			}
+			sdlChange, err := getSDLDesiredSchemaChange(ctx, provider, vcsConnector.Payload, prInfo.commitSHA)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to get the declarative schema for pull request %s, error %v", prInfo.url, err))
+			}
+			comment, err := s.getSDLPreviewComment(ctx, project, vcsConnector, []*fileChange{sdlChange})
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to preview schema changes for pull request %s, error %v", prInfo.url, err))
+			}
+			if err := provider.CreatePullRequestComment(ctx, vcsConnector.Payload.ExternalId, getPullRequestID(prInfo.url), comment); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to create pull request comment, error %v", err))
+			}
+			return nil
+		}
+		if vcsConnector.Payload.FileLayout == storepb.VCSConnector_SDL {
+			// The issue applies the desired full schema instead of the changed files.
+			sdlChange, err := getSDLDesiredSchemaChange(ctx, provider, vcsConnector.Payload, prInfo.commitSHA)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to get the declarative schema for pull request %s, error %v", prInfo.url, err))
+			}
+			prInfo.changes = []*fileChange{sdlChange}
 		}
 		issue, err := s.createIssueFromPRInfo(ctx, project, vcsProvider, vcsConnector, prInfo)
 		if err != nil {
@@ -154,10 +206,7 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		}
 		comment := getPullRequestComment(setting.ExternalUrl, issue.Name)
 		pullRequestID := getPullRequestID(prInfo.url)
-		if err := vcs.Get(
-			vcsProvider.Type,
-			vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken},
-		).CreatePullRequestComment(ctx, vcsConnector.Payload.ExternalId, pullRequestID, comment); err != nil {
+		if err := provider.CreatePullRequestComment(ctx, vcsConnector.Payload.ExternalId, pullRequestID, comment); err != nil {
 			return c.String(http.StatusOK, fmt.Sprintf("failed to create pull request comment, error %v", err))
 		}
 		return nil
@@ -234,6 +283,7 @@ func (s *Service) createIssueFromPRInfo(ctx context.Context, project *store.Proj
 			VcsSource: &v1pb.Plan_VCSSource{
 				VcsConnector:   fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
 				PullRequestUrl: prInfo.url,
+				CommitSha:      prInfo.commitSHA,
 				VcsType:        v1pb.VCSType(vcsProvider.Type),
 			},
 		},
@@ -39,4 +39,15 @@ func TestValidateGitHubWebhookSignature256(t *testing.T) {
 		assert.True(t, got)
 		assert.NoError(t, err)
 	})
+
+	t.Run("success without prefix", func(t *testing.T) {
+		// Gitea signs the payload without the "sha256=" prefix.
+		got, err := validateGitHubWebhookSignature256(
+			"6bf313c917fd04a3c6c85270bab6c2a6ae40b7ab37767107bf80ad5c6a0a0deb",
+			"bZovosSKsJ8QKCG9",
+			[]byte(payload),
+		)
+		assert.True(t, got)
+		assert.NoError(t, err)
+	})
 }
@@ -21,29 +21,32 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.InstanceService_UpdateDataSource_FullMethodName:  iam.PermissionInstancesUpdate,
 	v1pb.InstanceService_SyncSlowQueries_FullMethodName:   iam.PermissionInstancesSync,
 
-	v1pb.DatabaseService_GetDatabase_FullMethodName:            iam.PermissionDatabasesGet,
-	v1pb.DatabaseService_SearchDatabases_FullMethodName:        iam.PermissionDatabasesGet,
-	v1pb.DatabaseService_ListDatabases_FullMethodName:          iam.PermissionDatabasesList,
-	v1pb.DatabaseService_UpdateDatabase_FullMethodName:         iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:   iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_SyncDatabase_FullMethodName:           iam.PermissionDatabasesSync,
-	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:    iam.PermissionDatabasesGetSchema,
-	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName: iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:      iam.PermissionDatabasesGetSchema,
-	v1pb.DatabaseService_DiffSchema_FullMethodName:             iam.PermissionChangeHistoriesGet,
-	v1pb.DatabaseService_ListSlowQueries_FullMethodName:        iam.PermissionSlowQueriesList,
-	v1pb.DatabaseService_ListSecrets_FullMethodName:            iam.PermissionDatabaseSecretsList,
-	v1pb.DatabaseService_UpdateSecret_FullMethodName:           iam.PermissionDatabaseSecretsUpdate,
-	v1pb.DatabaseService_DeleteSecret_FullMethodName:           iam.PermissionDatabaseSecretsDelete,
-	v1pb.DatabaseService_AdviseIndex_FullMethodName:            iam.PermissionDatabasesAdviseIndex,
-	v1pb.DatabaseService_ListChangeHistories_FullMethodName:    iam.PermissionChangeHistoriesList,
-	v1pb.DatabaseService_GetChangeHistory_FullMethodName:       iam.PermissionChangeHistoriesGet,
-	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:   iam.PermissionEnvironmentsCreate,
-	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:   iam.PermissionEnvironmentsUpdate,
-	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:   iam.PermissionEnvironmentsDelete,
-	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName: iam.PermissionEnvironmentsUndelete,
-	v1pb.EnvironmentService_GetEnvironment_FullMethodName:      iam.PermissionEnvironmentsGet,
-	v1pb.EnvironmentService_ListEnvironments_FullMethodName:    iam.PermissionEnvironmentsList,
+	v1pb.DatabaseService_GetDatabase_FullMethodName:                     iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_SearchDatabases_FullMethodName:                 iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_ListDatabases_FullMethodName:                   iam.PermissionDatabasesList,
+	v1pb.DatabaseService_UpdateDatabase_FullMethodName:                  iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:            iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_SyncDatabase_FullMethodName:                    iam.PermissionDatabasesSync,
+	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:             iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName:          iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_ReviewClassificationSuggestions_FullMethodName: iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:               iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_DiffSchema_FullMethodName:                      iam.PermissionChangeHistoriesGet,
+	v1pb.DatabaseService_ResolveSchemaDrift_FullMethodName:              iam.PermissionIssuesCreate,
+	v1pb.DatabaseService_GetDatabaseGrowth_FullMethodName:               iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_ListSlowQueries_FullMethodName:                 iam.PermissionSlowQueriesList,
+	v1pb.DatabaseService_ListSecrets_FullMethodName:                     iam.PermissionDatabaseSecretsList,
+	v1pb.DatabaseService_UpdateSecret_FullMethodName:                    iam.PermissionDatabaseSecretsUpdate,
+	v1pb.DatabaseService_DeleteSecret_FullMethodName:                    iam.PermissionDatabaseSecretsDelete,
+	v1pb.DatabaseService_AdviseIndex_FullMethodName:                     iam.PermissionDatabasesAdviseIndex,
+	v1pb.DatabaseService_ListChangeHistories_FullMethodName:             iam.PermissionChangeHistoriesList,
+	v1pb.DatabaseService_GetChangeHistory_FullMethodName:                iam.PermissionChangeHistoriesGet,
+	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:            iam.PermissionEnvironmentsCreate,
+	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:            iam.PermissionEnvironmentsUpdate,
+	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:            iam.PermissionEnvironmentsDelete,
+	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName:          iam.PermissionEnvironmentsUndelete,
+	v1pb.EnvironmentService_GetEnvironment_FullMethodName:               iam.PermissionEnvironmentsGet,
+	v1pb.EnvironmentService_ListEnvironments_FullMethodName:             iam.PermissionEnvironmentsList,
 
 	// XXX: issues.action needs respective plans.action and rollouts.action permissions if the issue type is change database.
 	v1pb.IssueService_CreateIssue_FullMethodName:             iam.PermissionIssuesCreate,
@@ -110,6 +113,7 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.ChangelistService_CreateChangelist_FullMethodName: iam.PermissionChangelistsCreate,
 	v1pb.ChangelistService_UpdateChangelist_FullMethodName: iam.PermissionChangelistsUpdate,
 	v1pb.ChangelistService_DeleteChangelist_FullMethodName: iam.PermissionChangelistsDelete,
+	v1pb.ChangelistService_DryRunChangelist_FullMethodName: iam.PermissionChangelistsGet,
 
 	v1pb.InstanceRoleService_ListInstanceRoles_FullMethodName:    iam.PermissionInstancesGet,
 	v1pb.InstanceRoleService_GetInstanceRole_FullMethodName:      iam.PermissionInstancesGet,
@@ -140,6 +144,7 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.SettingService_ListSettings_FullMethodName:                      iam.PermissionSettingsList,
 	v1pb.SettingService_GetSetting_FullMethodName:                        iam.PermissionSettingsGet,
 	v1pb.SettingService_UpdateSetting_FullMethodName:                     iam.PermissionSettingsSet,
+	v1pb.SettingService_UnmaskValues_FullMethodName:                      iam.PermissionSettingsSet,
 
 	v1pb.OrgPolicyService_ListPolicies_FullMethodName: iam.PermissionPoliciesList,
 	v1pb.OrgPolicyService_GetPolicy_FullMethodName:    iam.PermissionPoliciesGet,
@@ -66,9 +66,13 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.CelService_BatchParse_FullMethodName,
 		v1pb.CelService_BatchDeparse_FullMethodName,
 		v1pb.SQLService_Query_FullMethodName,
+		v1pb.SQLService_FetchNext_FullMethodName,
+		v1pb.SQLService_CloseQuerySession_FullMethodName,
+		v1pb.SQLService_FederatedQuery_FullMethodName,
 		// TODO(steven): maybe needs to add a permission to check.
 		v1pb.SQLService_Execute_FullMethodName,
 		v1pb.SQLService_SearchQueryHistories_FullMethodName,
+		v1pb.SQLService_AggregateQueryHistories_FullMethodName,
 		v1pb.SQLService_Export_FullMethodName,
 		v1pb.SQLService_DifferPreview_FullMethodName,
 		v1pb.SQLService_Check_FullMethodName,
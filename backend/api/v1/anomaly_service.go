@@ -28,6 +28,12 @@ var typesMap = map[string]api.AnomalyType{
 	"MIGRATION_SCHEMA":      api.AnomalyInstanceMigrationSchema,
 	"DATABASE_CONNECTION":   api.AnomalyDatabaseConnection,
 	"DATABASE_SCHEMA_DRIFT": api.AnomalyDatabaseSchemaDrift,
+
+	"INSTANCE_REPLICATION_LAG":           api.AnomalyInstanceReplicationLag,
+	"DATABASE_TABLE_WITHOUT_PRIMARY_KEY": api.AnomalyDatabaseTableWithoutPrimaryKey,
+	"DATABASE_UNUSED_INDEX":              api.AnomalyDatabaseUnusedIndex,
+	"DATABASE_DUPLICATE_INDEX":           api.AnomalyDatabaseDuplicateIndex,
+	"DATABASE_AUTO_INCREMENT_EXHAUSTION": api.AnomalyDatabaseAutoIncrementExhaustion,
 }
 
 // AnomalyService implements the anomaly service.
@@ -183,6 +189,79 @@ func (s *AnomalyService) convertToAnomaly(ctx context.Context, anomaly *store.An
 				ActualSchema:   detail.Actual,
 			},
 		}
+	case api.AnomalyInstanceReplicationLag:
+		var detail api.AnomalyInstanceReplicationLagPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal instance replication lag anomaly payload")
+		}
+		pbDetail := &v1pb.Anomaly_InstanceReplicationLagDetail{}
+		for _, lag := range detail.DataSources {
+			pbDetail.DataSourceLags = append(pbDetail.DataSourceLags, &v1pb.Anomaly_InstanceReplicationLagDetail_DataSourceLag{
+				DataSourceId: lag.DataSourceID,
+				LagSeconds:   lag.LagSeconds,
+			})
+		}
+		pbAnomaly.Type = v1pb.Anomaly_INSTANCE_REPLICATION_LAG
+		pbAnomaly.Detail = &v1pb.Anomaly_InstanceReplicationLagDetail_{
+			InstanceReplicationLagDetail: pbDetail,
+		}
+	case api.AnomalyDatabaseTableWithoutPrimaryKey:
+		var detail api.AnomalyDatabaseTableWithoutPrimaryKeyPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal database table without primary key anomaly payload")
+		}
+		pbDetail := &v1pb.Anomaly_DatabaseTableWithoutPrimaryKeyDetail{}
+		for _, table := range detail.Tables {
+			pbDetail.Tables = append(pbDetail.Tables, &v1pb.Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table{
+				Schema: table.Schema,
+				Table:  table.Table,
+			})
+		}
+		pbAnomaly.Type = v1pb.Anomaly_DATABASE_TABLE_WITHOUT_PRIMARY_KEY
+		pbAnomaly.Detail = &v1pb.Anomaly_DatabaseTableWithoutPrimaryKeyDetail_{
+			DatabaseTableWithoutPrimaryKeyDetail: pbDetail,
+		}
+	case api.AnomalyDatabaseUnusedIndex, api.AnomalyDatabaseDuplicateIndex:
+		var detail api.AnomalyDatabaseIndexPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal database index anomaly payload")
+		}
+		pbDetail := &v1pb.Anomaly_DatabaseIndexDetail{}
+		for _, index := range detail.Indexes {
+			pbDetail.Indexes = append(pbDetail.Indexes, &v1pb.Anomaly_DatabaseIndexDetail_Index{
+				Schema:        index.Schema,
+				Table:         index.Table,
+				Index:         index.Index,
+				CoveringIndex: index.CoveringIndex,
+			})
+		}
+		pbAnomaly.Type = v1pb.Anomaly_DATABASE_UNUSED_INDEX
+		if anomaly.Type == api.AnomalyDatabaseDuplicateIndex {
+			pbAnomaly.Type = v1pb.Anomaly_DATABASE_DUPLICATE_INDEX
+		}
+		pbAnomaly.Detail = &v1pb.Anomaly_DatabaseIndexDetail_{
+			DatabaseIndexDetail: pbDetail,
+		}
+	case api.AnomalyDatabaseAutoIncrementExhaustion:
+		var detail api.AnomalyDatabaseAutoIncrementExhaustionPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal database auto-increment exhaustion anomaly payload")
+		}
+		pbDetail := &v1pb.Anomaly_DatabaseAutoIncrementExhaustionDetail{}
+		for _, column := range detail.Columns {
+			pbDetail.Columns = append(pbDetail.Columns, &v1pb.Anomaly_DatabaseAutoIncrementExhaustionDetail_Column{
+				Schema:       column.Schema,
+				Table:        column.Table,
+				Column:       column.Column,
+				Sequence:     column.Sequence,
+				CurrentValue: column.CurrentValue,
+				MaximumValue: column.MaximumValue,
+			})
+		}
+		pbAnomaly.Type = v1pb.Anomaly_DATABASE_AUTO_INCREMENT_EXHAUSTION
+		pbAnomaly.Detail = &v1pb.Anomaly_DatabaseAutoIncrementExhaustionDetail_{
+			DatabaseAutoIncrementExhaustionDetail: pbDetail,
+		}
 	}
 	pbAnomaly.Severity = getSeverityFromAnomalyType(pbAnomaly.Type)
 	return pbAnomaly, nil
@@ -192,6 +271,10 @@ func getSeverityFromAnomalyType(tp v1pb.Anomaly_AnomalyType) v1pb.Anomaly_Anomal
 	switch tp {
 	case v1pb.Anomaly_INSTANCE_CONNECTION, v1pb.Anomaly_MIGRATION_SCHEMA, v1pb.Anomaly_DATABASE_CONNECTION, v1pb.Anomaly_DATABASE_SCHEMA_DRIFT:
 		return v1pb.Anomaly_CRITICAL
+	case v1pb.Anomaly_INSTANCE_REPLICATION_LAG, v1pb.Anomaly_DATABASE_AUTO_INCREMENT_EXHAUSTION:
+		return v1pb.Anomaly_HIGH
+	case v1pb.Anomaly_DATABASE_TABLE_WITHOUT_PRIMARY_KEY, v1pb.Anomaly_DATABASE_UNUSED_INDEX, v1pb.Anomaly_DATABASE_DUPLICATE_INDEX:
+		return v1pb.Anomaly_MEDIUM
 	}
 	return v1pb.Anomaly_ANOMALY_SEVERITY_UNSPECIFIED
 }
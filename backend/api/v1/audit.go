@@ -110,6 +110,8 @@ func getRequestResource(request any) string {
 		return r.Database.Name
 	case *v1pb.BatchUpdateDatabasesRequest:
 		return r.Parent
+	case *v1pb.ReviewClassificationSuggestionsRequest:
+		return r.Name
 	case *v1pb.SetIamPolicyRequest:
 		return r.Project
 	case *v1pb.CreateUserRequest:
@@ -129,6 +131,10 @@ func getRequestString(request any) (string, error) {
 		switch r := request.(type) {
 		case *v1pb.QueryRequest:
 			return r
+		case *v1pb.FederatedQueryRequest:
+			return r
+		case *v1pb.UnmaskValuesRequest:
+			return r
 		case *v1pb.ExportRequest:
 			//nolint:revive
 			r = proto.Clone(r).(*v1pb.ExportRequest)
@@ -138,6 +144,8 @@ func getRequestString(request any) (string, error) {
 			return r
 		case *v1pb.BatchUpdateDatabasesRequest:
 			return r
+		case *v1pb.ReviewClassificationSuggestionsRequest:
+			return r
 		case *v1pb.SetIamPolicyRequest:
 			return r
 		case *v1pb.CreateUserRequest:
@@ -255,9 +263,12 @@ func isAuditMethod(method string) bool {
 		v1pb.AuthService_UpdateUser_FullMethodName,
 		v1pb.DatabaseService_UpdateDatabase_FullMethodName,
 		v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName,
+		v1pb.DatabaseService_ReviewClassificationSuggestions_FullMethodName,
 		v1pb.ProjectService_SetIamPolicy_FullMethodName,
 		v1pb.SQLService_Export_FullMethodName,
-		v1pb.SQLService_Query_FullMethodName:
+		v1pb.SQLService_Query_FullMethodName,
+		v1pb.SQLService_FederatedQuery_FullMethodName,
+		v1pb.SettingService_UnmaskValues_FullMethodName:
 		return true
 	default:
 		return false
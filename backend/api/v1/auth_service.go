@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
@@ -372,6 +373,17 @@ func (s *AuthService) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRe
 				}
 			}
 			patch.Phone = &request.User.Phone
+		case "approval_delegation":
+			delegation, err := s.convertToStoreApprovalDelegation(ctx, user, request.User.ApprovalDelegation)
+			if err != nil {
+				return nil, err
+			}
+			profile, ok := proto.Clone(user.Profile).(*storepb.UserProfile)
+			if !ok || profile == nil {
+				profile = &storepb.UserProfile{}
+			}
+			profile.ApprovalDelegation = delegation
+			patch.Profile = profile
 		}
 	}
 	if passwordPatch != nil {
@@ -548,6 +560,13 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 	for _, r := range user.Roles {
 		convertedUser.Roles = append(convertedUser.Roles, common.FormatRole(r.String()))
 	}
+	if delegation := user.Profile.GetApprovalDelegation(); delegation != nil {
+		convertedUser.ApprovalDelegation = &v1pb.User_ApprovalDelegation{
+			Delegate:  common.FormatUserUID(int(delegation.DelegateId)),
+			StartTime: delegation.StartTime,
+			EndTime:   delegation.EndTime,
+		}
+	}
 	if user.MFAConfig != nil {
 		convertedUser.MfaEnabled = user.MFAConfig.OtpSecret != ""
 		convertedUser.MfaSecret = user.MFAConfig.TempOtpSecret
@@ -556,6 +575,39 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 	return convertedUser
 }
 
+// convertToStoreApprovalDelegation validates and converts the approval delegation of the user.
+// A nil delegation clears the delegation.
+func (s *AuthService) convertToStoreApprovalDelegation(ctx context.Context, user *store.UserMessage, delegation *v1pb.User_ApprovalDelegation) (*storepb.UserProfile_ApprovalDelegation, error) {
+	if delegation == nil || delegation.Delegate == "" {
+		return nil, nil
+	}
+	delegateID, err := common.GetUserID(delegation.Delegate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if delegateID == user.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delegate the approvals to the user itself")
+	}
+	delegate, err := s.store.GetUserByID(ctx, delegateID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if delegate == nil || delegate.MemberDeleted {
+		return nil, status.Errorf(codes.InvalidArgument, "delegate %q not found", delegation.Delegate)
+	}
+	if delegate.Type != api.EndUser {
+		return nil, status.Errorf(codes.InvalidArgument, "delegate %q must be an end user", delegation.Delegate)
+	}
+	if delegation.StartTime != nil && delegation.EndTime != nil && !delegation.StartTime.AsTime().Before(delegation.EndTime.AsTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "the delegation start time must be before the end time")
+	}
+	return &storepb.UserProfile_ApprovalDelegation{
+		DelegateId: int32(delegateID),
+		StartTime:  delegation.StartTime,
+		EndTime:    delegation.EndTime,
+	}, nil
+}
+
 func convertToPrincipalType(userType v1pb.UserType) (api.PrincipalType, error) {
 	var t api.PrincipalType
 	switch userType {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -21,14 +22,16 @@ import (
 type ChangelistService struct {
 	v1pb.UnimplementedChangelistServiceServer
 	store      *store.Store
+	dbFactory  *dbfactory.DBFactory
 	profile    *config.Profile
 	iamManager *iam.Manager
 }
 
 // NewChangelistService creates a new ChangelistService.
-func NewChangelistService(store *store.Store, profile *config.Profile, iamManager *iam.Manager) *ChangelistService {
+func NewChangelistService(store *store.Store, dbFactory *dbfactory.DBFactory, profile *config.Profile, iamManager *iam.Manager) *ChangelistService {
 	return &ChangelistService{
 		store:      store,
+		dbFactory:  dbFactory,
 		profile:    profile,
 		iamManager: iamManager,
 	}
@@ -0,0 +1,328 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// dryRunDatabasePrefix is the name prefix of the ephemeral databases created for the changelist dry runs.
+	dryRunDatabasePrefix = "bytebase_dry_run_"
+	// dryRunUserPrefix is the name prefix of the temporary users that run the changes of the changelist dry runs.
+	dryRunUserPrefix = "bb_dry_run_"
+)
+
+// dryRunDefinerRegex matches the DEFINER clauses in the MySQL dump, e.g. DEFINER=`root`@`%`.
+var dryRunDefinerRegex = regexp.MustCompile("DEFINER=`[^`]*`@`[^`]*`\\s+")
+
+// dryRunSandbox is an ephemeral database with a temporary user that only has privileges on the ephemeral database.
+// The changes are executed as the temporary user instead of the admin data source, so that they cannot affect the
+// rest of the instance, e.g. reading server files, terminating other sessions or altering roles.
+type dryRunSandbox struct {
+	databaseName string
+	userName     string
+	password     string
+}
+
+// DryRunChangelist applies the changes of the changelist to an ephemeral database cloned from the target database schema.
+func (s *ChangelistService) DryRunChangelist(ctx context.Context, request *v1pb.DryRunChangelistRequest) (*v1pb.DryRunChangelistResponse, error) {
+	projectID, changelistID, err := common.GetProjectIDChangelistID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	changelist, err := s.store.GetChangelist(ctx, &store.FindChangelistMessage{ProjectID: &project.ResourceID, ResourceID: &changelistID})
+	if err != nil {
+		return nil, err
+	}
+	if changelist == nil {
+		return nil, status.Errorf(codes.NotFound, "changelist %q not found", changelistID)
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instanceID, DatabaseName: &databaseName})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", request.Database)
+	}
+	if database.ProjectID != project.ResourceID {
+		return nil, status.Errorf(codes.InvalidArgument, "database %q does not belong to project %q", request.Database, project.ResourceID)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	engine, err := getDryRunParserEngine(instance.Engine)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	// The response reveals the schema of the target database.
+	ok, err = s.iamManager.CheckPermission(ctx, iam.PermissionDatabasesGetSchema, user, project.ResourceID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied to get schema of database %q", request.Database)
+	}
+	// The dry run executes the changes on the instance of the target database.
+	ok, err = s.iamManager.CheckPermission(ctx, iam.PermissionPlansCreate, user, project.ResourceID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied to change database %q", request.Database)
+	}
+
+	var statements []string
+	for _, change := range changelist.Payload.Changes {
+		_, sheetUID, err := common.GetProjectResourceIDSheetUID(change.Sheet)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse sheet %q, error: %v", change.Sheet, err)
+		}
+		statement, err := s.store.GetSheetStatementByID(ctx, sheetUID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get sheet %q statement, error: %v", change.Sheet, err)
+		}
+		statements = append(statements, statement)
+	}
+
+	sourceSchema, err := dumpDatabaseSchema(ctx, s.dbFactory, instance, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to dump schema of database %q, error: %v", request.Database, err)
+	}
+	sourceSchema = trimDryRunDefiners(engine, sourceSchema)
+
+	sandbox, err := newDryRunSandbox()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate ephemeral database credentials, error: %v", err)
+	}
+	// Drop whatever part of the sandbox has been created even if the creation fails halfway.
+	defer func() {
+		// Use a fresh context so that the sandbox is dropped even if the request is canceled.
+		if err := dropDryRunSandbox(context.Background(), s.dbFactory, instance, sandbox); err != nil {
+			slog.Error("failed to drop ephemeral database", slog.String("instance", instance.ResourceID), slog.String("database", sandbox.databaseName), log.BBError(err))
+		}
+	}()
+	if err := createDryRunSandbox(ctx, s.dbFactory, instance, sandbox); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create ephemeral database, error: %v", err)
+	}
+
+	results, resultSchema, err := applyDryRunChanges(ctx, s.dbFactory, instance, sandbox, sourceSchema, changelist.Payload.Changes, statements)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to dry run changelist, error: %v", err)
+	}
+	resultSchema = trimDryRunDefiners(engine, resultSchema)
+
+	diff, err := diffDryRunSchema(engine, instance, sourceSchema, resultSchema)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute schema diff, error: %v", err)
+	}
+	return &v1pb.DryRunChangelistResponse{
+		Results:    results,
+		SchemaDiff: diff,
+	}, nil
+}
+
+func getDryRunParserEngine(engine storepb.Engine) (storepb.Engine, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return storepb.Engine_POSTGRES, nil
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB:
+		return storepb.Engine_MYSQL, nil
+	default:
+		return storepb.Engine_ENGINE_UNSPECIFIED, errors.Errorf("dry run is not supported for engine %q", engine)
+	}
+}
+
+// newDryRunSandbox returns a sandbox with unique names so that the concurrent dry runs never share a database or a user.
+// The database name fits in the 63-character identifier limit of PostgreSQL, and the user name fits in the 32-character
+// user name limit of MySQL.
+func newDryRunSandbox() (*dryRunSandbox, error) {
+	id := strings.ReplaceAll(uuid.NewString(), "-", "")
+	password, err := common.RandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	return &dryRunSandbox{
+		databaseName: dryRunDatabasePrefix + id,
+		userName:     dryRunUserPrefix + id[:20],
+		password:     password,
+	}, nil
+}
+
+// trimDryRunDefiners removes the DEFINER clauses from the MySQL dump. The sandbox user is not allowed to create objects
+// on behalf of the other users, and the objects it creates are defined by itself, which must not show up in the schema diff.
+func trimDryRunDefiners(engine storepb.Engine, schema string) string {
+	if engine != storepb.Engine_MYSQL {
+		return schema
+	}
+	return dryRunDefinerRegex.ReplaceAllString(schema, "")
+}
+
+func dumpDatabaseSchema(ctx context.Context, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, database *store.DatabaseMessage) (string, error) {
+	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+
+	var schema bytes.Buffer
+	if _, err := driver.Dump(ctx, &schema, true /* schemaOnly */); err != nil {
+		return "", err
+	}
+	return schema.String(), nil
+}
+
+// applyDryRunChanges restores the source schema to the ephemeral database and applies the changes in order as the sandbox user.
+// A failed change does not stop the dry run, so that all the errors are reported at once.
+func applyDryRunChanges(ctx context.Context, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, sandbox *dryRunSandbox, sourceSchema string, changes []*storepb.Changelist_Change, statements []string) ([]*v1pb.DryRunChangelistResponse_ChangeResult, string, error) {
+	// Use a dedicated driver because the connection must be closed before dropping the ephemeral database.
+	driver, err := dbFactory.GetDedicatedUserDatabaseDriver(ctx, instance, sandbox.databaseName, sandbox.userName, sandbox.password)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get sandbox database driver")
+	}
+	defer driver.Close(ctx)
+
+	if sourceSchema != "" {
+		if _, err := driver.Execute(ctx, sourceSchema, db.ExecuteOptions{}); err != nil {
+			return nil, "", errors.Wrap(err, "failed to restore schema to ephemeral database")
+		}
+	}
+
+	var results []*v1pb.DryRunChangelistResponse_ChangeResult
+	for i, change := range changes {
+		result := &v1pb.DryRunChangelistResponse_ChangeResult{
+			Sheet: change.Sheet,
+		}
+		if _, err := driver.Execute(ctx, statements[i], db.ExecuteOptions{}); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	var schema bytes.Buffer
+	if _, err := driver.Dump(ctx, &schema, true /* schemaOnly */); err != nil {
+		return nil, "", errors.Wrap(err, "failed to dump schema of ephemeral database")
+	}
+	return results, schema.String(), nil
+}
+
+func diffDryRunSchema(engine storepb.Engine, instance *store.InstanceMessage, sourceSchema, resultSchema string) (string, error) {
+	source, err := transform.SchemaTransform(engine, sourceSchema)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to transform source schema")
+	}
+	result, err := transform.SchemaTransform(engine, resultSchema)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to transform result schema")
+	}
+	return base.SchemaDiff(engine, base.DiffContext{
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		StrictMode:          true,
+	}, source, result)
+}
+
+// getCreateDryRunSandboxStatements returns the statements creating the sandbox, which are executed by the admin data source.
+// The sandbox user is granted the privileges on the ephemeral database only, without any instance-level privilege.
+func getCreateDryRunSandboxStatements(engine storepb.Engine, sandbox *dryRunSandbox) []string {
+	if engine == storepb.Engine_POSTGRES {
+		// The driver executes the statements as the database owner, so the sandbox role must own the ephemeral database.
+		// Creating a database owned by another role requires the membership of the role for non-superusers.
+		return []string{
+			fmt.Sprintf(`CREATE ROLE "%s" WITH LOGIN PASSWORD '%s' NOSUPERUSER NOCREATEDB NOCREATEROLE NOINHERIT NOREPLICATION NOBYPASSRLS;`, sandbox.userName, sandbox.password),
+			fmt.Sprintf(`GRANT "%s" TO CURRENT_USER;`, sandbox.userName),
+			fmt.Sprintf(`CREATE DATABASE "%s" OWNER "%s";`, sandbox.databaseName, sandbox.userName),
+		}
+	}
+	return []string{
+		fmt.Sprintf("CREATE DATABASE `%s`;", sandbox.databaseName),
+		fmt.Sprintf("CREATE USER '%s'@'%%' IDENTIFIED BY '%s';", sandbox.userName, sandbox.password),
+		fmt.Sprintf("GRANT ALL PRIVILEGES ON `%s`.* TO '%s'@'%%';", sandbox.databaseName, sandbox.userName),
+	}
+}
+
+// getDropDryRunSandboxStatements returns the statements dropping the sandbox, the database must be dropped before the user who owns its objects.
+func getDropDryRunSandboxStatements(engine storepb.Engine, sandbox *dryRunSandbox) []string {
+	if engine == storepb.Engine_POSTGRES {
+		return []string{
+			fmt.Sprintf(`DROP DATABASE IF EXISTS "%s";`, sandbox.databaseName),
+			fmt.Sprintf(`DROP ROLE IF EXISTS "%s";`, sandbox.userName),
+		}
+	}
+	return []string{
+		fmt.Sprintf("DROP DATABASE IF EXISTS `%s`;", sandbox.databaseName),
+		fmt.Sprintf("DROP USER IF EXISTS '%s'@'%%';", sandbox.userName),
+	}
+}
+
+func createDryRunSandbox(ctx context.Context, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, sandbox *dryRunSandbox) error {
+	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+
+	for _, statement := range getCreateDryRunSandboxStatements(instance.Engine, sandbox) {
+		// CREATE DATABASE cannot run inside a transaction block in PostgreSQL.
+		if _, err := driver.GetDB().ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dropDryRunSandbox(ctx context.Context, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, sandbox *dryRunSandbox) error {
+	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get admin database driver")
+	}
+	defer driver.Close(ctx)
+
+	for _, statement := range getDropDryRunSandboxStatements(instance.Engine, sandbox) {
+		if _, err := driver.GetDB().ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestNewDryRunSandbox(t *testing.T) {
+	a := require.New(t)
+	names := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		sandbox, err := newDryRunSandbox()
+		a.NoError(err)
+		a.True(strings.HasPrefix(sandbox.databaseName, dryRunDatabasePrefix))
+		a.LessOrEqual(len(sandbox.databaseName), 63)
+		a.True(strings.HasPrefix(sandbox.userName, dryRunUserPrefix))
+		a.LessOrEqual(len(sandbox.userName), 32)
+		a.Len(sandbox.password, 32)
+		a.False(names[sandbox.databaseName])
+		a.False(names[sandbox.userName])
+		names[sandbox.databaseName] = true
+		names[sandbox.userName] = true
+	}
+}
+
+func TestGetDryRunSandboxStatements(t *testing.T) {
+	a := require.New(t)
+	sandbox := &dryRunSandbox{
+		databaseName: "bytebase_dry_run_db",
+		userName:     "bb_dry_run_user",
+		password:     "secret",
+	}
+
+	a.Equal([]string{
+		`CREATE ROLE "bb_dry_run_user" WITH LOGIN PASSWORD 'secret' NOSUPERUSER NOCREATEDB NOCREATEROLE NOINHERIT NOREPLICATION NOBYPASSRLS;`,
+		`GRANT "bb_dry_run_user" TO CURRENT_USER;`,
+		`CREATE DATABASE "bytebase_dry_run_db" OWNER "bb_dry_run_user";`,
+	}, getCreateDryRunSandboxStatements(storepb.Engine_POSTGRES, sandbox))
+	a.Equal([]string{
+		`DROP DATABASE IF EXISTS "bytebase_dry_run_db";`,
+		`DROP ROLE IF EXISTS "bb_dry_run_user";`,
+	}, getDropDryRunSandboxStatements(storepb.Engine_POSTGRES, sandbox))
+
+	a.Equal([]string{
+		"CREATE DATABASE `bytebase_dry_run_db`;",
+		"CREATE USER 'bb_dry_run_user'@'%' IDENTIFIED BY 'secret';",
+		"GRANT ALL PRIVILEGES ON `bytebase_dry_run_db`.* TO 'bb_dry_run_user'@'%';",
+	}, getCreateDryRunSandboxStatements(storepb.Engine_MYSQL, sandbox))
+	a.Equal([]string{
+		"DROP DATABASE IF EXISTS `bytebase_dry_run_db`;",
+		"DROP USER IF EXISTS 'bb_dry_run_user'@'%';",
+	}, getDropDryRunSandboxStatements(storepb.Engine_MYSQL, sandbox))
+}
+
+func TestTrimDryRunDefiners(t *testing.T) {
+	a := require.New(t)
+	schema := "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW `v` AS select 1;\n" +
+		"CREATE DEFINER=`admin`@`localhost` PROCEDURE `p`() BEGIN END;\n"
+	a.Equal("CREATE ALGORITHM=UNDEFINED SQL SECURITY DEFINER VIEW `v` AS select 1;\n"+
+		"CREATE PROCEDURE `p`() BEGIN END;\n", trimDryRunDefiners(storepb.Engine_MYSQL, schema))
+	a.Equal(schema, trimDryRunDefiners(storepb.Engine_POSTGRES, schema))
+
+	engine, err := getDryRunParserEngine(storepb.Engine_MARIADB)
+	a.NoError(err)
+	a.Equal(storepb.Engine_MYSQL, engine)
+	_, err = getDryRunParserEngine(storepb.Engine_ORACLE)
+	a.Error(err)
+}
@@ -129,6 +129,8 @@ func (p *ContextProvider) do(ctx context.Context, fullMethod string, req any) ([
 		v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName,
 		v1pb.DatabaseService_DiffSchema_FullMethodName,
 		v1pb.DatabaseService_SyncDatabase_FullMethodName,
+		v1pb.DatabaseService_ResolveSchemaDrift_FullMethodName,
+		v1pb.DatabaseService_GetDatabaseGrowth_FullMethodName,
 		v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName,
 		v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName,
 		v1pb.DatabaseService_GetDatabaseSchema_FullMethodName,
@@ -150,7 +152,8 @@ func (p *ContextProvider) do(ctx context.Context, fullMethod string, req any) ([
 
 	case
 		v1pb.ChangelistService_CreateChangelist_FullMethodName,
-		v1pb.ChangelistService_GetChangelist_FullMethodName:
+		v1pb.ChangelistService_GetChangelist_FullMethodName,
+		v1pb.ChangelistService_DryRunChangelist_FullMethodName:
 		return p.getProjectIDsForChangelistService(ctx, req)
 
 	case
@@ -242,6 +245,8 @@ func (*ContextProvider) getProjectIDsForChangelistService(_ context.Context, req
 		projects = append(projects, r.GetParent())
 	case *v1pb.GetChangelistRequest:
 		changelists = append(changelists, r.GetName())
+	case *v1pb.DryRunChangelistRequest:
+		changelists = append(changelists, r.GetName())
 	}
 
 	var projectIDs []string
@@ -494,8 +499,16 @@ func (p *ContextProvider) getProjectIDsForDatabase(ctx context.Context, req any)
 		databaseNames = append(databaseNames, r.GetName())
 	case *v1pb.SyncDatabaseRequest:
 		databaseNames = append(databaseNames, r.GetName())
+	case *v1pb.ResolveSchemaDriftRequest:
+		databaseNames = append(databaseNames, r.GetName())
 	case *v1pb.AdviseIndexRequest:
 		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.GetDatabaseGrowthRequest:
+		databaseName, err := common.TrimSuffix(r.GetName(), "/growth")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
+		}
+		databaseNames = append(databaseNames, databaseName)
 	case *v1pb.GetDatabaseMetadataRequest:
 		databaseName, err := common.TrimSuffix(r.GetName(), "/metadata")
 		if err != nil {
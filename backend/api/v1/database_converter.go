@@ -403,12 +403,21 @@ func convertStoreTableConfig(ctx context.Context, table *storepb.TableConfig, op
 }
 
 func convertStoreColumnConfig(column *storepb.ColumnConfig) *v1pb.ColumnConfig {
-	return &v1pb.ColumnConfig{
+	columnConfig := &v1pb.ColumnConfig{
 		Name:             column.Name,
 		SemanticTypeId:   column.SemanticTypeId,
 		Labels:           column.Labels,
 		ClassificationId: column.ClassificationId,
 	}
+	if suggestion := column.ClassificationSuggestion; suggestion != nil {
+		columnConfig.ClassificationSuggestion = &v1pb.ClassificationSuggestion{
+			ClassificationId: suggestion.ClassificationId,
+			SemanticTypeId:   suggestion.SemanticTypeId,
+			Reason:           suggestion.Reason,
+			Rejected:         suggestion.Rejected,
+		}
+	}
+	return columnConfig
 }
 
 func convertV1DatabaseMetadata(ctx context.Context, metadata *v1pb.DatabaseMetadata, optionalStores *store.Store) (*storepb.DatabaseSchemaMetadata, *storepb.DatabaseConfig, error) {
@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -26,6 +27,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -64,19 +66,27 @@ type DatabaseService struct {
 	v1pb.UnimplementedDatabaseServiceServer
 	store          *store.Store
 	schemaSyncer   *schemasync.Syncer
+	sheetManager   *sheet.Manager
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	planService    *PlanService
+	issueService   *IssueService
+	rolloutService *RolloutService
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, sheetManager *sheet.Manager, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, planService *PlanService, issueService *IssueService, rolloutService *RolloutService) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		schemaSyncer:   schemaSyncer,
+		sheetManager:   sheetManager,
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		planService:    planService,
+		issueService:   issueService,
+		rolloutService: rolloutService,
 	}
 }
 
@@ -766,6 +776,7 @@ func (s *DatabaseService) UpdateDatabaseMetadata(ctx context.Context, request *v
 				SchemaConfigs:            databaseMetadata.GetSchemaConfigs(),
 				ClassificationFromConfig: databaseMetadata.ClassificationFromConfig,
 			}, nil /* optionalStores */)
+			keepClassificationSuggestions(databaseConfig, dbSchema.GetConfig())
 			if err := s.store.UpdateDBSchema(ctx, database.UID, &store.UpdateDBSchemaMessage{Config: databaseConfig}, principalID); err != nil {
 				return nil, err
 			}
@@ -788,6 +799,112 @@ func (s *DatabaseService) UpdateDatabaseMetadata(ctx context.Context, request *v
 	return v1pbMetadata, nil
 }
 
+// keepClassificationSuggestions keeps the classification suggestions of the old config in the new config,
+// since the suggestions are output only. The pending suggestion is dropped if the column has been classified.
+func keepClassificationSuggestions(config *storepb.DatabaseConfig, oldConfig *storepb.DatabaseConfig) {
+	suggestions := make(map[string]*storepb.ClassificationSuggestion)
+	for _, schema := range oldConfig.GetSchemaConfigs() {
+		for _, table := range schema.TableConfigs {
+			for _, column := range table.ColumnConfigs {
+				if column.ClassificationSuggestion != nil {
+					suggestions[fmt.Sprintf("%s/%s/%s", schema.Name, table.Name, column.Name)] = column.ClassificationSuggestion
+				}
+			}
+		}
+	}
+	for _, schema := range config.GetSchemaConfigs() {
+		for _, table := range schema.TableConfigs {
+			for _, column := range table.ColumnConfigs {
+				suggestion := suggestions[fmt.Sprintf("%s/%s/%s", schema.Name, table.Name, column.Name)]
+				if suggestion == nil || (!suggestion.Rejected && column.ClassificationId != "") {
+					continue
+				}
+				column.ClassificationSuggestion = suggestion
+			}
+		}
+	}
+}
+
+// ReviewClassificationSuggestions approves or rejects the classification suggestions of the columns.
+func (s *DatabaseService) ReviewClassificationSuggestions(ctx context.Context, request *v1pb.ReviewClassificationSuggestionsRequest) (*v1pb.DatabaseMetadata, error) {
+	if request.Action == v1pb.ReviewClassificationSuggestionsRequest_ACTION_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "action is required")
+	}
+	if len(request.Columns) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "columns are required")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.Name, common.MetadataSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %s, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database schema metadata not found")
+	}
+
+	config := model.NewDatabaseConfig(proto.Clone(dbSchema.GetConfig()).(*storepb.DatabaseConfig))
+	for _, column := range request.Columns {
+		columnConfig := config.CreateOrGetSchemaConfig(column.Schema).CreateOrGetTableConfig(column.Table).CreateOrGetColumnConfig(column.Column)
+		suggestion := columnConfig.ClassificationSuggestion
+		if suggestion == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "column %q has no classification suggestion", strings.Join([]string{column.Schema, column.Table, column.Column}, "."))
+		}
+		switch request.Action {
+		case v1pb.ReviewClassificationSuggestionsRequest_APPROVE:
+			columnConfig.ClassificationId = suggestion.ClassificationId
+			if columnConfig.SemanticTypeId == "" {
+				columnConfig.SemanticTypeId = suggestion.SemanticTypeId
+			}
+			columnConfig.ClassificationSuggestion = nil
+		case v1pb.ReviewClassificationSuggestionsRequest_REJECT:
+			suggestion.Rejected = true
+		}
+	}
+	if err := s.store.UpdateDBSchema(ctx, database.UID, &store.UpdateDBSchemaMessage{Config: config.BuildDatabaseConfig()}, principalID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update database config: %v", err)
+	}
+
+	dbSchema, err = s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.NotFound, "database schema %q not found", databaseName)
+	}
+	v1pbMetadata, err := convertStoreDatabaseMetadata(ctx, dbSchema.GetMetadata(), dbSchema.GetConfig(), nil /* filter */, nil /* optionalStores */)
+	if err != nil {
+		return nil, err
+	}
+	v1pbMetadata.Name = fmt.Sprintf("%s%s/%s%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName, common.MetadataSuffix)
+	return v1pbMetadata, nil
+}
+
 // GetDatabaseSchema gets the schema of a database.
 func (s *DatabaseService) GetDatabaseSchema(ctx context.Context, request *v1pb.GetDatabaseSchemaRequest) (*v1pb.DatabaseSchema, error) {
 	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.Name, common.SchemaSuffix)
@@ -0,0 +1,266 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ResolveSchemaDrift creates an issue to resolve the schema drift of a database.
+// The issue either establishes the drifted schema as the new baseline, or applies the DDL
+// reverting the drifted schema to the schema recorded by the latest change history.
+func (s *DatabaseService) ResolveSchemaDrift(ctx context.Context, request *v1pb.ResolveSchemaDriftRequest) (*v1pb.ResolveSchemaDriftResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	if request.Resolution == v1pb.ResolveSchemaDriftRequest_RESOLUTION_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "resolution is required")
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureSchemaDrift, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database %q, error: %v", databaseName, err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+
+	// Sync the database schema so that the drift anomaly reflects the live schema.
+	if err := s.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync database schema, error: %v", err)
+	}
+	drift, err := s.getSchemaDrift(ctx, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get schema drift, error: %v", err)
+	}
+	if drift == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no schema drift detected for database %q", databaseName)
+	}
+
+	revertStatement, err := getSchemaDriftRevertStatement(instance.Engine, drift)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate revert statement, error: %v", err)
+	}
+	response := &v1pb.ResolveSchemaDriftResponse{
+		RevertStatement: revertStatement,
+	}
+	if request.ValidateOnly {
+		return response, nil
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q, error: %v", database.ProjectID, err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	// The issue is checked by the ACL interceptor, and the plan and the rollout are created on behalf of the user.
+	for _, permission := range []iam.Permission{iam.PermissionPlansCreate, iam.PermissionRolloutsCreate} {
+		ok, err := s.iamManager.CheckPermission(ctx, permission, user, project.ResourceID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied, user does not have permission %q", permission)
+		}
+	}
+
+	config := &v1pb.Plan_ChangeDatabaseConfig{
+		Target: common.FormatDatabase(instance.ResourceID, database.DatabaseName),
+	}
+	var title, description string
+	var sheetUID int
+	switch request.Resolution {
+	case v1pb.ResolveSchemaDriftRequest_BASELINE:
+		config.Type = v1pb.Plan_ChangeDatabaseConfig_BASELINE
+		title = fmt.Sprintf("Establish new baseline for drifted database %q", database.DatabaseName)
+		description = fmt.Sprintf("Accept the schema drift detected after version %q as the new baseline.", drift.Version)
+	case v1pb.ResolveSchemaDriftRequest_REVERT:
+		if revertStatement == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "no statement is needed to revert the schema drift of database %q", databaseName)
+		}
+		sheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+			CreatorID:  user.ID,
+			ProjectUID: project.UID,
+			Title:      fmt.Sprintf("Sheet for reverting schema drift of database %q", database.DatabaseName),
+			Statement:  revertStatement,
+
+			Payload: &storepb.SheetPayload{
+				Engine: instance.Engine,
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+		}
+		sheetUID = sheet.UID
+		config.Type = v1pb.Plan_ChangeDatabaseConfig_MIGRATE
+		config.Sheet = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.SheetIDPrefix, sheet.UID)
+		title = fmt.Sprintf("Revert schema drift of database %q", database.DatabaseName)
+		description = fmt.Sprintf("Revert the schema drift detected after version %q.", drift.Version)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported resolution %q", request.Resolution)
+	}
+
+	parent := fmt.Sprintf("%s%s", common.ProjectNamePrefix, project.ResourceID)
+	plan, err := s.planService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+		Parent: parent,
+		Plan: &v1pb.Plan{
+			Title: title,
+			Steps: []*v1pb.Plan_Step{
+				{
+					Specs: []*v1pb.Plan_Spec{
+						{
+							Id: uuid.NewString(),
+							Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+								ChangeDatabaseConfig: config,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		s.deleteSchemaDriftResources(ctx, 0 /* planUID */, sheetUID)
+		return nil, err
+	}
+	issue, err := s.issueService.CreateIssue(ctx, &v1pb.CreateIssueRequest{
+		Parent: parent,
+		Issue: &v1pb.Issue{
+			Title:       title,
+			Description: description,
+			Type:        v1pb.Issue_DATABASE_CHANGE,
+			Plan:        plan.Name,
+		},
+	})
+	if err != nil {
+		_, planUID, _ := common.GetProjectIDPlanID(plan.Name)
+		s.deleteSchemaDriftResources(ctx, planUID, sheetUID)
+		return nil, err
+	}
+	// The issue is kept if the rollout fails to be created, the rollout can be created from the issue later.
+	// The issue is returned as well, otherwise the clients retry and create duplicate issues.
+	if _, err := s.rolloutService.CreateRollout(ctx, &v1pb.CreateRolloutRequest{
+		Parent: parent,
+		Rollout: &v1pb.Rollout{
+			Plan: plan.Name,
+		},
+	}); err != nil {
+		slog.Warn("failed to create rollout for schema drift resolution", slog.String("issue", issue.Name), log.BBError(err))
+	}
+
+	response.Issue = issue.Name
+	return response, nil
+}
+
+// deleteSchemaDriftResources deletes the plan and the sheet created for a schema drift resolution whose issue fails to be created,
+// so that they are not left behind without an issue.
+func (s *DatabaseService) deleteSchemaDriftResources(ctx context.Context, planUID int64, sheetUID int) {
+	if planUID != 0 {
+		if err := s.store.DeletePlan(ctx, planUID); err != nil {
+			slog.Error("failed to delete plan of schema drift resolution", slog.Int64("plan", planUID), log.BBError(err))
+		}
+	}
+	if sheetUID != 0 {
+		if err := s.store.DeleteSheet(ctx, sheetUID); err != nil {
+			slog.Error("failed to delete sheet of schema drift resolution", slog.Int("sheet", sheetUID), log.BBError(err))
+		}
+	}
+}
+
+// getSchemaDrift returns the payload of the active schema drift anomaly of the database, or nil if there is none.
+func (s *DatabaseService) getSchemaDrift(ctx context.Context, database *store.DatabaseMessage) (*api.AnomalyDatabaseSchemaDriftPayload, error) {
+	rowStatus := api.Normal
+	anomalies, err := s.store.ListAnomalyV2(ctx, &store.ListAnomalyMessage{
+		RowStatus:   &rowStatus,
+		DatabaseUID: &database.UID,
+		Types:       []api.AnomalyType{api.AnomalyDatabaseSchemaDrift},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(anomalies) == 0 {
+		return nil, nil
+	}
+	payload := &api.AnomalyDatabaseSchemaDriftPayload{}
+	if err := json.Unmarshal([]byte(anomalies[0].Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal schema drift payload")
+	}
+	return payload, nil
+}
+
+// getSchemaDriftRevertStatement returns the DDL statement to revert the actual schema to the expected schema.
+func getSchemaDriftRevertStatement(engine storepb.Engine, drift *api.AnomalyDatabaseSchemaDriftPayload) (string, error) {
+	expect, actual := drift.Expect, drift.Actual
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		var err error
+		if expect, err = transform.SchemaTransform(storepb.Engine_MYSQL, expect); err != nil {
+			return "", errors.Wrapf(err, "failed to convert expected schema to sdl format")
+		}
+		if actual, err = transform.SchemaTransform(storepb.Engine_MYSQL, actual); err != nil {
+			return "", errors.Wrapf(err, "failed to convert actual schema to sdl format")
+		}
+	}
+
+	parserEngine, err := getSchemaDriftParserEngine(engine)
+	if err != nil {
+		return "", err
+	}
+	return base.SchemaDiff(parserEngine, base.DiffContext{
+		IgnoreCaseSensitive: false,
+		StrictMode:          parserEngine != storepb.Engine_ORACLE,
+	}, actual, expect)
+}
+
+func getSchemaDriftParserEngine(engine storepb.Engine) (storepb.Engine, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return storepb.Engine_POSTGRES, nil
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		return storepb.Engine_MYSQL, nil
+	case storepb.Engine_TIDB:
+		return storepb.Engine_TIDB, nil
+	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
+		return storepb.Engine_ORACLE, nil
+	case storepb.Engine_MSSQL:
+		return storepb.Engine_MSSQL, nil
+	default:
+		return storepb.Engine_ENGINE_UNSPECIFIED, errors.Errorf("schema drift is not supported for engine %q", engine)
+	}
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultDatabaseGrowthDuration = 30 * 24 * time.Hour
+	// sizeLimitForecastHorizon is the farthest time to forecast hitting the size limit.
+	sizeLimitForecastHorizon = 10 * 365 * 24 * time.Hour
+)
+
+// GetDatabaseGrowth gets the size history and growth trend of a database.
+func (s *DatabaseService) GetDatabaseGrowth(ctx context.Context, request *v1pb.GetDatabaseGrowthRequest) (*v1pb.DatabaseGrowth, error) {
+	name, err := common.TrimSuffix(request.Name, "/growth")
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database %q, error: %v", databaseName, err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+
+	startTs := time.Now().Add(-defaultDatabaseGrowthDuration).Unix()
+	if request.StartTime != nil {
+		startTs = request.StartTime.GetSeconds()
+	}
+	histories, err := s.store.ListDatabaseSizeHistory(ctx, &store.FindDatabaseSizeHistoryMessage{
+		DatabaseUID: database.UID,
+		StartTs:     &startTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list database size history, error: %v", err)
+	}
+
+	growth := convertToDatabaseGrowth(histories, request.DatabaseSizeLimit, request.TableSizeLimit)
+	growth.Name = fmt.Sprintf("%s/growth", common.FormatDatabase(instance.ResourceID, database.DatabaseName))
+	return growth, nil
+}
+
+func convertToDatabaseGrowth(histories []*store.DatabaseSizeHistoryMessage, databaseSizeLimit, tableSizeLimit int64) *v1pb.DatabaseGrowth {
+	type tableKey struct {
+		schema string
+		table  string
+	}
+	var databasePoints []*v1pb.SizeTrend_Point
+	var tableKeys []tableKey
+	tablePoints := make(map[tableKey][]*v1pb.SizeTrend_Point)
+	for _, history := range histories {
+		sampleTime := timestamppb.New(time.Unix(history.CreatedTs, 0))
+		databasePoints = append(databasePoints, &v1pb.SizeTrend_Point{
+			Time:      sampleTime,
+			RowCount:  history.Payload.GetRowCount(),
+			DataSize:  history.Payload.GetDataSize(),
+			IndexSize: history.Payload.GetIndexSize(),
+		})
+		for _, table := range history.Payload.GetTables() {
+			key := tableKey{schema: table.Schema, table: table.Name}
+			if _, ok := tablePoints[key]; !ok {
+				tableKeys = append(tableKeys, key)
+			}
+			tablePoints[key] = append(tablePoints[key], &v1pb.SizeTrend_Point{
+				Time:      sampleTime,
+				RowCount:  table.RowCount,
+				DataSize:  table.DataSize,
+				IndexSize: table.IndexSize,
+			})
+		}
+	}
+
+	growth := &v1pb.DatabaseGrowth{
+		Trend: buildSizeTrend(databasePoints, databaseSizeLimit),
+	}
+	for _, key := range tableKeys {
+		growth.Tables = append(growth.Tables, &v1pb.TableSizeTrend{
+			Schema: key.schema,
+			Table:  key.table,
+			Trend:  buildSizeTrend(tablePoints[key], tableSizeLimit),
+		})
+	}
+	slices.SortStableFunc(growth.Tables, func(x, y *v1pb.TableSizeTrend) int {
+		switch {
+		case x.Trend.SizeGrowthPerDay > y.Trend.SizeGrowthPerDay:
+			return -1
+		case x.Trend.SizeGrowthPerDay < y.Trend.SizeGrowthPerDay:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return growth
+}
+
+// buildSizeTrend computes the growth rates with a least squares linear regression over the points,
+// and forecasts when the total size will hit the size limit within the forecast horizon.
+func buildSizeTrend(points []*v1pb.SizeTrend_Point, sizeLimit int64) *v1pb.SizeTrend {
+	trend := &v1pb.SizeTrend{
+		Points: points,
+	}
+	if len(points) < 2 {
+		return trend
+	}
+
+	days := make([]float64, len(points))
+	sizes := make([]float64, len(points))
+	rows := make([]float64, len(points))
+	for i, point := range points {
+		days[i] = point.Time.AsTime().Sub(points[0].Time.AsTime()).Hours() / 24
+		sizes[i] = float64(point.DataSize + point.IndexSize)
+		rows[i] = float64(point.RowCount)
+	}
+	trend.SizeGrowthPerDay = linearRegressionSlope(days, sizes)
+	trend.RowGrowthPerDay = linearRegressionSlope(days, rows)
+
+	if sizeLimit <= 0 || trend.SizeGrowthPerDay <= 0 {
+		return trend
+	}
+	last := points[len(points)-1]
+	remaining := float64(sizeLimit - last.DataSize - last.IndexSize)
+	if remaining <= 0 {
+		trend.SizeLimitTime = last.Time
+		return trend
+	}
+	// Compare in float64 before converting, the remaining duration of a slow growth overflows time.Duration.
+	remainingHours := remaining / trend.SizeGrowthPerDay * 24
+	if remainingHours > sizeLimitForecastHorizon.Hours() {
+		return trend
+	}
+	trend.SizeLimitTime = timestamppb.New(last.Time.AsTime().Add(time.Duration(remainingHours * float64(time.Hour))))
+	return trend
+}
+
+func linearRegressionSlope(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var numerator, denominator float64
+	for i := range xs {
+		numerator += (xs[i] - meanX) * (ys[i] - meanY)
+		denominator += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -138,3 +140,121 @@ func TestGetDatabasesFromExpression(t *testing.T) {
 		require.Equal(t, tc.want, got)
 	}
 }
+
+func TestGetSchemaDriftRevertStatement(t *testing.T) {
+	a := require.New(t)
+	drift := &api.AnomalyDatabaseSchemaDriftPayload{
+		Version: "0001",
+		Expect:  "CREATE TABLE public.t (id integer NOT NULL);\n",
+		Actual:  "CREATE TABLE public.t (id integer NOT NULL, name text);\n",
+	}
+	statement, err := getSchemaDriftRevertStatement(storepb.Engine_POSTGRES, drift)
+	a.NoError(err)
+	a.Contains(statement, "DROP COLUMN")
+	a.Contains(statement, "name")
+
+	drift.Actual = drift.Expect
+	statement, err = getSchemaDriftRevertStatement(storepb.Engine_POSTGRES, drift)
+	a.NoError(err)
+	a.Empty(statement)
+
+	_, err = getSchemaDriftRevertStatement(storepb.Engine_MONGODB, drift)
+	a.Error(err)
+}
+
+func TestConvertToDatabaseGrowth(t *testing.T) {
+	a := require.New(t)
+	const day = int64(24 * 60 * 60)
+	histories := []*store.DatabaseSizeHistoryMessage{
+		{
+			CreatedTs: 0,
+			Payload: &storepb.DatabaseSizeSnapshot{
+				RowCount: 100, DataSize: 1000, IndexSize: 0,
+				Tables: []*storepb.TableSizeSnapshot{
+					{Schema: "public", Name: "t1", RowCount: 50, DataSize: 500},
+					{Schema: "public", Name: "t2", RowCount: 50, DataSize: 500},
+				},
+			},
+		},
+		{
+			CreatedTs: day,
+			Payload: &storepb.DatabaseSizeSnapshot{
+				RowCount: 110, DataSize: 1100, IndexSize: 100,
+				Tables: []*storepb.TableSizeSnapshot{
+					{Schema: "public", Name: "t1", RowCount: 50, DataSize: 500},
+					{Schema: "public", Name: "t2", RowCount: 60, DataSize: 600, IndexSize: 100},
+				},
+			},
+		},
+		{
+			CreatedTs: 2 * day,
+			Payload: &storepb.DatabaseSizeSnapshot{
+				RowCount: 120, DataSize: 1200, IndexSize: 200,
+				Tables: []*storepb.TableSizeSnapshot{
+					{Schema: "public", Name: "t1", RowCount: 50, DataSize: 500},
+					{Schema: "public", Name: "t2", RowCount: 70, DataSize: 700, IndexSize: 200},
+				},
+			},
+		},
+	}
+
+	growth := convertToDatabaseGrowth(histories, 2400, 0)
+	a.Len(growth.Trend.Points, 3)
+	a.InDelta(200, growth.Trend.SizeGrowthPerDay, 1e-9)
+	a.InDelta(10, growth.Trend.RowGrowthPerDay, 1e-9)
+	// 1400 bytes at day 2, growing 200 bytes per day, hits 2400 bytes at day 7.
+	a.Equal(7*day, growth.Trend.SizeLimitTime.GetSeconds())
+
+	a.Len(growth.Tables, 2)
+	a.Equal("t2", growth.Tables[0].Table)
+	a.InDelta(200, growth.Tables[0].Trend.SizeGrowthPerDay, 1e-9)
+	a.Nil(growth.Tables[0].Trend.SizeLimitTime)
+	a.Equal("t1", growth.Tables[1].Table)
+	a.Zero(growth.Tables[1].Trend.SizeGrowthPerDay)
+
+	// The size limit had been hit.
+	growth = convertToDatabaseGrowth(histories, 1000, 0)
+	a.Equal(2*day, growth.Trend.SizeLimitTime.GetSeconds())
+
+	// The size limit beyond the forecast horizon is not forecast, instead of overflowing the duration.
+	growth = convertToDatabaseGrowth(histories, 1<<62, 0)
+	a.Nil(growth.Trend.SizeLimitTime)
+	growth = convertToDatabaseGrowth(histories, 1400+200*3650, 0)
+	a.Equal((2+3650)*day, growth.Trend.SizeLimitTime.GetSeconds())
+}
+
+func TestKeepClassificationSuggestions(t *testing.T) {
+	pending := &storepb.ClassificationSuggestion{ClassificationId: "1-1", Reason: "pending"}
+	rejected := &storepb.ClassificationSuggestion{ClassificationId: "1-2", Reason: "rejected", Rejected: true}
+	oldConfig := &storepb.DatabaseConfig{
+		SchemaConfigs: []*storepb.SchemaConfig{{
+			TableConfigs: []*storepb.TableConfig{{
+				Name: "t",
+				ColumnConfigs: []*storepb.ColumnConfig{
+					{Name: "email", ClassificationSuggestion: pending},
+					{Name: "phone", ClassificationSuggestion: pending},
+					{Name: "card", ClassificationSuggestion: rejected},
+				},
+			}},
+		}},
+	}
+	config := &storepb.DatabaseConfig{
+		SchemaConfigs: []*storepb.SchemaConfig{{
+			TableConfigs: []*storepb.TableConfig{{
+				Name: "t",
+				ColumnConfigs: []*storepb.ColumnConfig{
+					{Name: "email"},
+					{Name: "phone", ClassificationId: "1-3"},
+					{Name: "card", ClassificationId: "1-3"},
+				},
+			}},
+		}},
+	}
+
+	keepClassificationSuggestions(config, oldConfig)
+	a := require.New(t)
+	columns := config.SchemaConfigs[0].TableConfigs[0].ColumnConfigs
+	a.Equal(pending, columns[0].ClassificationSuggestion)
+	a.Nil(columns[1].ClassificationSuggestion)
+	a.Equal(rejected, columns[2].ClassificationSuggestion)
+}
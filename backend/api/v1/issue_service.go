@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -620,6 +621,18 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 			return nil, status.Errorf(codes.InvalidArgument, "found issues in grant request condition expression, issues: %v", issues.String())
 		}
 	}
+	if expiration := request.Issue.GrantRequest.GetExpiration(); expiration != nil {
+		if expiration.AsDuration() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "grant request expiration must be positive")
+		}
+		generalSetting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace general setting, error: %v", err)
+		}
+		if maximum := generalSetting.GetMaximumRoleExpiration(); maximum != nil && expiration.AsDuration() > maximum.AsDuration() {
+			return nil, status.Errorf(codes.InvalidArgument, "grant request expiration %v exceeds the maximum role expiration %v", expiration.AsDuration(), maximum.AsDuration())
+		}
+	}
 
 	issueCreateMessage := &store.IssueMessage{
 		Project:     project,
@@ -838,18 +851,20 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(ctx, s.store, step, user, policy)
+	delegator, err := checkUserCanReviewStep(ctx, s.store, issue, step, user, policy)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check if principal can approve step, error: %v", err)
-	}
-	if !canApprove {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot approve because the user does not have the required permission")
+		return nil, err
 	}
 
-	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+	stepIndex, _, _, _ := utils.FindPendingStepApprovers(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers)
+	approver := &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
 		PrincipalId: int32(principalID),
-	})
+	}
+	if delegator != nil {
+		approver.DelegatorId = int32(delegator.ID)
+	}
+	payload.Approval.Approvers = append(payload.Approval.Approvers, approver)
 
 	approved, err := utils.CheckApprovalApproved(payload.Approval)
 	if err != nil {
@@ -861,6 +876,11 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		return nil, status.Errorf(codes.Internal, "failed to handle incoming approval steps, error: %v", err)
 	}
 	payload.Approval.Approvers = append(payload.Approval.Approvers, newApprovers...)
+	newStepIndex, _, _, _ := utils.FindPendingStepApprovers(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers)
+	stepChanged := newStepIndex != stepIndex
+	if stepChanged {
+		payload.Approval.StepPendingTime = timestamppb.Now()
+	}
 
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
@@ -908,6 +928,10 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		if len(payload.Approval.ApprovalTemplates) != 1 {
 			return nil
 		}
+		// Only notify the approvers when the flow moves on to the next step.
+		if !stepChanged {
+			return nil
+		}
 		approvalStep := utils.FindNextPendingStep(payload.Approval.ApprovalTemplates[0], payload.Approval.Approvers)
 		if approvalStep == nil {
 			return nil
@@ -1052,17 +1076,18 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		return nil, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
 	}
 
-	canApprove, err := isUserReviewer(ctx, s.store, step, user, policy)
+	delegator, err := checkUserCanReviewStep(ctx, s.store, issue, step, user, policy)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check if principal can reject step, error: %v", err)
-	}
-	if !canApprove {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot reject because the user does not have the required permission")
+		return nil, err
 	}
-	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+	approver := &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
 		PrincipalId: int32(principalID),
-	})
+	}
+	if delegator != nil {
+		approver.DelegatorId = int32(delegator.ID)
+	}
+	payload.Approval.Approvers = append(payload.Approval.Approvers, approver)
 
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
@@ -1091,6 +1116,14 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		slog.Warn("failed to create issue comment", log.BBError(err))
 	}
 
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   user,
+		Type:    webhook.EventTypeIssueApprovalReject,
+		Comment: request.Comment,
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+	})
+
 	issueV1, err := convertToIssue(ctx, s.store, issue)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
@@ -1145,6 +1178,7 @@ func (s *IssueService) RequestIssue(ctx context.Context, request *v1pb.RequestIs
 		newApprovers = append(newApprovers, approver)
 	}
 	payload.Approval.Approvers = newApprovers
+	payload.Approval.StepPendingTime = timestamppb.Now()
 
 	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
 	if err != nil {
@@ -1701,6 +1735,69 @@ func canRequestIssue(issueCreator *store.UserMessage, user *store.UserMessage) b
 	return issueCreator.ID == user.ID
 }
 
+// checkUserCanReviewStep checks if the user can approve or reject the pending step of the issue approval flow,
+// either as a qualified approver or as the out-of-office delegate of a qualified approver.
+// It returns the delegator if the user reviews on behalf of the delegator.
+func checkUserCanReviewStep(ctx context.Context, stores *store.Store, issue *store.IssueMessage, step *storepb.ApprovalStep, user *store.UserMessage, policy *storepb.ProjectIamPolicy) (*store.UserMessage, error) {
+	if err := checkReviewerDuties(issue, user); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	ok, err := isUserReviewer(ctx, stores, step, user, policy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if principal can review step, error: %v", err)
+	}
+	if ok {
+		return nil, nil
+	}
+
+	delegators, err := stores.ListUsers(ctx, &store.FindUserMessage{ApprovalDelegateID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list delegators, error: %v", err)
+	}
+	now := time.Now()
+	for _, delegator := range delegators {
+		if utils.GetApprovalDelegateID(delegator, now) != user.ID {
+			continue
+		}
+		if err := checkReviewerDuties(issue, delegator); err != nil {
+			continue
+		}
+		ok, err := isUserReviewer(ctx, stores, step, delegator, policy)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check if principal can review step, error: %v", err)
+		}
+		if ok {
+			return delegator, nil
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "the user does not have the required permission to review the step")
+}
+
+// checkReviewerDuties checks if the reviewer, either reviewing in person or through the delegate, may review the pending step of the issue.
+// A reviewer cannot review a step twice, and cannot review the issue created by or the steps following the approvals of
+// the reviewer if the approval flow requires the separation of duties.
+func checkReviewerDuties(issue *store.IssueMessage, reviewer *store.UserMessage) error {
+	template := issue.Payload.Approval.ApprovalTemplates[0]
+	_, _, stepApprovers, previousApprovers := utils.FindPendingStepApprovers(template, issue.Payload.Approval.Approvers)
+	for _, approver := range stepApprovers {
+		if int(approver.PrincipalId) == reviewer.ID || int(approver.DelegatorId) == reviewer.ID {
+			return errors.Errorf("user %q has already reviewed the step", reviewer.Email)
+		}
+	}
+	if !template.GetFlow().GetSeparationOfDuties() {
+		return nil
+	}
+	if reviewer.ID == issue.Creator.ID {
+		return errors.Errorf("user %q is the issue creator and cannot review the issue by separation of duties", reviewer.Email)
+	}
+	for _, approver := range previousApprovers {
+		if int(approver.PrincipalId) == reviewer.ID || int(approver.DelegatorId) == reviewer.ID {
+			return errors.Errorf("user %q has approved the previous step and cannot review the step by separation of duties", reviewer.Email)
+		}
+	}
+	return nil
+}
+
 func isUserReviewer(ctx context.Context, stores *store.Store, step *storepb.ApprovalStep, user *store.UserMessage, policy *storepb.ProjectIamPolicy) (bool, error) {
 	if len(step.Nodes) != 1 {
 		return false, errors.Errorf("expecting one node but got %v", len(step.Nodes))
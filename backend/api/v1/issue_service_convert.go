@@ -94,6 +94,13 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 				return nil, errors.Wrapf(err, "failed to find user by id %v", approver.PrincipalId)
 			}
 			convertedApprover.Principal = fmt.Sprintf("users/%s", user.Email)
+			if approver.DelegatorId != 0 {
+				delegator, err := s.GetUserByID(ctx, int(approver.DelegatorId))
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to find user by id %v", approver.DelegatorId)
+				}
+				convertedApprover.Delegator = fmt.Sprintf("users/%s", delegator.Email)
+			}
 			issueV1.Approvers = append(issueV1.Approvers, convertedApprover)
 		}
 	}
@@ -235,7 +242,9 @@ func convertToApprovalTemplate(template *storepb.ApprovalTemplate) *v1pb.Approva
 }
 
 func convertToApprovalFlow(flow *storepb.ApprovalFlow) *v1pb.ApprovalFlow {
-	convertedFlow := &v1pb.ApprovalFlow{}
+	convertedFlow := &v1pb.ApprovalFlow{
+		SeparationOfDuties: flow.SeparationOfDuties,
+	}
 	for _, step := range flow.Steps {
 		convertedFlow.Steps = append(convertedFlow.Steps, convertToApprovalStep(step))
 	}
@@ -244,7 +253,9 @@ func convertToApprovalFlow(flow *storepb.ApprovalFlow) *v1pb.ApprovalFlow {
 
 func convertToApprovalStep(step *storepb.ApprovalStep) *v1pb.ApprovalStep {
 	convertedStep := &v1pb.ApprovalStep{
-		Type: v1pb.ApprovalStep_Type(step.Type),
+		Type:    v1pb.ApprovalStep_Type(step.Type),
+		Quorum:  step.Quorum,
+		Timeout: step.Timeout,
 	}
 	for _, node := range step.Nodes {
 		convertedStep.Nodes = append(convertedStep.Nodes, convertToApprovalNode(node))
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -38,3 +39,58 @@ func TestConvertToApprovalNode(t *testing.T) {
 }
 
 // TODO(p0ny): update tests for isUserReviewer
+
+func TestCheckReviewerDuties(t *testing.T) {
+	a := require.New(t)
+	creator := &store.UserMessage{ID: 101, Email: "creator@example.com"}
+	alice := &store.UserMessage{ID: 102, Email: "alice@example.com"}
+	bob := &store.UserMessage{ID: 103, Email: "bob@example.com"}
+	carol := &store.UserMessage{ID: 104, Email: "carol@example.com"}
+	template := &storepb.ApprovalTemplate{
+		Flow: &storepb.ApprovalFlow{
+			Steps: []*storepb.ApprovalStep{
+				{Type: storepb.ApprovalStep_ANY},
+				{Type: storepb.ApprovalStep_ANY, Quorum: 2},
+			},
+		},
+	}
+	newIssue := func(approvers ...*storepb.IssuePayloadApproval_Approver) *store.IssueMessage {
+		return &store.IssueMessage{
+			Creator: creator,
+			Payload: &storepb.IssuePayload{
+				Approval: &storepb.IssuePayloadApproval{
+					ApprovalTemplates: []*storepb.ApprovalTemplate{template},
+					Approvers:         approvers,
+				},
+			},
+		}
+	}
+	approved := func(principalID, delegatorID int) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{
+			Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+			PrincipalId: int32(principalID),
+			DelegatorId: int32(delegatorID),
+		}
+	}
+
+	// Without the separation of duties, the creator may review, but nobody reviews a step twice.
+	issue := newIssue(approved(alice.ID, 0))
+	a.NoError(checkReviewerDuties(issue, creator))
+	a.NoError(checkReviewerDuties(issue, alice))
+	issue = newIssue(approved(alice.ID, 0), approved(bob.ID, 0))
+	a.ErrorContains(checkReviewerDuties(issue, bob), "already reviewed")
+	// The delegate approval counts for the delegator in the quorum.
+	issue = newIssue(approved(alice.ID, 0), approved(carol.ID, bob.ID))
+	a.ErrorContains(checkReviewerDuties(issue, bob), "already reviewed")
+	a.ErrorContains(checkReviewerDuties(issue, carol), "already reviewed")
+
+	template.Flow.SeparationOfDuties = true
+	issue = newIssue()
+	a.ErrorContains(checkReviewerDuties(issue, creator), "issue creator")
+	a.NoError(checkReviewerDuties(issue, alice))
+	// The approvers of the previous steps, in person or through the delegates, cannot review the following steps.
+	issue = newIssue(approved(carol.ID, alice.ID))
+	a.ErrorContains(checkReviewerDuties(issue, alice), "previous step")
+	a.ErrorContains(checkReviewerDuties(issue, carol), "previous step")
+	a.NoError(checkReviewerDuties(issue, bob))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -626,6 +627,19 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", status.Errorf(codes.InvalidArgument, err.Error())
 		}
 		return payload.String()
+	case v1pb.PolicyType_QUERY_GUARD:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := s.convertToStorePBQueryGuardPolicy(ctx, policy.GetQueryGuardPolicy())
+		if err != nil {
+			return "", err
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal query guard policy")
+		}
+		return string(payloadBytes), nil
 	}
 
 	return "", status.Errorf(codes.InvalidArgument, "invalid policy %v", policy.Type)
@@ -723,6 +737,19 @@ func (s *OrgPolicyService) convertToPolicy(ctx context.Context, parentPath strin
 			return nil, err
 		}
 		policy.Policy = payload
+	case api.PolicyTypeQueryGuard:
+		pType = v1pb.PolicyType_QUERY_GUARD
+		queryGuardPolicy := &storepb.QueryGuardPolicy{}
+		if err := protojson.Unmarshal([]byte(policyMessage.Payload), queryGuardPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal query guard policy")
+		}
+		payload, err := s.convertToV1PBQueryGuardPolicy(ctx, queryGuardPolicy)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert query guard policy")
+		}
+		policy.Policy = &v1pb.Policy_QueryGuardPolicy{
+			QueryGuardPolicy: payload,
+		}
 	}
 
 	policy.Type = pType
@@ -1067,6 +1094,67 @@ func (s *OrgPolicyService) convertToV1PBMaskingExceptionPolicyPayload(ctx contex
 	}, nil
 }
 
+func (s *OrgPolicyService) convertToStorePBQueryGuardPolicy(ctx context.Context, policy *v1pb.QueryGuardPolicy) (*storepb.QueryGuardPolicy, error) {
+	if policy.MaximumEstimatedRows < 0 || policy.MaximumEstimatedCost < 0 || policy.MaximumEstimatedBytes < 0 || policy.MaximumFullScanRows < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the limits of query guard policy must be non-negative")
+	}
+	if policy.ExemptionRequestUrl != "" {
+		if _, err := url.ParseRequestURI(policy.ExemptionRequestUrl); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid exemption request url %q", policy.ExemptionRequestUrl)
+		}
+	}
+	var exemptMembers []string
+	for _, member := range policy.ExemptMembers {
+		if !strings.HasPrefix(member, "user:") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid member %s", member)
+		}
+		user, err := s.store.GetUserByEmail(ctx, strings.TrimPrefix(member, "user:"))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user %q with error: %v", member, err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user %q not found", member)
+		}
+		exemptMembers = append(exemptMembers, common.FormatUserUID(user.ID))
+	}
+	return &storepb.QueryGuardPolicy{
+		MaximumEstimatedRows:      policy.MaximumEstimatedRows,
+		MaximumEstimatedCost:      policy.MaximumEstimatedCost,
+		MaximumEstimatedBytes:     policy.MaximumEstimatedBytes,
+		MaximumFullScanRows:       policy.MaximumFullScanRows,
+		ExemptMembers:             exemptMembers,
+		ExemptionRequestUrl:       policy.ExemptionRequestUrl,
+		RejectOnEstimationFailure: policy.RejectOnEstimationFailure,
+	}, nil
+}
+
+func (s *OrgPolicyService) convertToV1PBQueryGuardPolicy(ctx context.Context, policy *storepb.QueryGuardPolicy) (*v1pb.QueryGuardPolicy, error) {
+	var exemptMembers []string
+	for _, member := range policy.ExemptMembers {
+		uid, err := common.GetUserID(member)
+		if err != nil {
+			return nil, err
+		}
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			continue
+		}
+		exemptMembers = append(exemptMembers, fmt.Sprintf("user:%s", user.Email))
+	}
+	return &v1pb.QueryGuardPolicy{
+		MaximumEstimatedRows:      policy.MaximumEstimatedRows,
+		MaximumEstimatedCost:      policy.MaximumEstimatedCost,
+		MaximumEstimatedBytes:     policy.MaximumEstimatedBytes,
+		MaximumFullScanRows:       policy.MaximumFullScanRows,
+		ExemptMembers:             exemptMembers,
+		ExemptionRequestUrl:       policy.ExemptionRequestUrl,
+		RejectOnEstimationFailure: policy.RejectOnEstimationFailure,
+	}, nil
+}
+
 func convertToV1PBRestrictIssueCreationForSQLReviewPolicy(payloadStr string) (*v1pb.Policy_RestrictIssueCreationForSqlReviewPolicy, error) {
 	payload, err := api.UnmarshalRestrictIssueCreationForSQLReviewPolicy(payloadStr)
 	if err != nil {
@@ -1104,6 +1192,8 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeDisableCopyData, nil
 	case v1pb.PolicyType_RESTRICT_ISSUE_CREATION_FOR_SQL_REVIEW.String():
 		return api.PolicyTypeRestrictIssueCreationForSQLReview, nil
+	case v1pb.PolicyType_QUERY_GUARD.String():
+		return api.PolicyTypeQueryGuard, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
@@ -236,6 +236,7 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRe
 			VcsConnector:   request.GetPlan().GetVcsSource().GetVcsConnector(),
 			PullRequestUrl: request.GetPlan().GetVcsSource().GetPullRequestUrl(),
 			VcsType:        storepb.VCSType(request.GetPlan().GetVcsSource().VcsType),
+			CommitSha:      request.GetPlan().GetVcsSource().GetCommitSha(),
 		}
 	}
 
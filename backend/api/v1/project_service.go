@@ -26,6 +26,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/iam"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -1167,6 +1168,8 @@ func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error)
 			result = append(result, string(api.ActivityNotifyIssueApproved))
 		case v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, string(api.ActivityNotifyPipelineRollout))
+		case v1pb.Activity_TYPE_NOTIFY_GRANT_EXPIRING:
+			result = append(result, string(api.ActivityNotifyGrantExpiring))
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -1220,6 +1223,8 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_ISSUE_APPROVED)
 		case string(api.ActivityNotifyPipelineRollout):
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT)
+		case string(api.ActivityNotifyGrantExpiring):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_GRANT_EXPIRING)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -1376,6 +1381,14 @@ func (s *ProjectService) convertToV1IamPolicy(ctx context.Context, iamPolicy *st
 			Members:   members,
 			Condition: binding.Condition,
 		}
+		for _, rowFilter := range binding.RowFilters {
+			v1pbBinding.RowFilters = append(v1pbBinding.RowFilters, &v1pb.RowFilter{
+				Database:  rowFilter.Database,
+				Schema:    rowFilter.Schema,
+				Table:     rowFilter.Table,
+				Predicate: rowFilter.Predicate,
+			})
+		}
 		if v1pbBinding.Condition == nil {
 			v1pbBinding.Condition = &expr.Expr{}
 		}
@@ -1433,6 +1446,14 @@ func (s *ProjectService) convertToStoreIamPolicy(ctx context.Context, iamPolicy
 			Members:   members,
 			Condition: binding.Condition,
 		}
+		for _, rowFilter := range binding.RowFilters {
+			storeBinding.RowFilters = append(storeBinding.RowFilters, &storepb.RowFilter{
+				Database:  rowFilter.Database,
+				Schema:    rowFilter.Schema,
+				Table:     rowFilter.Table,
+				Predicate: rowFilter.Predicate,
+			})
+		}
 		if storeBinding.Condition == nil {
 			storeBinding.Condition = &expr.Expr{}
 		}
@@ -1674,7 +1695,42 @@ func (s *ProjectService) validateIAMPolicy(ctx context.Context, policy *v1pb.Iam
 	if generalSetting != nil {
 		maximumRoleExpiration = generalSetting.MaximumRoleExpiration
 	}
-	return s.validateBindings(policy.Bindings, roles, maximumRoleExpiration)
+	if err := s.validateBindings(policy.Bindings, roles, maximumRoleExpiration); err != nil {
+		return err
+	}
+	return s.validateRowFilters(ctx, policy.Bindings)
+}
+
+// validateRowFilters validates the predicates of the row filters by the engines of the databases.
+func (s *ProjectService) validateRowFilters(ctx context.Context, bindings []*v1pb.Binding) error {
+	for _, binding := range bindings {
+		for _, rowFilter := range binding.RowFilters {
+			instanceID, databaseName, err := common.GetInstanceDatabaseID(rowFilter.Database)
+			if err != nil {
+				return errors.Wrapf(err, "invalid row filter database %q", rowFilter.Database)
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+			if err != nil {
+				return errors.Wrapf(err, "failed to get instance %q", instanceID)
+			}
+			if instance == nil {
+				return errors.Errorf("instance %q not found", instanceID)
+			}
+			if !base.SupportRowFilters(instance.Engine) {
+				return errors.Errorf("row filter is not supported for engine %s", instance.Engine)
+			}
+			filter := &base.RowFilter{
+				Database:  databaseName,
+				Schema:    rowFilter.Schema,
+				Table:     rowFilter.Table,
+				Predicate: rowFilter.Predicate,
+			}
+			if _, _, err := base.ApplyRowFilters(instance.Engine, "SELECT 1", databaseName, rowFilter.Schema, []*base.RowFilter{filter}, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (*ProjectService) validateBindings(bindings []*v1pb.Binding, roles []*v1pb.Role, maximumRoleExpiration *durationpb.Duration) error {
@@ -1708,6 +1764,12 @@ func (*ProjectService) validateBindings(bindings []*v1pb.Binding, roles []*v1pb.
 		}
 		projectRoleMap[binding.Role] = true
 
+		for _, rowFilter := range binding.RowFilters {
+			if rowFilter.Database == "" || rowFilter.Table == "" || rowFilter.Predicate == "" {
+				return errors.Errorf("row filter database, table and predicate are required")
+			}
+		}
+
 		if _, err := common.ValidateProjectMemberCELExpr(binding.Condition); err != nil {
 			return err
 		}
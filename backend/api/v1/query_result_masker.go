@@ -296,6 +296,14 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.MaskingAlgorithmSett
 		return masker.NewMD5Masker(m.Md5Mask.Salt)
 	case *storepb.MaskingAlgorithmSetting_Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_TokenizationMask_:
+		return masker.NewTokenizationMasker(m.TokenizationMask.Key)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask_:
+		return masker.NewFPEMasker(m.FormatPreservingEncryptionMask.Key)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+		return masker.NewDateShiftMasker(m.DateShiftMask.Key, m.DateShiftMask.MaxShiftDays)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_NumericPerturbationMask_:
+		return masker.NewNumericPerturbationMasker(m.NumericPerturbationMask.Key, m.NumericPerturbationMask.NoiseRatio)
 	}
 	return masker.NewNoneMasker()
 }
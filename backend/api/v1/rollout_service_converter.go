@@ -44,6 +44,7 @@ func convertToPlan(ctx context.Context, s *store.Store, plan *store.PlanMessage)
 			VcsType:        v1pb.VCSType(plan.Config.GetVcsSource().GetVcsType()),
 			VcsConnector:   plan.Config.GetVcsSource().GetVcsConnector(),
 			PullRequestUrl: plan.Config.GetVcsSource().GetPullRequestUrl(),
+			CommitSha:      plan.Config.GetVcsSource().GetCommitSha(),
 		},
 		CreateTime:              timestamppb.New(time.Unix(plan.CreatedTs, 0)),
 		UpdateTime:              timestamppb.New(time.Unix(plan.UpdatedTs, 0)),
@@ -126,7 +126,7 @@ func (mr *metadataDiffRootNode) tryMerge(other *metadataDiffRootNode) (bool, str
 		}
 		conflict, msg := schema.tryMerge(otherSchema)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("schema %q: %s", schema.name, msg)
 		}
 		delete(other.schemas, schema.name)
 	}
@@ -160,12 +160,16 @@ type metadataDiffSchemaNode struct {
 	base *storepb.SchemaMetadata
 	head *storepb.SchemaMetadata
 
-	tables     map[string]*metadataDiffTableNode
-	views      map[string]*metadataDiffViewNode
-	functions  map[string]*metadataDiffFunctioNnode
-	procedures map[string]*metadataDiffProcedureNode
+	tables            map[string]*metadataDiffTableNode
+	views             map[string]*metadataDiffViewNode
+	materializedViews map[string]*metadataDiffMaterializedViewNode
+	functions         map[string]*metadataDiffFunctioNnode
+	procedures        map[string]*metadataDiffProcedureNode
+	sequences         map[string]*metadataDiffSequenceNode
+	enumTypes         map[string]*metadataDiffEnumTypeNode
 
-	// SchemaMetadata contains other object types, likes function, view etc. But we do not support them yet.
+	// SchemaMetadata contains other object types, likes stream, task etc. But we do not support them yet,
+	// they are kept as is in the target schema.
 }
 
 func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool, string) {
@@ -201,7 +205,7 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 		}
 		conflict, msg := tableNode.tryMerge(otherTableNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("table %q: %s", tableName, msg)
 		}
 		delete(other.tables, tableName)
 	}
@@ -217,7 +221,7 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 		}
 		conflict, msg := viewNode.tryMerge(otherViewNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("view %q: %s", viewName, msg)
 		}
 		delete(other.views, viewName)
 	}
@@ -233,7 +237,7 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 		}
 		conflict, msg := functionNode.tryMerge(otherFunctionNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("function %q: %s", functionName, msg)
 		}
 		delete(other.functions, functionName)
 	}
@@ -249,11 +253,63 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 		}
 		conflict, msg := procedureNode.tryMerge(otherProcedureNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("procedure %q: %s", procedureName, msg)
 		}
 		delete(other.procedures, procedureName)
 	}
 
+	for _, remainingProcedure := range other.procedures {
+		n.procedures[remainingProcedure.name] = remainingProcedure
+	}
+
+	for materializedViewName, materializedViewNode := range n.materializedViews {
+		otherMaterializedViewNode, in := other.materializedViews[materializedViewName]
+		if !in {
+			continue
+		}
+		conflict, msg := materializedViewNode.tryMerge(otherMaterializedViewNode)
+		if conflict {
+			return true, fmt.Sprintf("materialized view %q: %s", materializedViewName, msg)
+		}
+		delete(other.materializedViews, materializedViewName)
+	}
+
+	for _, remainingMaterializedView := range other.materializedViews {
+		n.materializedViews[remainingMaterializedView.name] = remainingMaterializedView
+	}
+
+	for sequenceName, sequenceNode := range n.sequences {
+		otherSequenceNode, in := other.sequences[sequenceName]
+		if !in {
+			continue
+		}
+		conflict, msg := sequenceNode.tryMerge(otherSequenceNode)
+		if conflict {
+			return true, fmt.Sprintf("sequence %q: %s", sequenceName, msg)
+		}
+		delete(other.sequences, sequenceName)
+	}
+
+	for _, remainingSequence := range other.sequences {
+		n.sequences[remainingSequence.name] = remainingSequence
+	}
+
+	for enumTypeName, enumTypeNode := range n.enumTypes {
+		otherEnumTypeNode, in := other.enumTypes[enumTypeName]
+		if !in {
+			continue
+		}
+		conflict, msg := enumTypeNode.tryMerge(otherEnumTypeNode)
+		if conflict {
+			return true, fmt.Sprintf("enum type %q: %s", enumTypeName, msg)
+		}
+		delete(other.enumTypes, enumTypeName)
+	}
+
+	for _, remainingEnumType := range other.enumTypes {
+		n.enumTypes[remainingEnumType.name] = remainingEnumType
+	}
+
 	return false, ""
 }
 
@@ -272,46 +328,92 @@ func (n *metadataDiffSchemaNode) applyDiffTo(target *storepb.DatabaseSchemaMetad
 	for viewName := range n.views {
 		sortedViewNames = append(sortedViewNames, viewName)
 	}
+	slices.Sort(sortedViewNames)
+
+	sortedMaterializedViewNames := make([]string, 0, len(n.materializedViews))
+	for materializedViewName := range n.materializedViews {
+		sortedMaterializedViewNames = append(sortedMaterializedViewNames, materializedViewName)
+	}
+	slices.Sort(sortedMaterializedViewNames)
 
 	sortedFunctionNames := make([]string, 0, len(n.functions))
 	for functionName := range n.functions {
 		sortedFunctionNames = append(sortedFunctionNames, functionName)
 	}
+	slices.Sort(sortedFunctionNames)
 
 	sortedProcedureNames := make([]string, 0, len(n.procedures))
 	for procedureName := range n.procedures {
 		sortedProcedureNames = append(sortedProcedureNames, procedureName)
 	}
+	slices.Sort(sortedProcedureNames)
 
-	switch n.action {
-	case diffActionCreate:
-		newSchema := &storepb.SchemaMetadata{
-			Name: n.name,
-		}
+	sortedSequenceNames := make([]string, 0, len(n.sequences))
+	for sequenceName := range n.sequences {
+		sortedSequenceNames = append(sortedSequenceNames, sequenceName)
+	}
+	slices.Sort(sortedSequenceNames)
+
+	sortedEnumTypeNames := make([]string, 0, len(n.enumTypes))
+	for enumTypeName := range n.enumTypes {
+		sortedEnumTypeNames = append(sortedEnumTypeNames, enumTypeName)
+	}
+	slices.Sort(sortedEnumTypeNames)
+
+	applyObjectDiffs := func(schema *storepb.SchemaMetadata) error {
 		for _, tableName := range sortedTableNames {
 			table := n.tables[tableName]
-			if err := table.applyDiffTo(newSchema); err != nil {
+			if err := table.applyDiffTo(schema); err != nil {
 				return errors.Wrapf(err, "failed to apply diff to table %q", table.name)
 			}
 		}
 		for _, viewName := range sortedViewNames {
 			view := n.views[viewName]
-			if err := view.applyDiffTo(newSchema); err != nil {
+			if err := view.applyDiffTo(schema); err != nil {
 				return errors.Wrapf(err, "failed to apply diff to view %q", view.name)
 			}
 		}
+		for _, materializedViewName := range sortedMaterializedViewNames {
+			materializedView := n.materializedViews[materializedViewName]
+			if err := materializedView.applyDiffTo(schema); err != nil {
+				return errors.Wrapf(err, "failed to apply diff to materialized view %q", materializedView.name)
+			}
+		}
 		for _, functionName := range sortedFunctionNames {
 			function := n.functions[functionName]
-			if err := function.applyDiffTo(newSchema); err != nil {
+			if err := function.applyDiffTo(schema); err != nil {
 				return errors.Wrapf(err, "failed to apply diff to function %q", function.name)
 			}
 		}
 		for _, procedureName := range sortedProcedureNames {
 			procedure := n.procedures[procedureName]
-			if err := procedure.applyDiffTo(newSchema); err != nil {
+			if err := procedure.applyDiffTo(schema); err != nil {
 				return errors.Wrapf(err, "failed to apply diff to procedure %q", procedure.name)
 			}
 		}
+		for _, sequenceName := range sortedSequenceNames {
+			sequence := n.sequences[sequenceName]
+			if err := sequence.applyDiffTo(schema); err != nil {
+				return errors.Wrapf(err, "failed to apply diff to sequence %q", sequence.name)
+			}
+		}
+		for _, enumTypeName := range sortedEnumTypeNames {
+			enumType := n.enumTypes[enumTypeName]
+			if err := enumType.applyDiffTo(schema); err != nil {
+				return errors.Wrapf(err, "failed to apply diff to enum type %q", enumType.name)
+			}
+		}
+		return nil
+	}
+
+	switch n.action {
+	case diffActionCreate:
+		newSchema := &storepb.SchemaMetadata{
+			Name: n.name,
+		}
+		if err := applyObjectDiffs(newSchema); err != nil {
+			return err
+		}
 		target.Schemas = append(target.Schemas, newSchema)
 	case diffActionDrop:
 		for i, schema := range target.Schemas {
@@ -324,35 +426,20 @@ func (n *metadataDiffSchemaNode) applyDiffTo(target *storepb.DatabaseSchemaMetad
 		for idx, schema := range target.Schemas {
 			if schema.Name == n.name {
 				newSchema := &storepb.SchemaMetadata{
-					Name:       n.name,
-					Tables:     schema.Tables,
-					Views:      schema.Views,
-					Functions:  schema.Functions,
-					Procedures: schema.Procedures,
-				}
-				for _, tableName := range sortedTableNames {
-					table := n.tables[tableName]
-					if err := table.applyDiffTo(newSchema); err != nil {
-						return errors.Wrapf(err, "failed to apply diff to table %q", table.name)
-					}
-				}
-				for _, viewName := range sortedViewNames {
-					view := n.views[viewName]
-					if err := view.applyDiffTo(newSchema); err != nil {
-						return errors.Wrapf(err, "failed to apply diff to view %q", view.name)
-					}
+					Name:              n.name,
+					Tables:            schema.Tables,
+					ExternalTables:    schema.ExternalTables,
+					Views:             schema.Views,
+					Functions:         schema.Functions,
+					Procedures:        schema.Procedures,
+					Streams:           schema.Streams,
+					Tasks:             schema.Tasks,
+					MaterializedViews: schema.MaterializedViews,
+					Sequences:         schema.Sequences,
+					EnumTypes:         schema.EnumTypes,
 				}
-				for _, functionName := range sortedFunctionNames {
-					function := n.functions[functionName]
-					if err := function.applyDiffTo(newSchema); err != nil {
-						return errors.Wrapf(err, "failed to apply diff to function %q", function.name)
-					}
-				}
-				for _, procedureName := range sortedProcedureNames {
-					procedure := n.procedures[procedureName]
-					if err := procedure.applyDiffTo(newSchema); err != nil {
-						return errors.Wrapf(err, "failed to apply diff to procedure %q", procedure.name)
-					}
+				if err := applyObjectDiffs(newSchema); err != nil {
+					return err
 				}
 				target.Schemas[idx] = newSchema
 			}
@@ -377,7 +464,7 @@ type metadataDiffTableNode struct {
 
 	foreignKeys map[string]*metadataDiffForeignKeyNode
 	indexes     map[string]*metadataDiffIndexNode
-	// TableMetaData contains other object types, likes trigger, index etc. But we do not support them yet.
+	triggers    map[string]*metadataDiffTriggerNode
 
 	// partitionNames is designed to help to handle the partition orders.
 	// The size of partitionNames is always equal to the size of partitionsMap,
@@ -447,7 +534,7 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		}
 		conflict, msg := columnNode.tryMerge(otherColumnNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("column %q: %s", columnName, msg)
 		}
 		delete(other.columnsMap, columnName)
 	}
@@ -459,7 +546,7 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		}
 		conflict, msg := foreignKeyNode.tryMerge(otherForeignKeyNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("foreign key %q: %s", foreignKeyName, msg)
 		}
 		delete(other.foreignKeys, foreignKeyName)
 	}
@@ -471,11 +558,23 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		}
 		conflict, msg := indexNode.tryMerge(otherIndexNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("index %q: %s", indexName, msg)
 		}
 		delete(other.indexes, indexName)
 	}
 
+	for triggerName, triggerNode := range n.triggers {
+		otherTriggerNode, in := other.triggers[triggerName]
+		if !in {
+			continue
+		}
+		conflict, msg := triggerNode.tryMerge(otherTriggerNode)
+		if conflict {
+			return true, fmt.Sprintf("trigger %q: %s", triggerName, msg)
+		}
+		delete(other.triggers, triggerName)
+	}
+
 	for _, partitionName := range n.partitionNames {
 		partitionNode := n.partitionsMap[partitionName]
 		otherPartitionNode, in := other.partitionsMap[partitionName]
@@ -484,7 +583,7 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		}
 		conflict, msg := partitionNode.tryMerge(otherPartitionNode)
 		if conflict {
-			return true, msg
+			return true, fmt.Sprintf("partition %q: %s", partitionName, msg)
 		}
 		delete(other.partitionsMap, partitionName)
 	}
@@ -515,6 +614,10 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		n.indexes[remainingIndex.name] = remainingIndex
 	}
 
+	for _, remainingTrigger := range other.triggers {
+		n.triggers[remainingTrigger.name] = remainingTrigger
+	}
+
 	return false, ""
 }
 
@@ -535,6 +638,12 @@ func (n *metadataDiffTableNode) applyDiffTo(target *storepb.SchemaMetadata) erro
 	}
 	slices.Sort(sortedIndexName)
 
+	sortedTriggerName := make([]string, 0, len(n.triggers))
+	for triggerName := range n.triggers {
+		sortedTriggerName = append(sortedTriggerName, triggerName)
+	}
+	slices.Sort(sortedTriggerName)
+
 	switch n.action {
 	case diffActionCreate:
 		newTable := &storepb.TableMetadata{
@@ -570,6 +679,12 @@ func (n *metadataDiffTableNode) applyDiffTo(target *storepb.SchemaMetadata) erro
 				return errors.Wrapf(err, "failed to apply diff to index %q", index.name)
 			}
 		}
+		for _, triggerName := range sortedTriggerName {
+			trigger := n.triggers[triggerName]
+			if err := trigger.applyDiffTo(newTable); err != nil {
+				return errors.Wrapf(err, "failed to apply diff to trigger %q", trigger.name)
+			}
+		}
 
 		for _, partitionName := range n.partitionNames {
 			partition := n.partitionsMap[partitionName]
@@ -588,8 +703,8 @@ func (n *metadataDiffTableNode) applyDiffTo(target *storepb.SchemaMetadata) erro
 		}
 	case diffActionUpdate:
 		for idx, table := range target.Tables {
-			// Update table currently is only contains diff of columns, foreign keys and indexes.
-			// So we do apply column and foreign key diff to target table.
+			// Update table currently is only contains diff of columns, foreign keys, indexes, triggers and partitions.
+			// So we do apply these diffs to target table.
 			if table.Name == n.name {
 				newTable := &storepb.TableMetadata{
 					Name:        n.name,
@@ -600,6 +715,8 @@ func (n *metadataDiffTableNode) applyDiffTo(target *storepb.SchemaMetadata) erro
 					Columns:     table.Columns,
 					ForeignKeys: table.ForeignKeys,
 					Indexes:     table.Indexes,
+					Partitions:  table.Partitions,
+					Triggers:    table.Triggers,
 				}
 				for _, columnName := range n.columnNames {
 					if columnNode, in := n.columnsMap[columnName]; in {
@@ -620,6 +737,12 @@ func (n *metadataDiffTableNode) applyDiffTo(target *storepb.SchemaMetadata) erro
 						return errors.Wrapf(err, "failed to apply diff to index %q", index.name)
 					}
 				}
+				for _, triggerName := range sortedTriggerName {
+					trigger := n.triggers[triggerName]
+					if err := trigger.applyDiffTo(newTable); err != nil {
+						return errors.Wrapf(err, "failed to apply diff to trigger %q", trigger.name)
+					}
+				}
 				// XXX(zp): We need to find a better way to solve the problem of column position.
 				// We need to sort the columns by position after applying the diff.
 				for idx := range newTable.Columns {
@@ -1061,13 +1184,19 @@ func (n *metadataDiffFunctioNnode) applyDiffTo(target *storepb.SchemaMetadata) e
 		}
 		target.Functions = append(target.Functions, newFunction)
 	case diffActionDrop:
-		for i, view := range target.Functions {
-			if view.Name == n.name {
+		for i, function := range target.Functions {
+			if function.Name == n.name {
 				target.Functions = append(target.Functions[:i], target.Functions[i+1:]...)
 				break
 			}
 		}
-		return nil
+	case diffActionUpdate:
+		for i, function := range target.Functions {
+			if function.Name == n.name {
+				target.Functions[i] = n.head
+				break
+			}
+		}
 	}
 	return nil
 }
@@ -1128,13 +1257,19 @@ func (n *metadataDiffProcedureNode) applyDiffTo(target *storepb.SchemaMetadata)
 		}
 		target.Procedures = append(target.Procedures, newProcedure)
 	case diffActionDrop:
-		for i, view := range target.Procedures {
-			if view.Name == n.name {
+		for i, procedure := range target.Procedures {
+			if procedure.Name == n.name {
 				target.Procedures = append(target.Procedures[:i], target.Procedures[i+1:]...)
 				break
 			}
 		}
-		return nil
+	case diffActionUpdate:
+		for i, procedure := range target.Procedures {
+			if procedure.Name == n.name {
+				target.Procedures[i] = n.head
+				break
+			}
+		}
 	}
 	return nil
 }
@@ -1165,6 +1300,9 @@ func (n *metadataDiffViewNode) tryMerge(other *metadataDiffViewNode) (bool, stri
 		if n.head.Definition != other.head.Definition {
 			return true, fmt.Sprintf("conflict view definition, one is %s, the other is %s", n.head.Definition, other.head.Definition)
 		}
+		if n.head.Comment != other.head.Comment {
+			return true, fmt.Sprintf("conflict view comment, one is %s, the other is %s", n.head.Comment, other.head.Comment)
+		}
 	}
 
 	if n.action == diffActionUpdate {
@@ -1175,6 +1313,17 @@ func (n *metadataDiffViewNode) tryMerge(other *metadataDiffViewNode) (bool, stri
 				}
 			} else {
 				n.head.Definition = other.head.Definition
+				n.head.DependentColumns = other.head.DependentColumns
+			}
+		}
+
+		if other.base.Comment != other.head.Comment {
+			if n.base.Comment != n.head.Comment {
+				if n.head.Comment != other.head.Comment {
+					return true, fmt.Sprintf("conflict view comment, one is %s, the other is %s", n.head.Comment, other.head.Comment)
+				}
+			} else {
+				n.head.Comment = other.head.Comment
 			}
 		}
 	}
@@ -1189,11 +1338,7 @@ func (n *metadataDiffViewNode) applyDiffTo(target *storepb.SchemaMetadata) error
 
 	switch n.action {
 	case diffActionCreate:
-		newView := &storepb.ViewMetadata{
-			Name:       n.name,
-			Definition: n.head.Definition,
-		}
-		target.Views = append(target.Views, newView)
+		target.Views = append(target.Views, n.head)
 	case diffActionDrop:
 		for i, view := range target.Views {
 			if view.Name == n.name {
@@ -1201,7 +1346,304 @@ func (n *metadataDiffViewNode) applyDiffTo(target *storepb.SchemaMetadata) error
 				break
 			}
 		}
-		return nil
+	case diffActionUpdate:
+		for i, view := range target.Views {
+			if view.Name == n.name {
+				target.Views[i] = n.head
+				break
+			}
+		}
+	}
+	return nil
+}
+
+type metadataDiffMaterializedViewNode struct {
+	metadataDiffBaseNode
+	name string
+	//nolint
+	base *storepb.MaterializedViewMetadata
+	head *storepb.MaterializedViewMetadata
+}
+
+func (n *metadataDiffMaterializedViewNode) tryMerge(other *metadataDiffMaterializedViewNode) (bool, string) {
+	if other == nil {
+		return true, "other node check conflict with materialized view node must not be nil"
+	}
+
+	if n.name != other.name {
+		return true, fmt.Sprintf("non-expected materialized view node pair, one is %s, the other is %s", n.name, other.name)
+	}
+	if n.action != other.action {
+		return true, fmt.Sprintf("conflict materialized view action, one is %s, the other is %s", n.action, other.action)
+	}
+	if n.action == diffActionDrop {
+		return false, ""
+	}
+	if n.action == diffActionCreate {
+		if n.head.Definition != other.head.Definition {
+			return true, fmt.Sprintf("conflict materialized view definition, one is %s, the other is %s", n.head.Definition, other.head.Definition)
+		}
+		if n.head.Comment != other.head.Comment {
+			return true, fmt.Sprintf("conflict materialized view comment, one is %s, the other is %s", n.head.Comment, other.head.Comment)
+		}
+	}
+
+	if n.action == diffActionUpdate {
+		if other.base.Definition != other.head.Definition {
+			if n.base.Definition != n.head.Definition {
+				if n.head.Definition != other.head.Definition {
+					return true, fmt.Sprintf("conflict materialized view definition, one is %s, the other is %s", n.head.Definition, other.head.Definition)
+				}
+			} else {
+				n.head.Definition = other.head.Definition
+				n.head.DependentColumns = other.head.DependentColumns
+			}
+		}
+
+		if other.base.Comment != other.head.Comment {
+			if n.base.Comment != n.head.Comment {
+				if n.head.Comment != other.head.Comment {
+					return true, fmt.Sprintf("conflict materialized view comment, one is %s, the other is %s", n.head.Comment, other.head.Comment)
+				}
+			} else {
+				n.head.Comment = other.head.Comment
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func (n *metadataDiffMaterializedViewNode) applyDiffTo(target *storepb.SchemaMetadata) error {
+	if target == nil {
+		return errors.New("target must not be nil")
+	}
+
+	switch n.action {
+	case diffActionCreate:
+		target.MaterializedViews = append(target.MaterializedViews, n.head)
+	case diffActionDrop:
+		for i, materializedView := range target.MaterializedViews {
+			if materializedView.Name == n.name {
+				target.MaterializedViews = append(target.MaterializedViews[:i], target.MaterializedViews[i+1:]...)
+				break
+			}
+		}
+	case diffActionUpdate:
+		for i, materializedView := range target.MaterializedViews {
+			if materializedView.Name == n.name {
+				target.MaterializedViews[i] = n.head
+				break
+			}
+		}
+	}
+	return nil
+}
+
+type metadataDiffSequenceNode struct {
+	metadataDiffBaseNode
+	name string
+	//nolint
+	base *storepb.SequenceMetadata
+	head *storepb.SequenceMetadata
+}
+
+func (n *metadataDiffSequenceNode) tryMerge(other *metadataDiffSequenceNode) (bool, string) {
+	if other == nil {
+		return true, "other node check conflict with sequence node must not be nil"
+	}
+
+	if n.name != other.name {
+		return true, fmt.Sprintf("non-expected sequence node pair, one is %s, the other is %s", n.name, other.name)
+	}
+	if n.action != other.action {
+		return true, fmt.Sprintf("conflict sequence action, one is %s, the other is %s", n.action, other.action)
+	}
+	if n.action == diffActionDrop {
+		return false, ""
+	}
+	if n.action == diffActionCreate {
+		if n.head.DataType != other.head.DataType {
+			return true, fmt.Sprintf("conflict sequence data type, one is %s, the other is %s", n.head.DataType, other.head.DataType)
+		}
+	}
+
+	if n.action == diffActionUpdate {
+		if other.base.DataType != other.head.DataType {
+			if n.base.DataType != n.head.DataType {
+				if n.head.DataType != other.head.DataType {
+					return true, fmt.Sprintf("conflict sequence data type, one is %s, the other is %s", n.head.DataType, other.head.DataType)
+				}
+			} else {
+				n.head.DataType = other.head.DataType
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func (n *metadataDiffSequenceNode) applyDiffTo(target *storepb.SchemaMetadata) error {
+	if target == nil {
+		return errors.New("target must not be nil")
+	}
+
+	switch n.action {
+	case diffActionCreate:
+		target.Sequences = append(target.Sequences, n.head)
+	case diffActionDrop:
+		for i, sequence := range target.Sequences {
+			if sequence.Name == n.name {
+				target.Sequences = append(target.Sequences[:i], target.Sequences[i+1:]...)
+				break
+			}
+		}
+	case diffActionUpdate:
+		for i, sequence := range target.Sequences {
+			if sequence.Name == n.name {
+				target.Sequences[i] = n.head
+				break
+			}
+		}
+	}
+	return nil
+}
+
+type metadataDiffEnumTypeNode struct {
+	metadataDiffBaseNode
+	name string
+	//nolint
+	base *storepb.EnumTypeMetadata
+	head *storepb.EnumTypeMetadata
+}
+
+func (n *metadataDiffEnumTypeNode) tryMerge(other *metadataDiffEnumTypeNode) (bool, string) {
+	if other == nil {
+		return true, "other node check conflict with enum type node must not be nil"
+	}
+
+	if n.name != other.name {
+		return true, fmt.Sprintf("non-expected enum type node pair, one is %s, the other is %s", n.name, other.name)
+	}
+	if n.action != other.action {
+		return true, fmt.Sprintf("conflict enum type action, one is %s, the other is %s", n.action, other.action)
+	}
+	if n.action == diffActionDrop {
+		return false, ""
+	}
+	if n.action == diffActionCreate {
+		if !slices.Equal(n.head.Values, other.head.Values) {
+			return true, fmt.Sprintf("conflict enum type values, one is (%s), the other is (%s)", strings.Join(n.head.Values, ", "), strings.Join(other.head.Values, ", "))
+		}
+		if n.head.Comment != other.head.Comment {
+			return true, fmt.Sprintf("conflict enum type comment, one is %s, the other is %s", n.head.Comment, other.head.Comment)
+		}
+	}
+
+	if n.action == diffActionUpdate {
+		if !slices.Equal(other.base.Values, other.head.Values) {
+			if !slices.Equal(n.base.Values, n.head.Values) {
+				if !slices.Equal(n.head.Values, other.head.Values) {
+					return true, fmt.Sprintf("conflict enum type values, one is (%s), the other is (%s)", strings.Join(n.head.Values, ", "), strings.Join(other.head.Values, ", "))
+				}
+			} else {
+				n.head.Values = other.head.Values
+			}
+		}
+
+		if other.base.Comment != other.head.Comment {
+			if n.base.Comment != n.head.Comment {
+				if n.head.Comment != other.head.Comment {
+					return true, fmt.Sprintf("conflict enum type comment, one is %s, the other is %s", n.head.Comment, other.head.Comment)
+				}
+			} else {
+				n.head.Comment = other.head.Comment
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func (n *metadataDiffEnumTypeNode) applyDiffTo(target *storepb.SchemaMetadata) error {
+	if target == nil {
+		return errors.New("target must not be nil")
+	}
+
+	switch n.action {
+	case diffActionCreate:
+		target.EnumTypes = append(target.EnumTypes, n.head)
+	case diffActionDrop:
+		for i, enumType := range target.EnumTypes {
+			if enumType.Name == n.name {
+				target.EnumTypes = append(target.EnumTypes[:i], target.EnumTypes[i+1:]...)
+				break
+			}
+		}
+	case diffActionUpdate:
+		for i, enumType := range target.EnumTypes {
+			if enumType.Name == n.name {
+				target.EnumTypes[i] = n.head
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// Trigger related.
+type metadataDiffTriggerNode struct {
+	metadataDiffBaseNode
+	name string
+	//nolint
+	base *storepb.TriggerMetadata
+	head *storepb.TriggerMetadata
+}
+
+func (n *metadataDiffTriggerNode) tryMerge(other *metadataDiffTriggerNode) (bool, string) {
+	if other == nil {
+		return true, "other node check conflict with trigger node must not be nil"
+	}
+
+	if n.name != other.name {
+		return true, fmt.Sprintf("non-expected trigger node pair, one is %s, the other is %s", n.name, other.name)
+	}
+	if n.action != other.action {
+		return true, fmt.Sprintf("conflict trigger action, one is %s, the other is %s", n.action, other.action)
+	}
+	if n.action == diffActionDrop {
+		return false, ""
+	}
+	// The definition is the whole CREATE TRIGGER statement, so both sides must end up with the same one.
+	if n.head.Definition != other.head.Definition {
+		return true, fmt.Sprintf("conflict trigger definition, one is %s, the other is %s", n.head.Definition, other.head.Definition)
+	}
+
+	return false, ""
+}
+
+func (n *metadataDiffTriggerNode) applyDiffTo(target *storepb.TableMetadata) error {
+	if target == nil {
+		return errors.New("target must not be nil")
+	}
+
+	switch n.action {
+	case diffActionCreate:
+		target.Triggers = append(target.Triggers, n.head)
+	case diffActionDrop:
+		for i, trigger := range target.Triggers {
+			if trigger.Name == n.name {
+				target.Triggers = append(target.Triggers[:i], target.Triggers[i+1:]...)
+				break
+			}
+		}
+	case diffActionUpdate:
+		for i, trigger := range target.Triggers {
+			if trigger.Name == n.name {
+				target.Triggers[i] = n.head
+				break
+			}
+		}
 	}
 	return nil
 }
@@ -1299,6 +1741,16 @@ func (n *metadataDiffForeignKeyNode) tryMerge(other *metadataDiffForeignKeyNode)
 				n.head.Columns = other.head.Columns
 			}
 		}
+
+		if !reflect.DeepEqual(other.base.ReferencedColumns, other.head.ReferencedColumns) {
+			if !reflect.DeepEqual(n.base.ReferencedColumns, n.head.ReferencedColumns) {
+				if !reflect.DeepEqual(n.head.ReferencedColumns, other.head.ReferencedColumns) {
+					return true, fmt.Sprintf("conflict foreign key referenced columns, one is %v, the other is %v", n.head.ReferencedColumns, other.head.ReferencedColumns)
+				}
+			} else {
+				n.head.ReferencedColumns = other.head.ReferencedColumns
+			}
+		}
 	}
 
 	return false, ""
@@ -1387,13 +1839,16 @@ func diffSchemaMetadata(base, head *storepb.SchemaMetadata) (*metadataDiffSchema
 		metadataDiffBaseNode: metadataDiffBaseNode{
 			action: action,
 		},
-		name:       name,
-		base:       base,
-		head:       head,
-		tables:     make(map[string]*metadataDiffTableNode),
-		views:      make(map[string]*metadataDiffViewNode),
-		functions:  make(map[string]*metadataDiffFunctioNnode),
-		procedures: make(map[string]*metadataDiffProcedureNode),
+		name:              name,
+		base:              base,
+		head:              head,
+		tables:            make(map[string]*metadataDiffTableNode),
+		views:             make(map[string]*metadataDiffViewNode),
+		materializedViews: make(map[string]*metadataDiffMaterializedViewNode),
+		functions:         make(map[string]*metadataDiffFunctioNnode),
+		procedures:        make(map[string]*metadataDiffProcedureNode),
+		sequences:         make(map[string]*metadataDiffSequenceNode),
+		enumTypes:         make(map[string]*metadataDiffEnumTypeNode),
 	}
 
 	tableNamesMap := make(map[string]bool)
@@ -1509,8 +1964,92 @@ func diffSchemaMetadata(base, head *storepb.SchemaMetadata) (*metadataDiffSchema
 		}
 	}
 
+	materializedViewNamesMap := make(map[string]bool)
+	baseMaterializedViewMap := make(map[string]*storepb.MaterializedViewMetadata)
+	if base != nil {
+		for _, materializedView := range base.MaterializedViews {
+			baseMaterializedViewMap[materializedView.Name] = materializedView
+			materializedViewNamesMap[materializedView.Name] = true
+		}
+	}
+
+	headMaterializedViewMap := make(map[string]*storepb.MaterializedViewMetadata)
+	if head != nil {
+		for _, materializedView := range head.MaterializedViews {
+			headMaterializedViewMap[materializedView.Name] = materializedView
+			materializedViewNamesMap[materializedView.Name] = true
+		}
+	}
+
+	for materializedViewName := range materializedViewNamesMap {
+		baseMaterializedView, headMaterializedView := baseMaterializedViewMap[materializedViewName], headMaterializedViewMap[materializedViewName]
+		diffNode, err := diffMaterializedViewMetadata(baseMaterializedView, headMaterializedView)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to diff materialized view %q", materializedViewName)
+		}
+		if diffNode != nil {
+			schemaNode.materializedViews[materializedViewName] = diffNode
+		}
+	}
+
+	sequenceNamesMap := make(map[string]bool)
+	baseSequenceMap := make(map[string]*storepb.SequenceMetadata)
+	if base != nil {
+		for _, sequence := range base.Sequences {
+			baseSequenceMap[sequence.Name] = sequence
+			sequenceNamesMap[sequence.Name] = true
+		}
+	}
+
+	headSequenceMap := make(map[string]*storepb.SequenceMetadata)
+	if head != nil {
+		for _, sequence := range head.Sequences {
+			headSequenceMap[sequence.Name] = sequence
+			sequenceNamesMap[sequence.Name] = true
+		}
+	}
+
+	for sequenceName := range sequenceNamesMap {
+		baseSequence, headSequence := baseSequenceMap[sequenceName], headSequenceMap[sequenceName]
+		diffNode, err := diffSequenceMetadata(baseSequence, headSequence)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to diff sequence %q", sequenceName)
+		}
+		if diffNode != nil {
+			schemaNode.sequences[sequenceName] = diffNode
+		}
+	}
+
+	enumTypeNamesMap := make(map[string]bool)
+	baseEnumTypeMap := make(map[string]*storepb.EnumTypeMetadata)
+	if base != nil {
+		for _, enumType := range base.EnumTypes {
+			baseEnumTypeMap[enumType.Name] = enumType
+			enumTypeNamesMap[enumType.Name] = true
+		}
+	}
+
+	headEnumTypeMap := make(map[string]*storepb.EnumTypeMetadata)
+	if head != nil {
+		for _, enumType := range head.EnumTypes {
+			headEnumTypeMap[enumType.Name] = enumType
+			enumTypeNamesMap[enumType.Name] = true
+		}
+	}
+
+	for enumTypeName := range enumTypeNamesMap {
+		baseEnumType, headEnumType := baseEnumTypeMap[enumTypeName], headEnumTypeMap[enumTypeName]
+		diffNode, err := diffEnumTypeMetadata(baseEnumType, headEnumType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to diff enum type %q", enumTypeName)
+		}
+		if diffNode != nil {
+			schemaNode.enumTypes[enumTypeName] = diffNode
+		}
+	}
+
 	if action == diffActionUpdate {
-		if len(schemaNode.tables) == 0 && len(schemaNode.views) == 0 && len(schemaNode.functions) == 0 && len(schemaNode.procedures) == 0 {
+		if len(schemaNode.tables) == 0 && len(schemaNode.views) == 0 && len(schemaNode.materializedViews) == 0 && len(schemaNode.functions) == 0 && len(schemaNode.procedures) == 0 && len(schemaNode.sequences) == 0 && len(schemaNode.enumTypes) == 0 {
 			return nil, nil
 		}
 	}
@@ -1545,7 +2084,7 @@ func diffViewMetadata(base, head *storepb.ViewMetadata) (*metadataDiffViewNode,
 	}
 
 	if action == diffActionUpdate {
-		if base.Definition == head.Definition {
+		if base.Definition == head.Definition && base.Comment == head.Comment {
 			return nil, nil
 		}
 	}
@@ -1553,6 +2092,111 @@ func diffViewMetadata(base, head *storepb.ViewMetadata) (*metadataDiffViewNode,
 	return viewNode, nil
 }
 
+func diffMaterializedViewMetadata(base, head *storepb.MaterializedViewMetadata) (*metadataDiffMaterializedViewNode, error) {
+	if base == nil && head == nil {
+		return nil, errors.New("from and to materialized view metadata must not be nil")
+	}
+
+	var name string
+	action := diffActionUpdate
+	if base == nil {
+		action = diffActionCreate
+		name = head.Name
+	} else if head == nil {
+		action = diffActionDrop
+		name = base.Name
+	} else {
+		name = base.Name
+	}
+
+	materializedViewNode := &metadataDiffMaterializedViewNode{
+		metadataDiffBaseNode: metadataDiffBaseNode{
+			action: action,
+		},
+		name: name,
+		base: base,
+		head: head,
+	}
+
+	if action == diffActionUpdate {
+		if base.Definition == head.Definition && base.Comment == head.Comment {
+			return nil, nil
+		}
+	}
+
+	return materializedViewNode, nil
+}
+
+func diffSequenceMetadata(base, head *storepb.SequenceMetadata) (*metadataDiffSequenceNode, error) {
+	if base == nil && head == nil {
+		return nil, errors.New("from and to sequence metadata must not be nil")
+	}
+
+	var name string
+	action := diffActionUpdate
+	if base == nil {
+		action = diffActionCreate
+		name = head.Name
+	} else if head == nil {
+		action = diffActionDrop
+		name = base.Name
+	} else {
+		name = base.Name
+	}
+
+	sequenceNode := &metadataDiffSequenceNode{
+		metadataDiffBaseNode: metadataDiffBaseNode{
+			action: action,
+		},
+		name: name,
+		base: base,
+		head: head,
+	}
+
+	if action == diffActionUpdate {
+		if proto.Equal(base, head) {
+			return nil, nil
+		}
+	}
+
+	return sequenceNode, nil
+}
+
+func diffEnumTypeMetadata(base, head *storepb.EnumTypeMetadata) (*metadataDiffEnumTypeNode, error) {
+	if base == nil && head == nil {
+		return nil, errors.New("from and to enum type metadata must not be nil")
+	}
+
+	var name string
+	action := diffActionUpdate
+	if base == nil {
+		action = diffActionCreate
+		name = head.Name
+	} else if head == nil {
+		action = diffActionDrop
+		name = base.Name
+	} else {
+		name = base.Name
+	}
+
+	enumTypeNode := &metadataDiffEnumTypeNode{
+		metadataDiffBaseNode: metadataDiffBaseNode{
+			action: action,
+		},
+		name: name,
+		base: base,
+		head: head,
+	}
+
+	if action == diffActionUpdate {
+		if proto.Equal(base, head) {
+			return nil, nil
+		}
+	}
+
+	return enumTypeNode, nil
+}
+
 func diffProcedureMetadata(base, head *storepb.ProcedureMetadata) (*metadataDiffProcedureNode, error) {
 	if base == nil && head == nil {
 		return nil, errors.New("from and to view metadata must not be nil")
@@ -1650,6 +2294,7 @@ func diffTableMetadata(base, head *storepb.TableMetadata) (*metadataDiffTableNod
 		columnsMap:    make(map[string]*metadataDiffColumnNode),
 		foreignKeys:   make(map[string]*metadataDiffForeignKeyNode),
 		indexes:       make(map[string]*metadataDiffIndexNode),
+		triggers:      make(map[string]*metadataDiffTriggerNode),
 		partitionsMap: make(map[string]*metadataDiffPartitionNode),
 	}
 
@@ -1759,6 +2404,35 @@ func diffTableMetadata(base, head *storepb.TableMetadata) (*metadataDiffTableNod
 		}
 	}
 
+	triggerNamesMap := make(map[string]bool)
+
+	baseTriggerMap := make(map[string]*storepb.TriggerMetadata)
+	if base != nil {
+		for _, trigger := range base.Triggers {
+			baseTriggerMap[trigger.Name] = trigger
+			triggerNamesMap[trigger.Name] = true
+		}
+	}
+
+	headTriggerMap := make(map[string]*storepb.TriggerMetadata)
+	if head != nil {
+		for _, trigger := range head.Triggers {
+			headTriggerMap[trigger.Name] = trigger
+			triggerNamesMap[trigger.Name] = true
+		}
+	}
+
+	for triggerName := range triggerNamesMap {
+		baseTrigger, headTrigger := baseTriggerMap[triggerName], headTriggerMap[triggerName]
+		diffNode, err := diffTriggerMetadata(baseTrigger, headTrigger)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to diff trigger %q", triggerName)
+		}
+		if diffNode != nil {
+			tableNode.triggers[triggerName] = diffNode
+		}
+	}
+
 	basePartitionMap := make(map[string]*storepb.TablePartitionMetadata)
 	if base != nil {
 		for _, partition := range base.Partitions {
@@ -1773,19 +2447,22 @@ func diffTableMetadata(base, head *storepb.TableMetadata) (*metadataDiffTableNod
 		}
 	}
 
+	// Keep the partition orders of the base and head metadata.
 	partitionNamesMap := make(map[string]bool)
 	var partitionNamesSlice []string
 
-	for partitionName := range basePartitionMap {
-		partitionNamesMap[partitionName] = true
-		partitionNamesSlice = append(partitionNamesSlice, partitionName)
+	for _, partition := range base.GetPartitions() {
+		if _, ok := partitionNamesMap[partition.Name]; !ok {
+			partitionNamesSlice = append(partitionNamesSlice, partition.Name)
+		}
+		partitionNamesMap[partition.Name] = true
 	}
 
-	for partitionName := range headPartitionMap {
-		if _, ok := partitionNamesMap[partitionName]; !ok {
-			partitionNamesSlice = append(partitionNamesSlice, partitionName)
+	for _, partition := range head.GetPartitions() {
+		if _, ok := partitionNamesMap[partition.Name]; !ok {
+			partitionNamesSlice = append(partitionNamesSlice, partition.Name)
 		}
-		partitionNamesMap[partitionName] = true
+		partitionNamesMap[partition.Name] = true
 	}
 
 	for _, partitionName := range partitionNamesSlice {
@@ -1801,7 +2478,7 @@ func diffTableMetadata(base, head *storepb.TableMetadata) (*metadataDiffTableNod
 	}
 
 	if action == diffActionUpdate {
-		if len(tableNode.columnsMap) == 0 && len(tableNode.foreignKeys) == 0 && len(tableNode.indexes) == 0 {
+		if len(tableNode.columnsMap) == 0 && len(tableNode.foreignKeys) == 0 && len(tableNode.indexes) == 0 && len(tableNode.triggers) == 0 && len(tableNode.partitionsMap) == 0 {
 			return nil, nil
 		}
 	}
@@ -1879,6 +2556,41 @@ func diffForeignKeyMetadata(base, head *storepb.ForeignKeyMetadata) (*metadataDi
 	return fkNode, nil
 }
 
+func diffTriggerMetadata(base, head *storepb.TriggerMetadata) (*metadataDiffTriggerNode, error) {
+	if base == nil && head == nil {
+		return nil, errors.New("base and head trigger metadata cannot be nil both")
+	}
+
+	var name string
+	action := diffActionUpdate
+	if base == nil {
+		action = diffActionCreate
+		name = head.Name
+	} else if head == nil {
+		action = diffActionDrop
+		name = base.Name
+	} else {
+		name = base.Name
+	}
+
+	triggerNode := &metadataDiffTriggerNode{
+		metadataDiffBaseNode: metadataDiffBaseNode{
+			action: action,
+		},
+		name: name,
+		base: base,
+		head: head,
+	}
+
+	if action == diffActionUpdate {
+		if !proto.Equal(base, head) {
+			return triggerNode, nil
+		}
+		return nil, nil
+	}
+	return triggerNode, nil
+}
+
 func diffIndexMetadata(base, head *storepb.IndexMetadata) (*metadataDiffIndexNode, error) {
 	if base == nil && head == nil {
 		return nil, errors.New("base and head index metadata cannot be nil both")
@@ -1955,19 +2667,22 @@ func diffPartitionMetadata(base, head *storepb.TablePartitionMetadata) (*metadat
 		}
 	}
 
+	// Keep the subpartition orders of the base and head metadata.
 	subpartitionNamesMap := make(map[string]bool)
 	var subpartitionNamesSlice []string
 
-	for subpartitionName := range baseSubpartitionMap {
-		subpartitionNamesMap[subpartitionName] = true
-		subpartitionNamesSlice = append(subpartitionNamesSlice, subpartitionName)
+	for _, subpartition := range base.GetSubpartitions() {
+		if _, ok := subpartitionNamesMap[subpartition.Name]; !ok {
+			subpartitionNamesSlice = append(subpartitionNamesSlice, subpartition.Name)
+		}
+		subpartitionNamesMap[subpartition.Name] = true
 	}
 
-	for subpartitionName := range headSubpartitionMap {
-		if _, ok := subpartitionNamesMap[subpartitionName]; !ok {
-			subpartitionNamesSlice = append(subpartitionNamesSlice, subpartitionName)
+	for _, subpartition := range head.GetSubpartitions() {
+		if _, ok := subpartitionNamesMap[subpartition.Name]; !ok {
+			subpartitionNamesSlice = append(subpartitionNamesSlice, subpartition.Name)
 		}
-		subpartitionNamesMap[subpartitionName] = true
+		subpartitionNamesMap[subpartition.Name] = true
 	}
 
 	for _, subpartitionName := range subpartitionNamesSlice {
@@ -77,3 +77,36 @@ func TestTryMerge(t *testing.T) {
 		a.NoError(err)
 	}
 }
+
+func TestTryMergeConflict(t *testing.T) {
+	type testCase struct {
+		Description string `yaml:"description"`
+		Ancestor    string `yaml:"ancestor"`
+		Head        string `yaml:"head"`
+		Base        string `yaml:"base"`
+		// Conflict is the expected conflict message.
+		Conflict string `yaml:"conflict"`
+	}
+
+	a := require.New(t)
+
+	content, err := os.ReadFile("testdata/schema_design_merge/try_merge_conflict.yaml")
+	a.NoError(err)
+	var testCases []testCase
+	err = yaml.Unmarshal(content, &testCases)
+	a.NoError(err)
+
+	for idx, tc := range testCases {
+		ancestorSchemaMetadata := new(storepb.DatabaseSchemaMetadata)
+		err = protojson.Unmarshal([]byte(tc.Ancestor), ancestorSchemaMetadata)
+		a.NoErrorf(err, "test case %d: %s", idx, tc.Description)
+		headSchemaMetadata := new(storepb.DatabaseSchemaMetadata)
+		err = protojson.Unmarshal([]byte(tc.Head), headSchemaMetadata)
+		a.NoErrorf(err, "test case %d: %s", idx, tc.Description)
+		baseSchemaMetadata := new(storepb.DatabaseSchemaMetadata)
+		err = protojson.Unmarshal([]byte(tc.Base), baseSchemaMetadata)
+		a.NoErrorf(err, "test case %d: %s", idx, tc.Description)
+		_, err = tryMerge(ancestorSchemaMetadata, headSchemaMetadata, baseSchemaMetadata)
+		a.EqualErrorf(err, tc.Conflict, "test case %d: %s", idx, tc.Description)
+	}
+}
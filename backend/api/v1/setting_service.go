@@ -19,7 +19,9 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/classifier"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/masker"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -73,6 +75,9 @@ var whitelistSettings = []api.SettingName{
 	api.SettingMaskingAlgorithm,
 }
 
+// maximumDateShiftDays is the maximum number of days to shift for the date shift masking algorithm.
+const maximumDateShiftDays = 36500
+
 var preservedMaskingAlgorithmIDMatcher = regexp.MustCompile("^[0]{8}-[0]{4}-[0]{4}-[0]{4}-[0]{9}[0-9a-fA-F]{3}$")
 
 //go:embed mail_templates/testmail/template.html
@@ -202,6 +207,14 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 				payload.MaximumRoleExpiration = nil
 			}
 		}
+		if payload.QueryHistoryRetention != nil {
+			// If the value is less than or equal to 0, we will remove the setting. AKA keep forever.
+			if payload.QueryHistoryRetention.Seconds <= 0 {
+				payload.QueryHistoryRetention = nil
+			} else if payload.QueryHistoryRetention.AsDuration() < 24*time.Hour {
+				return nil, status.Errorf(codes.InvalidArgument, "query history retention should be at least one day")
+			}
+		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
@@ -382,6 +395,15 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting external approval setting")
 		}
 		storeValue := convertExternalApprovalSetting(externalApprovalSetting)
+		oldNode := make(map[string]*storepb.ExternalApprovalSetting_Node)
+		for _, node := range oldSetting.Nodes {
+			oldNode[node.Id] = node
+		}
+		for _, node := range storeValue.Nodes {
+			if err := validateExternalApprovalSettingNode(node, oldNode[node.Id]); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+		}
 
 		newNode := make(map[string]*storepb.ExternalApprovalSetting_Node)
 		for _, node := range storeValue.Nodes {
@@ -448,6 +470,11 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 		if len(payload.Configs) > 1 {
 			return nil, status.Errorf(codes.InvalidArgument, "only support define 1 classification config for now")
 		}
+		for _, config := range payload.Configs {
+			if _, err := classifier.New(config); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid data classification config %q: %v", config.Id, err)
+			}
+		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
@@ -477,8 +504,17 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 		}
 		storeSettingValue = string(bytes)
 	case api.SettingMaskingAlgorithm:
+		oldSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get masking algorithm setting: %v", err)
+		}
+		oldAlgorithms := make(map[string]*storepb.MaskingAlgorithmSetting_Algorithm)
+		for _, algorithm := range oldSetting.Algorithms {
+			oldAlgorithms[algorithm.Id] = algorithm
+		}
 		idMap := make(map[string]struct{})
 		for _, algorithm := range request.Setting.Value.GetMaskingAlgorithmSettingValue().Algorithms {
+			fillMaskingAlgorithmKey(algorithm, oldAlgorithms[algorithm.Id])
 			if err := validateMaskingAlgorithm(algorithm); err != nil {
 				return nil, err
 			}
@@ -538,6 +574,38 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 	return settingMessage, nil
 }
 
+// UnmaskValues restores the values masked by a reversible masking algorithm with its key.
+func (s *SettingService) UnmaskValues(ctx context.Context, request *v1pb.UnmaskValuesRequest) (*v1pb.UnmaskValuesResponse, error) {
+	setting, err := s.store.GetMaskingAlgorithmSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get masking algorithm setting: %v", err)
+	}
+	var algorithm *storepb.MaskingAlgorithmSetting_Algorithm
+	for _, a := range setting.Algorithms {
+		if a.Id == request.AlgorithmId {
+			algorithm = a
+			break
+		}
+	}
+	if algorithm == nil {
+		return nil, status.Errorf(codes.NotFound, "masking algorithm %q not found", request.AlgorithmId)
+	}
+	m, ok := getMaskerByMaskingAlgorithmAndLevel(algorithm, storepb.MaskingLevel_FULL).(masker.ReversibleMasker)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "masking algorithm %q is not reversible", algorithm.Title)
+	}
+
+	response := &v1pb.UnmaskValuesResponse{}
+	for i, value := range request.Values {
+		unmasked, err := m.Unmask(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to unmask the value at index %d: %v", i, err)
+		}
+		response.Values = append(response.Values, unmasked)
+	}
+	return response, nil
+}
+
 func convertV1PbToStorePb(inputPB, outputPB protoreflect.ProtoMessage) error {
 	bytes, err := protojson.Marshal(inputPB)
 	if err != nil {
@@ -717,6 +785,9 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
 		}
+		for _, algorithm := range v1Value.Algorithms {
+			stripMaskingAlgorithmKey(algorithm)
+		}
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
@@ -834,12 +905,10 @@ func settingInWhitelist(name api.SettingName) bool {
 }
 
 func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
+	// The approval template without any step approves the issues automatically.
 	if template.Flow == nil {
 		return errors.Errorf("approval template cannot be nil")
 	}
-	if len(template.Flow.Steps) == 0 {
-		return errors.Errorf("approval template cannot have 0 step")
-	}
 	for _, step := range template.Flow.Steps {
 		if step.Type != v1pb.ApprovalStep_ANY {
 			return errors.Errorf("invalid approval step type: %v", step.Type)
@@ -847,6 +916,17 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 		if len(step.Nodes) != 1 {
 			return errors.Errorf("expect 1 node in approval step, got: %v", len(step.Nodes))
 		}
+		if step.Quorum < 0 {
+			return errors.Errorf("invalid approval step quorum: %v", step.Quorum)
+		}
+		if step.Quorum > 1 {
+			if _, ok := step.Nodes[0].Payload.(*v1pb.ApprovalNode_ExternalNodeId); ok {
+				return errors.Errorf("quorum is not supported for the external approval node")
+			}
+		}
+		if step.Timeout != nil && step.Timeout.AsDuration() <= 0 {
+			return errors.Errorf("invalid approval step timeout: %v", step.Timeout.AsDuration())
+		}
 	}
 	return nil
 }
@@ -1015,12 +1095,33 @@ func convertToExternalApprovalSettingNodes(nodes []*storepb.ExternalApprovalSett
 	return v1Nodes
 }
 
+// convertToExternalApprovalSettingNode converts the node without the credentials and the webhook secret.
 func convertToExternalApprovalSettingNode(o *storepb.ExternalApprovalSetting_Node) *v1pb.ExternalApprovalSetting_Node {
-	return &v1pb.ExternalApprovalSetting_Node{
+	node := &v1pb.ExternalApprovalSetting_Node{
 		Id:       o.Id,
 		Title:    o.Title,
 		Endpoint: o.Endpoint,
+		Type:     v1pb.ExternalApprovalSetting_Node_Type(o.Type),
+	}
+	if c := o.JiraConfig; c != nil {
+		node.JiraConfig = &v1pb.ExternalApprovalSetting_JiraConfig{
+			Url:              c.Url,
+			Email:            c.Email,
+			ProjectKey:       c.ProjectKey,
+			IssueType:        c.IssueType,
+			ApprovedStatuses: c.ApprovedStatuses,
+			RejectedStatuses: c.RejectedStatuses,
+		}
 	}
+	if c := o.ServiceNowConfig; c != nil {
+		node.ServiceNowConfig = &v1pb.ExternalApprovalSetting_ServiceNowConfig{
+			InstanceUrl:    c.InstanceUrl,
+			Username:       c.Username,
+			ApprovedStates: c.ApprovedStates,
+			RejectedStates: c.RejectedStates,
+		}
+	}
+	return node
 }
 
 func convertExternalApprovalSetting(s *v1pb.ExternalApprovalSetting) *storepb.ExternalApprovalSetting {
@@ -1038,11 +1139,73 @@ func convertExternalApprovalSettingNodes(nodes []*v1pb.ExternalApprovalSetting_N
 }
 
 func convertExternalApprovalSettingNode(o *v1pb.ExternalApprovalSetting_Node) *storepb.ExternalApprovalSetting_Node {
-	return &storepb.ExternalApprovalSetting_Node{
-		Id:       o.Id,
-		Title:    o.Title,
-		Endpoint: o.Endpoint,
+	node := &storepb.ExternalApprovalSetting_Node{
+		Id:            o.Id,
+		Title:         o.Title,
+		Endpoint:      o.Endpoint,
+		Type:          storepb.ExternalApprovalSetting_Node_Type(o.Type),
+		WebhookSecret: o.WebhookSecret,
+	}
+	if c := o.JiraConfig; c != nil {
+		node.JiraConfig = &storepb.ExternalApprovalSetting_JiraConfig{
+			Url:              c.Url,
+			Email:            c.Email,
+			ApiToken:         c.ApiToken,
+			ProjectKey:       c.ProjectKey,
+			IssueType:        c.IssueType,
+			ApprovedStatuses: c.ApprovedStatuses,
+			RejectedStatuses: c.RejectedStatuses,
+		}
+	}
+	if c := o.ServiceNowConfig; c != nil {
+		node.ServiceNowConfig = &storepb.ExternalApprovalSetting_ServiceNowConfig{
+			InstanceUrl:    c.InstanceUrl,
+			Username:       c.Username,
+			Password:       c.Password,
+			ApprovedStates: c.ApprovedStates,
+			RejectedStates: c.RejectedStates,
+		}
+	}
+	return node
+}
+
+// validateExternalApprovalSettingNode validates the node and fills the credentials and the webhook secret from the old node if they are not set.
+func validateExternalApprovalSettingNode(node *storepb.ExternalApprovalSetting_Node, oldNode *storepb.ExternalApprovalSetting_Node) error {
+	if node.WebhookSecret == "" && oldNode != nil {
+		node.WebhookSecret = oldNode.WebhookSecret
+	}
+	switch node.Type {
+	case storepb.ExternalApprovalSetting_Node_JIRA:
+		c := node.JiraConfig
+		if c == nil {
+			return errors.Errorf("jira config is required for the Jira external approval node %q", node.Title)
+		}
+		if c.ApiToken == "" && oldNode.GetJiraConfig() != nil {
+			c.ApiToken = oldNode.JiraConfig.ApiToken
+		}
+		if c.Url == "" || c.Email == "" || c.ApiToken == "" || c.ProjectKey == "" || c.IssueType == "" {
+			return errors.Errorf("url, email, api token, project key and issue type are required for the Jira external approval node %q", node.Title)
+		}
+		if len(c.ApprovedStatuses) == 0 || len(c.RejectedStatuses) == 0 {
+			return errors.Errorf("approved and rejected statuses are required for the Jira external approval node %q", node.Title)
+		}
+	case storepb.ExternalApprovalSetting_Node_SERVICE_NOW:
+		c := node.ServiceNowConfig
+		if c == nil {
+			return errors.Errorf("service now config is required for the ServiceNow external approval node %q", node.Title)
+		}
+		if c.Password == "" && oldNode.GetServiceNowConfig() != nil {
+			c.Password = oldNode.ServiceNowConfig.Password
+		}
+		if c.InstanceUrl == "" || c.Username == "" || c.Password == "" {
+			return errors.Errorf("instance url, username and password are required for the ServiceNow external approval node %q", node.Title)
+		}
+	default:
+		if node.Endpoint == "" {
+			return errors.Errorf("endpoint is required for the relay external approval node %q", node.Title)
+		}
 	}
+	return nil
 }
 
 // stripSensitiveData strips the sensitive data like password from the setting.value.
@@ -1203,6 +1366,24 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 			if err := checkSubstitution(m.InnerOuterMask.Substitution); err != nil {
 				return err
 			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask_:
+			if m.FormatPreservingEncryptionMask.Key == "" {
+				return status.Errorf(codes.InvalidArgument, "the key for format-preserving encryption is required")
+			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+			if m.DateShiftMask.Key == "" {
+				return status.Errorf(codes.InvalidArgument, "the key for date shift is required")
+			}
+			if m.DateShiftMask.MaxShiftDays <= 0 || m.DateShiftMask.MaxShiftDays > maximumDateShiftDays {
+				return status.Errorf(codes.InvalidArgument, "the max shift days should be in the range of [1, %d]", maximumDateShiftDays)
+			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_NumericPerturbationMask_:
+			if m.NumericPerturbationMask.Key == "" {
+				return status.Errorf(codes.InvalidArgument, "the key for numeric perturbation is required")
+			}
+			if m.NumericPerturbationMask.NoiseRatio <= 0 || m.NumericPerturbationMask.NoiseRatio > 1 {
+				return status.Errorf(codes.InvalidArgument, "the noise ratio should be in the range of (0, 1]")
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -1210,8 +1391,12 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 		if algorithm.Mask == nil {
 			return nil
 		}
-		switch algorithm.Mask.(type) {
+		switch m := algorithm.Mask.(type) {
 		case *v1pb.MaskingAlgorithmSetting_Algorithm_Md5Mask:
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_TokenizationMask_:
+			if m.TokenizationMask.Key == "" {
+				return status.Errorf(codes.InvalidArgument, "the key for tokenization is required")
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -1222,6 +1407,46 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 	return nil
 }
 
+// fillMaskingAlgorithmKey fills the key of the algorithm from the old one if it is not set,
+// as the keys are not returned to the client.
+func fillMaskingAlgorithmKey(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm, oldAlgorithm *storepb.MaskingAlgorithmSetting_Algorithm) {
+	if oldAlgorithm == nil {
+		return
+	}
+	switch m := algorithm.Mask.(type) {
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_TokenizationMask_:
+		if m.TokenizationMask.Key == "" {
+			m.TokenizationMask.Key = oldAlgorithm.GetTokenizationMask().GetKey()
+		}
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask_:
+		if m.FormatPreservingEncryptionMask.Key == "" {
+			m.FormatPreservingEncryptionMask.Key = oldAlgorithm.GetFormatPreservingEncryptionMask().GetKey()
+		}
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+		if m.DateShiftMask.Key == "" {
+			m.DateShiftMask.Key = oldAlgorithm.GetDateShiftMask().GetKey()
+		}
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_NumericPerturbationMask_:
+		if m.NumericPerturbationMask.Key == "" {
+			m.NumericPerturbationMask.Key = oldAlgorithm.GetNumericPerturbationMask().GetKey()
+		}
+	}
+}
+
+// stripMaskingAlgorithmKey strips the secret key of the algorithm.
+func stripMaskingAlgorithmKey(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm) {
+	switch m := algorithm.Mask.(type) {
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_TokenizationMask_:
+		m.TokenizationMask.Key = ""
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask_:
+		m.FormatPreservingEncryptionMask.Key = ""
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+		m.DateShiftMask.Key = ""
+	case *v1pb.MaskingAlgorithmSetting_Algorithm_NumericPerturbationMask_:
+		m.NumericPerturbationMask.Key = ""
+	}
+}
+
 func checkSubstitution(substitution string) error {
 	if substitution == "" {
 		return status.Errorf(codes.InvalidArgument, "the substitution for inner or outer masks is required")
@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/metrics"
+	"github.com/bytebase/bytebase/backend/component/queryguard"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -58,6 +61,7 @@ type SQLService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	querySessions  *querySessionManager
 }
 
 // NewSQLService creates a SQLService.
@@ -78,6 +82,7 @@ func NewSQLService(
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		querySessions:  newQuerySessionManager(dbFactory),
 	}
 }
 
@@ -111,8 +116,9 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 		}
 
 		// We only need to get the driver and connection once.
+		// The session states set by the statements must not be shared with others, so the driver is not pooled.
 		if driver == nil {
-			driver, err = s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+			driver, err = s.dbFactory.GetDedicatedAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 			if err != nil {
 				return status.Errorf(codes.Internal, "failed to get database driver: %v", err)
 			}
@@ -129,7 +135,7 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 		result, durationNs, queryErr := s.doAdminExecute(ctx, driver, conn, request)
 		sanitizeResults(result)
 
-		if err := s.postQuery(ctx, database, request.Statement, user.ID, durationNs, queryErr); err != nil {
+		if err := s.postQuery(ctx, database, request.Statement, user.ID, &storepb.QueryHistoryPayload{}, durationNs, queryErr); err != nil {
 			slog.Error("failed to post admin execute activity", log.BBError(err))
 		}
 
@@ -270,7 +276,8 @@ func (s *SQLService) preExecute(ctx context.Context, request *v1pb.ExecuteReques
 }
 
 func (s *SQLService) doExecute(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, request *v1pb.ExecuteRequest) ([]*v1pb.QueryResult, int64, error) {
-	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	// The session states set by the statements must not be shared with others, so the driver is not pooled.
+	driver, err := s.dbFactory.GetDedicatedAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "failed to get database driver")
 	}
@@ -346,6 +353,15 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		if err := s.accessCheck(ctx, instance, user, spans, request.Limit, false /* isAdmin */, true /* isExport */); err != nil {
 			return nil, err
 		}
+		// The spans are computed from the statement, so the filters are applied to the same text.
+		rewritten, err := s.applyRowFilters(ctx, instance, database, "" /* dataSourceID */, user, spans, statement, request.Limit, true /* isExport */)
+		if err != nil {
+			return nil, err
+		}
+		if rewritten != statement {
+			request = proto.Clone(request).(*v1pb.ExportRequest)
+			request.Statement = rewritten
+		}
 	}
 
 	// Run SQL review.
@@ -355,7 +371,10 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 
 	bytes, durationNs, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, instance, database, spans)
 
-	if err := s.postExport(ctx, database, statement, user.ID, durationNs, exportErr); err != nil {
+	historyPayload := &storepb.QueryHistoryPayload{
+		AccessedColumns: getAccessedColumns(instance, spans),
+	}
+	if err := s.postExport(ctx, database, statement, user.ID, historyPayload, durationNs, exportErr); err != nil {
 		return nil, err
 	}
 
@@ -510,17 +529,15 @@ func DoExport(ctx context.Context, storeInstance *store.Store, dbFactory *dbfact
 	return content, durationNs, nil
 }
 
-func (s *SQLService) postExport(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error) error {
+func (s *SQLService) postExport(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, payload *storepb.QueryHistoryPayload, durationNs int64, queryErr error) error {
+	payload.Duration = durationpb.New(time.Duration(durationNs))
 	qh := &store.QueryHistoryMessage{
 		CreatorUID: userUID,
 		ProjectID:  database.ProjectID,
 		Database:   common.FormatDatabase(database.InstanceID, database.DatabaseName),
 		Statement:  statement,
 		Type:       store.QueryHistoryTypeExport,
-		Payload: &storepb.QueryHistoryPayload{
-			Error:    nil,
-			Duration: durationpb.New(time.Duration(durationNs)),
-		},
+		Payload:    payload,
 	}
 	if queryErr != nil {
 		queryErrString := queryErr.Error()
@@ -580,40 +597,21 @@ func (s *SQLService) SearchQueryHistories(ctx context.Context, request *v1pb.Sea
 	}
 	limitPlusOne := limit + 1
 
-	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
-	if !ok {
-		return nil, status.Errorf(codes.Internal, "principal ID not found")
-	}
-
-	find := &store.FindQueryHistoryMessage{
-		CreatorUID: &principalID,
-		Limit:      &limitPlusOne,
-		Offset:     &offset,
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
 	}
-
-	filters, err := parseFilter(request.Filter)
+	find, err := s.getQueryHistoryFind(ctx, user, request.Filter)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
-
-	for _, spec := range filters {
-		if spec.operator != comparatorTypeEqual {
-			return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "%v" filter`, spec.key)
-		}
-		switch spec.key {
-		case "database":
-			database := spec.value
-			find.Database = &database
-		case "instance":
-			instance := spec.value
-			find.Instance = &instance
-		case "type":
-			historyType := store.QueryHistoryType(spec.value)
-			find.Type = &historyType
-		default:
-			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
-		}
+	orderByKeys, err := getQueryHistoryOrderByKeys(request.OrderBy)
+	if err != nil {
+		return nil, err
 	}
+	find.Limit = &limitPlusOne
+	find.Offset = &offset
+	find.OrderByKeys = orderByKeys
 
 	historyList, err := s.store.ListQueryHistories(ctx, find)
 	if err != nil {
@@ -666,14 +664,17 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 	}
 
 	return &v1pb.QueryHistory{
-		Name:       fmt.Sprintf("queryHistories/%d", history.UID),
-		Statement:  history.Statement,
-		Error:      history.Payload.Error,
-		Database:   history.Database,
-		Creator:    common.FormatUserEmail(user.Email),
-		CreateTime: timestamppb.New(history.CreatedTime),
-		Duration:   history.Payload.Duration,
-		Type:       historyType,
+		Name:            fmt.Sprintf("queryHistories/%d", history.UID),
+		Statement:       history.Statement,
+		Error:           history.Payload.Error,
+		Database:        history.Database,
+		Creator:         common.FormatUserEmail(user.Email),
+		CreateTime:      timestamppb.New(history.CreatedTime),
+		Duration:        history.Payload.Duration,
+		Type:            historyType,
+		Worksheet:       history.Payload.Worksheet,
+		ParameterValues: history.Payload.ParameterValues,
+		AccessedColumns: convertToV1QueryHistoryAccessedColumns(history.Payload.AccessedColumns),
 	}, nil
 }
 
@@ -689,10 +690,24 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		return nil, err
 	}
 
+	// The query history keeps the worksheet statement with the {{name}} parameters.
+	historyStatement := request.Statement
+	var args []any
+	if request.Worksheet != "" {
+		boundStatement, boundArgs, err := s.bindQueryParameters(ctx, request, user, instance)
+		if err != nil {
+			return nil, err
+		}
+		request = proto.Clone(request).(*v1pb.QueryRequest)
+		request.Statement = boundStatement
+		args = boundArgs
+	}
+
 	statement := request.Statement
 	// In Redshift datashare, Rewrite query used for parser.
 	if database.DataShare {
 		statement = strings.ReplaceAll(statement, fmt.Sprintf("%s.", database.DatabaseName), "")
+		historyStatement = statement
 	}
 
 	// Validate the request.
@@ -722,6 +737,15 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		if err := s.accessCheck(ctx, instance, user, spans, request.Limit, false /* isAdmin */, false /* isExport */); err != nil {
 			return nil, err
 		}
+		// The spans are computed from the statement, so the filters are applied to the same text.
+		rewritten, err := s.applyRowFilters(ctx, instance, database, request.DataSourceId, user, spans, statement, request.Limit, false /* isExport */)
+		if err != nil {
+			return nil, err
+		}
+		if rewritten != statement {
+			request = proto.Clone(request).(*v1pb.QueryRequest)
+			request.Statement = rewritten
+		}
 	}
 
 	// Run SQL review.
@@ -730,21 +754,44 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		return nil, err
 	}
 
+	if adviceStatus != storepb.Advice_ERROR && !request.Explain {
+		if err := s.queryGuardCheck(ctx, request, args, user, environment, instance, database); err != nil {
+			return nil, err
+		}
+	}
+
 	var results []*v1pb.QueryResult
+	var session *querySession
 	var queryErr error
 	var durationNs int64
 	if adviceStatus != storepb.Advice_ERROR {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database)
-		if queryErr == nil && s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
-			masker := NewQueryResultMasker(s.store)
-			if err := masker.MaskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
-				return nil, status.Errorf(codes.Internal, err.Error())
+		// The cursor is not supported for the parameterized query.
+		if len(args) == 0 && canOpenQuerySession(request, instance) {
+			results, session, durationNs, queryErr = s.openQuerySession(ctx, request, user, instance, database, spans)
+		} else {
+			results, durationNs, queryErr = s.doQuery(ctx, request, args, instance, database)
+		}
+		metrics.IncQuery(instance.Engine, queryErr)
+		if queryErr == nil {
+			if err := s.maskQueryResults(ctx, spans, results, instance); err != nil {
+				if session != nil {
+					s.querySessions.remove(session.token)
+				}
+				return nil, err
 			}
 		}
 	}
 
 	// Update activity.
-	if err = s.postQuery(ctx, database, statement, user.ID, durationNs, queryErr); err != nil {
+	historyPayload := &storepb.QueryHistoryPayload{
+		Worksheet:       request.Worksheet,
+		ParameterValues: request.ParameterValues,
+		AccessedColumns: getAccessedColumns(instance, spans),
+	}
+	if err = s.postQuery(ctx, database, historyStatement, user.ID, historyPayload, durationNs, queryErr); err != nil {
+		if session != nil {
+			s.querySessions.remove(session.token)
+		}
 		return nil, err
 	}
 	if queryErr != nil {
@@ -763,12 +810,29 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		Advices:     advices,
 		AllowExport: allowExport,
 	}
+	if session != nil {
+		session.mu.Lock()
+		session.allowExport = allowExport
+		session.mu.Unlock()
+		response.NextPageToken = session.token
+	}
 
 	return response, nil
 }
 
-// doQuery does query.
-func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.QueryResult, int64, error) {
+func (s *SQLService) maskQueryResults(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage) error {
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) != nil {
+		return nil
+	}
+	masker := NewQueryResultMasker(s.store)
+	if err := masker.MaskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// doQuery does query, the args are bound to the placeholders of the statement.
+func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, args []any, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.QueryResult, int64, error) {
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
 	if err != nil {
 		return nil, 0, err
@@ -798,6 +862,7 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 		Explain:         request.Explain,
 		ReadOnly:        true,
 		CurrentDatabase: database.DatabaseName,
+		Args:            args,
 	})
 	select {
 	case <-ctx.Done():
@@ -812,17 +877,15 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 	return results, time.Now().UnixNano() - start, err
 }
 
-func (s *SQLService) postQuery(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error) error {
+func (s *SQLService) postQuery(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, payload *storepb.QueryHistoryPayload, durationNs int64, queryErr error) error {
+	payload.Duration = durationpb.New(time.Duration(durationNs))
 	qh := &store.QueryHistoryMessage{
 		CreatorUID: userUID,
 		ProjectID:  database.ProjectID,
 		Database:   common.FormatDatabase(database.InstanceID, database.DatabaseName),
 		Statement:  statement,
 		Type:       store.QueryHistoryTypeQuery,
-		Payload: &storepb.QueryHistoryPayload{
-			Error:    nil,
-			Duration: durationpb.New(time.Duration(durationNs)),
-		},
+		Payload:    payload,
 	}
 	if queryErr != nil {
 		queryErrString := queryErr.Error()
@@ -941,6 +1004,78 @@ func BuildListDatabaseNamesFunc(storeInstance *store.Store, instance *store.Inst
 	}
 }
 
+// queryGuardCheck rejects the expensive query by the query guard policy of the environment.
+// The check is skipped if the engine is not supported. If the cost of the query cannot be estimated, e.g. EXPLAIN fails,
+// the query is rejected or allowed by the reject_on_estimation_failure of the policy.
+func (s *SQLService) queryGuardCheck(ctx context.Context, request *v1pb.QueryRequest, args []any, user *store.UserMessage, environment *store.EnvironmentMessage, instance *store.InstanceMessage, database *store.DatabaseMessage) error {
+	if !queryguard.SupportEngine(instance.Engine) {
+		return nil
+	}
+	policy, err := s.store.GetQueryGuardPolicy(ctx, environment.UID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get query guard policy: %v", err)
+	}
+	if queryguard.IsPolicyEmpty(policy) || slices.Contains(policy.ExemptMembers, common.FormatUserUID(user.ID)) {
+		return nil
+	}
+	singleSQLs, err := base.SplitMultiSQL(instance.Engine, request.Statement)
+	if err != nil {
+		return queryGuardError(policy, environment, queryguard.CheckEstimationFailure(policy, err))
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get database schema: %v", err)
+	}
+	getTableRowCount := func(schemaName, tableName string) int64 {
+		for _, schema := range dbSchema.GetMetadata().GetSchemas() {
+			if !strings.EqualFold(schema.Name, schemaName) && schemaName != "" {
+				continue
+			}
+			for _, table := range schema.Tables {
+				if strings.EqualFold(table.Name, tableName) {
+					return table.RowCount
+				}
+			}
+		}
+		return 0
+	}
+
+	var reasons []string
+	for _, singleSQL := range singleSQLs {
+		estimate, err := queryguard.EstimateQuery(ctx, instance.Engine, driver, singleSQL.Text, args...)
+		if err != nil {
+			slog.Warn("failed to estimate the query cost", slog.String("instance", instance.ResourceID), slog.String("database", database.DatabaseName), log.BBError(err))
+			reasons = append(reasons, queryguard.CheckEstimationFailure(policy, err)...)
+			continue
+		}
+		reasons = append(reasons, queryguard.Check(policy, estimate, getTableRowCount)...)
+	}
+	return queryGuardError(policy, environment, reasons)
+}
+
+// queryGuardError returns the error of the query rejected by the query guard policy for the reasons, or nil if there is no reason.
+func queryGuardError(policy *storepb.QueryGuardPolicy, environment *store.EnvironmentMessage, reasons []string) error {
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	exemption := "Please contact the workspace admin or DBA to request an exemption."
+	if policy.ExemptionRequestUrl != "" {
+		exemption = fmt.Sprintf("Request an exemption at %s.", policy.ExemptionRequestUrl)
+	}
+	return status.Errorf(codes.FailedPrecondition, "the query is rejected by the query guard policy of environment %q: %s. %s", environment.Title, strings.Join(reasons, "; "), exemption)
+}
+
 func (s *SQLService) accessCheck(
 	ctx context.Context,
 	instance *store.InstanceMessage,
@@ -0,0 +1,517 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/bytebase/bytebase/backend/component/metrics"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/standard"
+	"github.com/bytebase/bytebase/backend/plugin/parser/tokenizer"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// defaultFederatedSourceLimit is the default maximum number of rows read from each source table.
+	defaultFederatedSourceLimit = 10000
+	// maximumFederatedSourceLimit is the maximum number of rows read from each source table.
+	maximumFederatedSourceLimit = 100000
+	// sqliteRecursive is the authorizer action code of the recursive CTEs, which is not exported by the sqlite3 driver.
+	sqliteRecursive = 33
+)
+
+// federatedReference is a dotted identifier chain in the federated statement, which may reference a source table.
+type federatedReference struct {
+	// start and end are the byte offsets of the reference in the statement.
+	start int
+	end   int
+	parts []string
+}
+
+// federatedSource is a source table pushed down to its database.
+type federatedSource struct {
+	instance *store.InstanceMessage
+	database *store.DatabaseMessage
+	schema   string
+	table    string
+	// name is the table name in the in-process engine.
+	name string
+}
+
+// FederatedQuery joins and aggregates the tables across instances and databases in an in-process engine.
+// Each source table is read through its own driver with the access check and masking as Query,
+// and the statement runs on the results in an in-memory SQLite database.
+func (s *SQLService) FederatedQuery(ctx context.Context, request *v1pb.FederatedQueryRequest) (*v1pb.QueryResponse, error) {
+	if err := s.licenseService.IsFeatureEnabled(api.FeatureFederatedQuery); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Statement == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "statement is required")
+	}
+	if err := validateFederatedStatement(request.Statement); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	sourceLimit := int(request.SourceLimit)
+	if sourceLimit <= 0 {
+		sourceLimit = defaultFederatedSourceLimit
+	}
+	if sourceLimit > maximumFederatedSourceLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "source limit must not exceed %d", maximumFederatedSourceLimit)
+	}
+	timeout := defaultTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sources, statement, err := s.resolveFederatedSources(ctx, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no source table is referenced, reference the tables as instance.database.table")
+	}
+
+	start := time.Now()
+	engine, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to open the in-process engine: %v", err)
+	}
+	defer engine.Close()
+	// The in-memory database is private to a connection.
+	conn, err := engine.Conn(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to open the in-process engine: %v", err)
+	}
+	defer conn.Close()
+
+	for _, source := range sources {
+		result, err := s.queryFederatedSource(ctx, user, source, sourceLimit)
+		if err != nil {
+			return nil, err
+		}
+		if err := loadFederatedSource(ctx, conn, source.name, result); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load %s: %v", source.displayName(), err)
+		}
+	}
+
+	if err := restrictFederatedEngine(conn); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restrict the in-process engine: %v", err)
+	}
+	if request.Limit > 0 {
+		statement = fmt.Sprintf("SELECT * FROM (\n%s\n) LIMIT %d", strings.TrimRight(strings.TrimSpace(statement), ";"), request.Limit)
+	}
+	result, queryErr := util.Query(ctx, storepb.Engine_SQLITE, conn, statement, nil)
+	if queryErr == nil {
+		results := []*v1pb.QueryResult{result}
+		sanitizeResults(results)
+		// Show the original statement instead of the rewritten one.
+		result.Statement = request.Statement
+	}
+
+	// Record the query history in each source database.
+	recorded := make(map[int]bool)
+	for _, source := range sources {
+		if recorded[source.database.UID] {
+			continue
+		}
+		recorded[source.database.UID] = true
+		if err := s.postQuery(ctx, source.database, request.Statement, user.ID, &storepb.QueryHistoryPayload{}, time.Since(start).Nanoseconds(), queryErr); err != nil {
+			return nil, err
+		}
+	}
+	if queryErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to run the federated query: %v", queryErr)
+	}
+
+	return &v1pb.QueryResponse{
+		Results: []*v1pb.QueryResult{result},
+		// The federated results are not exportable as no single database owns them.
+		AllowExport: false,
+	}, nil
+}
+
+// validateFederatedStatement checks that the statement is a single SELECT statement.
+func validateFederatedStatement(statement string) error {
+	text, err := tokenizer.StandardRemoveQuotedTextAndComment(statement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the statement")
+	}
+	// Only the trailing semicolon is allowed.
+	if strings.Contains(strings.TrimRight(strings.TrimSpace(text), ";"), ";") || !standard.CheckStatementWithoutQuotedTextAndComment(text) {
+		return errors.New("only a single SELECT statement is supported")
+	}
+	return nil
+}
+
+// restrictFederatedEngine limits the in-process engine to read the loaded tables before running the user statement.
+// Attaching other databases, including the files on the server, is disabled, and the authorizer denies everything
+// except reading the tables, calling the functions and the transaction wrapping the query, e.g. PRAGMA, VACUUM, DDL and DML.
+func restrictFederatedEngine(conn *sql.Conn) error {
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return errors.Errorf("unexpected connection type %T", driverConn)
+		}
+		c.SetLimit(sqlite3.SQLITE_LIMIT_ATTACHED, 0)
+		c.RegisterAuthorizer(func(action int, _, _, _ string) int {
+			switch action {
+			case sqlite3.SQLITE_SELECT, sqlite3.SQLITE_READ, sqlite3.SQLITE_FUNCTION, sqliteRecursive, sqlite3.SQLITE_TRANSACTION:
+				return sqlite3.SQLITE_OK
+			default:
+				return sqlite3.SQLITE_DENY
+			}
+		})
+		return nil
+	})
+}
+
+// resolveFederatedSources finds the source tables referenced in the statement,
+// and rewrites the statement to reference the tables in the in-process engine.
+// The dotted identifiers not starting with an instance resource id are left as is, e.g. schema.table.column in SQLite.
+func (s *SQLService) resolveFederatedSources(ctx context.Context, statement string) ([]*federatedSource, string, error) {
+	var sources []*federatedSource
+	sourceMap := make(map[string]*federatedSource)
+	var sb strings.Builder
+	last := 0
+	for _, reference := range parseFederatedReferences(statement) {
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &reference.parts[0]})
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "failed to get instance %q: %v", reference.parts[0], err)
+		}
+		if instance == nil {
+			continue
+		}
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:   &instance.ResourceID,
+			DatabaseName: &reference.parts[1],
+		})
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "failed to get database %q: %v", reference.parts[1], err)
+		}
+		if database == nil {
+			return nil, "", status.Errorf(codes.NotFound, "database %q not found in instance %q", reference.parts[1], instance.ResourceID)
+		}
+		source := &federatedSource{
+			instance: instance,
+			database: database,
+			table:    reference.parts[len(reference.parts)-1],
+		}
+		if len(reference.parts) == 4 {
+			source.schema = reference.parts[2]
+		}
+		key := strings.Join(reference.parts, ".")
+		if existing, ok := sourceMap[key]; ok {
+			source = existing
+		} else {
+			source.name = fmt.Sprintf("source_%d", len(sources)+1)
+			sourceMap[key] = source
+			sources = append(sources, source)
+		}
+		sb.WriteString(statement[last:reference.start])
+		sb.WriteString(source.name)
+		last = reference.end
+	}
+	sb.WriteString(statement[last:])
+	return sources, sb.String(), nil
+}
+
+// queryFederatedSource reads the rows of the source table as Query does, with the access check and masking.
+func (s *SQLService) queryFederatedSource(ctx context.Context, user *store.UserMessage, source *federatedSource, sourceLimit int) (*v1pb.QueryResult, error) {
+	instance, database := source.instance, source.database
+	statement := getFederatedSourceStatement(instance.Engine, source.schema, source.table)
+	spans, err := base.GetQuerySpan(
+		ctx,
+		base.GetQuerySpanContext{
+			GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(s.store, instance),
+			ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(s.store, instance),
+			GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(s.store, instance),
+		},
+		instance.Engine,
+		statement,
+		database.DatabaseName,
+		"",
+		store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get query span for %s: %v", source.displayName(), err)
+	}
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		if err := s.accessCheck(ctx, instance, user, spans, int32(sourceLimit), false /* isAdmin */, false /* isExport */); err != nil {
+			return nil, err
+		}
+		statement, err = s.applyRowFilters(ctx, instance, database, "" /* dataSourceID */, user, spans, statement, int32(sourceLimit), false /* isExport */)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The source is pushed down as a whole table read bounded by the source limit,
+	// so the query guard policy, which targets the unbounded ad-hoc queries, is not applied.
+	// One more row than the limit is read to tell whether the table is truncated, and it is never returned.
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver for %s: %v", source.displayName(), err)
+	}
+	defer driver.Close(ctx)
+	var conn *sql.Conn
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get connection for %s: %v", source.displayName(), err)
+		}
+		defer conn.Close()
+	}
+	results, err := driver.QueryConn(ctx, conn, statement, &db.QueryContext{
+		Limit:           sourceLimit + 1,
+		ReadOnly:        true,
+		CurrentDatabase: database.DatabaseName,
+	})
+	metrics.IncQuery(instance.Engine, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query %s: %v", source.displayName(), err)
+	}
+	if len(results) != 1 {
+		return nil, status.Errorf(codes.Internal, "expect 1 result for %s but got %d", source.displayName(), len(results))
+	}
+	if results[0].Error != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to query %s: %s", source.displayName(), results[0].Error)
+	}
+	if err := validateFederatedSourceSize(results[0], sourceLimit); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", source.displayName(), err)
+	}
+	sanitizeResults(results)
+	if err := s.maskQueryResults(ctx, spans, results, instance); err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// validateFederatedSourceSize fails the source read with more rows than the source limit.
+// The federated statement runs on the whole source tables, so a truncated table would silently give wrong joins and aggregates.
+func validateFederatedSourceSize(result *v1pb.QueryResult, sourceLimit int) error {
+	if len(result.Rows) > sourceLimit {
+		return errors.Errorf("the table has more than %d rows, the federated query only supports the tables within the source limit", sourceLimit)
+	}
+	return nil
+}
+
+func (source *federatedSource) displayName() string {
+	parts := []string{source.instance.ResourceID, source.database.DatabaseName}
+	if source.schema != "" {
+		parts = append(parts, source.schema)
+	}
+	parts = append(parts, source.table)
+	return strings.Join(parts, ".")
+}
+
+func getFederatedSourceStatement(engine storepb.Engine, schema, table string) string {
+	quote := func(identifier string) string {
+		switch engine {
+		case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_STARROCKS, storepb.Engine_DORIS, storepb.Engine_CLICKHOUSE, storepb.Engine_BIGQUERY, storepb.Engine_SPANNER, storepb.Engine_DATABRICKS, storepb.Engine_HIVE:
+			return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+		case storepb.Engine_MSSQL:
+			return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+		default:
+			return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+		}
+	}
+	name := quote(table)
+	if schema != "" {
+		name = fmt.Sprintf("%s.%s", quote(schema), name)
+	}
+	return fmt.Sprintf("SELECT * FROM %s", name)
+}
+
+// loadFederatedSource creates the table in the in-process engine and inserts the rows.
+// The columns are untyped, so that the values keep their types from the source.
+func loadFederatedSource(ctx context.Context, conn *sql.Conn, name string, result *v1pb.QueryResult) error {
+	if len(result.ColumnNames) == 0 {
+		return errors.New("no columns")
+	}
+	var columns, placeholders []string
+	for _, column := range result.ColumnNames {
+		columns = append(columns, fmt.Sprintf(`"%s"`, strings.ReplaceAll(column, `"`, `""`)))
+		placeholders = append(placeholders, "?")
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE TABLE %s (%s)", name, strings.Join(columns, ", "))); err != nil {
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s VALUES (%s)", name, strings.Join(placeholders, ", ")))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, row := range result.Rows {
+		values := make([]any, len(result.ColumnNames))
+		for i, value := range row.Values {
+			if i < len(values) {
+				values[i] = convertRowValueToFederatedValue(value)
+			}
+		}
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func convertRowValueToFederatedValue(value *v1pb.RowValue) any {
+	switch v := value.GetKind().(type) {
+	case *v1pb.RowValue_BoolValue:
+		return v.BoolValue
+	case *v1pb.RowValue_BytesValue:
+		return v.BytesValue
+	case *v1pb.RowValue_DoubleValue:
+		return v.DoubleValue
+	case *v1pb.RowValue_FloatValue:
+		return float64(v.FloatValue)
+	case *v1pb.RowValue_Int32Value:
+		return int64(v.Int32Value)
+	case *v1pb.RowValue_Int64Value:
+		return v.Int64Value
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue
+	case *v1pb.RowValue_Uint32Value:
+		return int64(v.Uint32Value)
+	case *v1pb.RowValue_Uint64Value:
+		// SQLite integers are signed 64-bit.
+		return fmt.Sprintf("%d", v.Uint64Value)
+	case *v1pb.RowValue_ValueValue:
+		b, err := v.ValueValue.MarshalJSON()
+		if err != nil {
+			return nil
+		}
+		return string(b)
+	default:
+		return nil
+	}
+}
+
+// parseFederatedReferences finds the dotted identifier chains with 3 or 4 parts in the statement,
+// skipping the string literals and comments.
+func parseFederatedReferences(statement string) []*federatedReference {
+	var references []*federatedReference
+	runes := []rune(statement)
+	// offsets maps the rune index to the byte offset.
+	offsets := make([]int, len(runes)+1)
+	offset := 0
+	for i, r := range runes {
+		offsets[i] = offset
+		offset += len(string(r))
+	}
+	offsets[len(runes)] = offset
+
+	// readIdentifier reads an identifier at i, and returns the identifier and the index after it.
+	readIdentifier := func(i int) (string, int, bool) {
+		if i >= len(runes) {
+			return "", i, false
+		}
+		switch runes[i] {
+		case '"', '`':
+			quote := runes[i]
+			var sb strings.Builder
+			for j := i + 1; j < len(runes); j++ {
+				if runes[j] == quote {
+					if j+1 < len(runes) && runes[j+1] == quote {
+						sb.WriteRune(quote)
+						j++
+						continue
+					}
+					return sb.String(), j + 1, true
+				}
+				sb.WriteRune(runes[j])
+			}
+			return "", i, false
+		default:
+			if !unicode.IsLetter(runes[i]) && runes[i] != '_' {
+				return "", i, false
+			}
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '$') {
+				j++
+			}
+			return string(runes[i:j]), j, true
+		}
+	}
+
+	for i := 0; i < len(runes); {
+		switch {
+		case runes[i] == '\'':
+			// String literal, where '' is an escaped quote.
+			i++
+			for i < len(runes) {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					break
+				}
+				i++
+			}
+			i++
+		case runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/') {
+				i++
+			}
+			i += 2
+		default:
+			part, next, ok := readIdentifier(i)
+			if !ok {
+				i++
+				continue
+			}
+			parts := []string{part}
+			end := next
+			for end < len(runes) && runes[end] == '.' {
+				part, next, ok := readIdentifier(end + 1)
+				if !ok {
+					break
+				}
+				parts = append(parts, part)
+				end = next
+			}
+			if len(parts) == 3 || len(parts) == 4 {
+				references = append(references, &federatedReference{
+					start: offsets[i],
+					end:   offsets[min(end, len(runes))],
+					parts: parts,
+				})
+			}
+			i = end
+		}
+	}
+	return references
+}
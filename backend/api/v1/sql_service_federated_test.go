@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestParseFederatedReferences(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      [][]string
+	}{
+		{
+			statement: "SELECT * FROM mysql1.db.users u JOIN pg1.db.public.orders o ON u.id = o.user_id",
+			want:      [][]string{{"mysql1", "db", "users"}, {"pg1", "db", "public", "orders"}},
+		},
+		{
+			statement: "SELECT * FROM \"prod-mysql\".`my db`.t WHERE name = 'a.b.c' -- x.y.z\n/* p.q.r */",
+			want:      [][]string{{"prod-mysql", "my db", "t"}},
+		},
+		{
+			statement: "SELECT u.id, main.t.c FROM t u",
+			want:      [][]string{{"main", "t", "c"}},
+		},
+		{
+			statement: "SELECT 1.2, 'it''s a.b.c'",
+			want:      nil,
+		},
+	}
+
+	for _, test := range tests {
+		var got [][]string
+		for _, reference := range parseFederatedReferences(test.statement) {
+			got = append(got, reference.parts)
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+
+	statement := "SELECT * FROM \"prod-mysql\".db.t"
+	references := parseFederatedReferences(statement)
+	require.Len(t, references, 1)
+	require.Equal(t, "\"prod-mysql\".db.t", statement[references[0].start:references[0].end])
+}
+
+func TestGetFederatedSourceStatement(t *testing.T) {
+	require.Equal(t, "SELECT * FROM `t``1`", getFederatedSourceStatement(storepb.Engine_MYSQL, "", "t`1"))
+	require.Equal(t, `SELECT * FROM "public"."orders"`, getFederatedSourceStatement(storepb.Engine_POSTGRES, "public", "orders"))
+	require.Equal(t, "SELECT * FROM [dbo].[t]", getFederatedSourceStatement(storepb.Engine_MSSQL, "dbo", "t"))
+}
+
+func TestValidateFederatedSourceSize(t *testing.T) {
+	a := require.New(t)
+	result := &v1pb.QueryResult{Rows: []*v1pb.QueryRow{{}, {}}}
+	a.NoError(validateFederatedSourceSize(result, 2))
+	// The source is read with one more row than the limit, which means the table is truncated.
+	result.Rows = append(result.Rows, &v1pb.QueryRow{})
+	a.Error(validateFederatedSourceSize(result, 2))
+}
+
+func TestLoadFederatedSource(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	engine, err := sql.Open("sqlite3", ":memory:")
+	a.NoError(err)
+	defer engine.Close()
+	conn, err := engine.Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+
+	a.NoError(loadFederatedSource(ctx, conn, "source_1", &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}, {Kind: &v1pb.RowValue_StringValue{StringValue: "alice"}}}},
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int32Value{Int32Value: 2}}, {Kind: &v1pb.RowValue_StringValue{StringValue: "******"}}}},
+		},
+	}))
+	a.NoError(loadFederatedSource(ctx, conn, "source_2", &v1pb.QueryResult{
+		ColumnNames: []string{"user_id", "amount"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}, {Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 10.5}}}},
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}, {Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 2}}}},
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}}, {Kind: &v1pb.RowValue_NullValue{}}}},
+		},
+	}))
+
+	result, err := util.Query(ctx, storepb.Engine_SQLITE, conn, "SELECT u.name, SUM(o.amount) AS total FROM source_1 u JOIN source_2 o ON u.id = o.user_id GROUP BY u.name ORDER BY u.name", nil)
+	a.NoError(err)
+	a.Empty(result.Error)
+	a.Equal([]string{"name", "total"}, result.ColumnNames)
+	a.Len(result.Rows, 2)
+	// The masked values are joined as they are.
+	a.Equal("******", result.Rows[0].Values[0].GetStringValue())
+	a.Equal("alice", result.Rows[1].Values[0].GetStringValue())
+}
+
+func TestValidateFederatedStatement(t *testing.T) {
+	a := require.New(t)
+	a.NoError(validateFederatedStatement("SELECT * FROM mysql1.db.users;"))
+	a.NoError(validateFederatedStatement("WITH t AS (SELECT 1) SELECT * FROM t"))
+	a.Error(validateFederatedStatement("SELECT 1; SELECT 2"))
+	a.Error(validateFederatedStatement("ATTACH DATABASE '/etc/passwd' AS x"))
+	a.Error(validateFederatedStatement("PRAGMA table_info(source_1)"))
+	a.Error(validateFederatedStatement("DELETE FROM mysql1.db.users"))
+}
+
+func TestRestrictFederatedEngine(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	engine, err := sql.Open("sqlite3", ":memory:")
+	a.NoError(err)
+	defer engine.Close()
+	conn, err := engine.Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+	a.NoError(loadFederatedSource(ctx, conn, "source_1", &v1pb.QueryResult{
+		ColumnNames: []string{"id"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}}},
+		},
+	}))
+	a.NoError(restrictFederatedEngine(conn))
+
+	// Attaching databases is rejected, so are the statements other than reading the loaded tables.
+	for _, statement := range []string{
+		"ATTACH DATABASE ':memory:' AS other",
+		"ATTACH DATABASE 'file:/tmp/federated.db' AS other",
+		"PRAGMA writable_schema = ON",
+		"VACUUM",
+		"CREATE TABLE t (id INT)",
+		"INSERT INTO source_1 VALUES (2)",
+		"DROP TABLE source_1",
+	} {
+		_, err := conn.ExecContext(ctx, statement)
+		a.Error(err, statement)
+	}
+
+	result, err := util.Query(ctx, storepb.Engine_SQLITE, conn, "WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 3) SELECT COUNT(*) FROM n JOIN source_1 ON n.i = source_1.id", nil)
+	a.NoError(err)
+	a.Empty(result.Error)
+	a.Equal("1", result.Rows[0].Values[0].GetStringValue())
+}
@@ -0,0 +1,319 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// defaultQueryHistoryAggregationLimit is the default maximum number of the query history aggregations.
+	defaultQueryHistoryAggregationLimit = 100
+	// maximumQueryHistoryAggregationLimit is the maximum number of the query history aggregations.
+	maximumQueryHistoryAggregationLimit = 1000
+)
+
+// getAccessedColumns returns the sorted distinct columns accessed by the query spans on the instance.
+// The columns from the linked servers are skipped.
+func getAccessedColumns(instance *store.InstanceMessage, spans []*base.QuerySpan) []*storepb.QueryHistoryPayload_AccessedColumn {
+	columns := make(map[base.ColumnResource]bool)
+	add := func(sourceColumns base.SourceColumnSet) {
+		for column := range sourceColumns {
+			if column.Server != "" || column.Table == "" {
+				continue
+			}
+			columns[column] = true
+		}
+	}
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		add(span.SourceColumns)
+		for _, result := range span.Results {
+			add(result.SourceColumns)
+		}
+	}
+
+	var resources []base.ColumnResource
+	for column := range columns {
+		resources = append(resources, column)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].String() < resources[j].String()
+	})
+
+	var accessedColumns []*storepb.QueryHistoryPayload_AccessedColumn
+	for _, resource := range resources {
+		accessedColumns = append(accessedColumns, &storepb.QueryHistoryPayload_AccessedColumn{
+			Database: common.FormatDatabase(instance.ResourceID, resource.Database),
+			Schema:   resource.Schema,
+			Table:    resource.Table,
+			Column:   resource.Column,
+		})
+	}
+	return accessedColumns
+}
+
+// getQueryHistoryFind parses the query history filter.
+// The query histories of the current user are returned by default,
+// and the query histories of other users require the bb.auditLogs.get permission in the workspace or the projects.
+func (s *SQLService) getQueryHistoryFind(ctx context.Context, user *store.UserMessage, filter string) (*store.FindQueryHistoryMessage, error) {
+	find := &store.FindQueryHistoryMessage{
+		CreatorUID: &user.ID,
+	}
+
+	filters, err := parseFilter(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	for _, spec := range filters {
+		if spec.key == "create_time" {
+			if spec.operator != comparatorTypeGreaterEqual && spec.operator != comparatorTypeLessEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "<=" or ">=" operation for "create_time" filter`)
+			}
+			t, err := time.Parse(time.RFC3339, spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to parse create_time %s, err: %v", spec.value, err)
+			}
+			ts := t.Unix()
+			if spec.operator == comparatorTypeGreaterEqual {
+				find.CreatedTsAfter = &ts
+			} else {
+				find.CreatedTsBefore = &ts
+			}
+			continue
+		}
+
+		if spec.operator != comparatorTypeEqual {
+			return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "%v" filter`, spec.key)
+		}
+		value := spec.value
+		switch spec.key {
+		case "database":
+			find.Database = &value
+		case "instance":
+			find.Instance = &value
+		case "type":
+			historyType := store.QueryHistoryType(value)
+			find.Type = &historyType
+		case "project":
+			projectID, err := common.GetProjectID(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid project filter %q", value)
+			}
+			find.ProjectID = &projectID
+		case "creator":
+			if value == "*" {
+				find.CreatorUID = nil
+				continue
+			}
+			email, err := common.GetUserEmail(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid creator filter %q", value)
+			}
+			creator, err := s.store.GetUserByEmail(ctx, email)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user %q, error: %v", email, err)
+			}
+			if creator == nil {
+				return nil, status.Errorf(codes.NotFound, "user %q not found", email)
+			}
+			find.CreatorUID = &creator.ID
+		case "statement":
+			if strings.TrimSpace(value) == "" {
+				continue
+			}
+			find.Statement = &value
+		case "schema":
+			find.Schema = &value
+		case "table":
+			find.Table = &value
+		case "column":
+			find.Column = &value
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+		}
+	}
+
+	if find.CreatorUID == nil || *find.CreatorUID != user.ID {
+		projectIDs, err := getProjectIDsWithPermission(ctx, s.store, user, s.iamManager, iam.PermissionAuditLogsGet)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get projects with permission, error: %v", err)
+		}
+		if projectIDs != nil {
+			if len(*projectIDs) == 0 {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied to get the query histories of other users, require %q", iam.PermissionAuditLogsGet)
+			}
+			find.ProjectIDs = projectIDs
+		}
+	}
+	return find, nil
+}
+
+func getQueryHistoryOrderByKeys(orderBy string) ([]store.OrderByKey, error) {
+	keys, err := parseOrderBy(orderBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	orderByKeys := []store.OrderByKey{}
+	for _, orderByKey := range keys {
+		key := ""
+		switch orderByKey.key {
+		case "create_time":
+			key = "created_ts"
+		case "duration":
+			key = "duration"
+		}
+		if key == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order by key %v", orderByKey.key)
+		}
+
+		sortOrder := store.ASC
+		if !orderByKey.isAscend {
+			sortOrder = store.DESC
+		}
+		orderByKeys = append(orderByKeys, store.OrderByKey{
+			Key:       key,
+			SortOrder: sortOrder,
+		})
+	}
+	return orderByKeys, nil
+}
+
+func getQueryHistoryAggregationOrderByKeys(orderBy string) ([]store.OrderByKey, error) {
+	keys, err := parseOrderBy(orderBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	orderByKeys := []store.OrderByKey{}
+	for _, orderByKey := range keys {
+		key := ""
+		switch orderByKey.key {
+		case "count", "error_count", "p50_duration", "p90_duration", "p99_duration", "max_duration":
+			key = orderByKey.key
+		case "last_query_time":
+			key = "last_query_ts"
+		}
+		if key == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order by key %v", orderByKey.key)
+		}
+
+		sortOrder := store.ASC
+		if !orderByKey.isAscend {
+			sortOrder = store.DESC
+		}
+		orderByKeys = append(orderByKeys, store.OrderByKey{
+			Key:       key,
+			SortOrder: sortOrder,
+		})
+	}
+	return orderByKeys, nil
+}
+
+// AggregateQueryHistories aggregates the query histories by user, database, instance or accessed table.
+func (s *SQLService) AggregateQueryHistories(ctx context.Context, request *v1pb.AggregateQueryHistoriesRequest) (*v1pb.AggregateQueryHistoriesResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupBy store.QueryHistoryGroupBy
+	switch request.GroupBy {
+	case v1pb.AggregateQueryHistoriesRequest_USER:
+		groupBy = store.QueryHistoryGroupByUser
+	case v1pb.AggregateQueryHistoriesRequest_DATABASE:
+		groupBy = store.QueryHistoryGroupByDatabase
+	case v1pb.AggregateQueryHistoriesRequest_TABLE:
+		groupBy = store.QueryHistoryGroupByTable
+	case v1pb.AggregateQueryHistoriesRequest_INSTANCE:
+		groupBy = store.QueryHistoryGroupByInstance
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported group by %v", request.GroupBy)
+	}
+
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultQueryHistoryAggregationLimit
+	}
+	if limit > maximumQueryHistoryAggregationLimit {
+		limit = maximumQueryHistoryAggregationLimit
+	}
+
+	find, err := s.getQueryHistoryFind(ctx, user, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	orderByKeys, err := getQueryHistoryAggregationOrderByKeys(request.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregations, err := s.store.AggregateQueryHistories(ctx, find, groupBy, orderByKeys, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to aggregate query histories, error: %v", err)
+	}
+
+	resp := &v1pb.AggregateQueryHistoriesResponse{}
+	for _, aggregation := range aggregations {
+		v1Aggregation := &v1pb.QueryHistoryAggregation{
+			Instance:      aggregation.Instance,
+			Database:      aggregation.Database,
+			Schema:        aggregation.Schema,
+			Table:         aggregation.Table,
+			Count:         aggregation.Count,
+			ErrorCount:    aggregation.ErrorCount,
+			P50Duration:   convertSecondsToDuration(aggregation.P50Duration),
+			P90Duration:   convertSecondsToDuration(aggregation.P90Duration),
+			P99Duration:   convertSecondsToDuration(aggregation.P99Duration),
+			MaxDuration:   convertSecondsToDuration(aggregation.MaxDuration),
+			LastQueryTime: timestamppb.New(time.Unix(aggregation.LastQueryTs, 0)),
+		}
+		if groupBy == store.QueryHistoryGroupByUser {
+			creator, err := s.store.GetUserByID(ctx, aggregation.CreatorUID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user %d, error: %v", aggregation.CreatorUID, err)
+			}
+			if creator == nil {
+				continue
+			}
+			v1Aggregation.User = common.FormatUserEmail(creator.Email)
+		}
+		resp.Aggregations = append(resp.Aggregations, v1Aggregation)
+	}
+	return resp, nil
+}
+
+func convertSecondsToDuration(seconds float64) *durationpb.Duration {
+	return durationpb.New(time.Duration(math.Round(seconds * float64(time.Second))))
+}
+
+func convertToV1QueryHistoryAccessedColumns(accessedColumns []*storepb.QueryHistoryPayload_AccessedColumn) []*v1pb.QueryHistory_AccessedColumn {
+	var result []*v1pb.QueryHistory_AccessedColumn
+	for _, accessedColumn := range accessedColumns {
+		result = append(result, &v1pb.QueryHistory_AccessedColumn{
+			Database: accessedColumn.Database,
+			Schema:   accessedColumn.Schema,
+			Table:    accessedColumn.Table,
+			Column:   accessedColumn.Column,
+		})
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetAccessedColumns(t *testing.T) {
+	a := require.New(t)
+	instance := &store.InstanceMessage{ResourceID: "prod"}
+	ssn := base.ColumnResource{Database: "crm", Schema: "public", Table: "customers", Column: "ssn"}
+	id := base.ColumnResource{Database: "crm", Schema: "public", Table: "customers", Column: "id"}
+	spans := []*base.QuerySpan{
+		{
+			Results: []base.QuerySpanResult{
+				{Name: "ssn", SourceColumns: base.SourceColumnSet{ssn: true}},
+			},
+			SourceColumns: base.SourceColumnSet{
+				ssn: true,
+				id:  true,
+				// The columns from the linked servers are skipped.
+				{Server: "remote", Database: "crm", Schema: "public", Table: "orders", Column: "id"}: true,
+			},
+		},
+		{
+			SourceColumns: base.SourceColumnSet{
+				{Database: "billing", Schema: "public", Table: "invoices", Column: "amount"}: true,
+			},
+		},
+	}
+
+	a.Equal([]*storepb.QueryHistoryPayload_AccessedColumn{
+		{Database: "instances/prod/databases/billing", Schema: "public", Table: "invoices", Column: "amount"},
+		{Database: "instances/prod/databases/crm", Schema: "public", Table: "customers", Column: "id"},
+		{Database: "instances/prod/databases/crm", Schema: "public", Table: "customers", Column: "ssn"},
+	}, getAccessedColumns(instance, spans))
+	a.Nil(getAccessedColumns(instance, nil))
+}
+
+func TestGetQueryHistoryOrderByKeys(t *testing.T) {
+	a := require.New(t)
+
+	keys, err := getQueryHistoryOrderByKeys("duration desc, create_time")
+	a.NoError(err)
+	a.Equal([]store.OrderByKey{
+		{Key: "duration", SortOrder: store.DESC},
+		{Key: "created_ts", SortOrder: store.ASC},
+	}, keys)
+
+	keys, err = getQueryHistoryAggregationOrderByKeys("p99_duration desc, last_query_time")
+	a.NoError(err)
+	a.Equal([]store.OrderByKey{
+		{Key: "p99_duration", SortOrder: store.DESC},
+		{Key: "last_query_ts", SortOrder: store.ASC},
+	}, keys)
+
+	_, err = getQueryHistoryOrderByKeys("statement")
+	a.Error(err)
+	_, err = getQueryHistoryAggregationOrderByKeys("duration")
+	a.Error(err)
+}
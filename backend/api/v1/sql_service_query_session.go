@@ -0,0 +1,387 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// querySessionIdleTimeout is the duration after which an idle query session is closed.
+	querySessionIdleTimeout = 10 * time.Minute
+	// maximumQuerySessionsPerUser is the maximum number of open query sessions per user.
+	// The least recently used session is closed when a user opens more.
+	maximumQuerySessionsPerUser = 10
+	// maximumQuerySessions is the maximum number of open query sessions of the server.
+	maximumQuerySessions = 200
+)
+
+// getMaximumQuerySessionsPerInstance returns the maximum number of open query sessions of the instance.
+// Each session holds a connection, so the sessions take at most half of the instance maximum connections
+// to leave the rest for the other queries and the background jobs.
+func getMaximumQuerySessionsPerInstance(instance *store.InstanceMessage) int {
+	return max(1, dbfactory.GetInstanceMaximumConnections(instance)/2)
+}
+
+// querySession is a query opened with a server-side cursor, whose rows are fetched page by page.
+// The sessions live in the memory of the server that opened them, so the deployments with multiple replicas
+// must route the requests of a client to the same replica, e.g. by the session affinity of the load balancer.
+// Otherwise, FetchNext returns NotFound and the client has to run the query again.
+type querySession struct {
+	token    string
+	userID   int
+	instance *store.InstanceMessage
+	spans    []*base.QuerySpan
+	pageSize int
+	timeout  time.Duration
+	// limit is the maximum number of rows of the query checked by the access control, 0 means no limit.
+	// The session ends once the fetched rows reach the limit, the same as the query returning the rows with the limit.
+	limit int
+
+	// cancel aborts the query, it is safe to call without holding mu.
+	cancel    context.CancelFunc
+	idleTimer *time.Timer
+	// lastAccess is protected by the mutex of querySessionManager.
+	lastAccess time.Time
+
+	mu          sync.Mutex
+	driver      db.Driver
+	conn        *sql.Conn
+	cursor      *util.Cursor
+	allowExport bool
+	closed      bool
+	// fetched is the number of the rows fetched.
+	fetched int
+	// release releases the connection slot reserved for the session.
+	release func()
+}
+
+// fetch reads the next page of the rows.
+func (qs *querySession) fetch(pageSize int) (*v1pb.QueryResult, error) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	if qs.closed {
+		return nil, errors.New("query session is closed")
+	}
+	qs.idleTimer.Reset(querySessionIdleTimeout)
+
+	if qs.limit > 0 {
+		pageSize = min(pageSize, qs.limit-qs.fetched)
+	}
+
+	// The query is bound to the session context, so the timeout aborts the whole session.
+	timer := time.AfterFunc(qs.timeout, qs.cancel)
+	result, err := qs.cursor.Fetch(pageSize)
+	if !timer.Stop() {
+		return nil, errors.Errorf("timeout reached: %v", qs.timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+	qs.fetched += len(result.Rows)
+	return result, nil
+}
+
+func (qs *querySession) done() bool {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	return qs.closed || qs.cursor.Done() || (qs.limit > 0 && qs.fetched >= qs.limit)
+}
+
+// close aborts the query and releases the connection.
+func (qs *querySession) close() {
+	qs.cancel()
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	if qs.closed {
+		return
+	}
+	qs.closed = true
+	qs.idleTimer.Stop()
+	if err := qs.cursor.Close(); err != nil {
+		slog.Debug("failed to close the query cursor", log.BBError(err))
+	}
+	if err := qs.conn.Close(); err != nil {
+		slog.Debug("failed to close the query connection", log.BBError(err))
+	}
+	if err := qs.driver.Close(context.Background()); err != nil {
+		slog.Debug("failed to close the query driver", log.BBError(err))
+	}
+	qs.release()
+}
+
+// querySessionManager keeps the open query sessions by their page tokens.
+type querySessionManager struct {
+	dbFactory *dbfactory.DBFactory
+
+	mu       sync.Mutex
+	sessions map[string]*querySession
+	// reserved is the number of the reserved session slots per instance UID.
+	reserved map[int]int
+	// total is the number of the reserved session slots of the server.
+	total int
+}
+
+func newQuerySessionManager(dbFactory *dbfactory.DBFactory) *querySessionManager {
+	return &querySessionManager{
+		dbFactory: dbFactory,
+		sessions:  make(map[string]*querySession),
+		reserved:  make(map[int]int),
+	}
+}
+
+// reserve reserves a session slot and a connection of the instance.
+// It returns false if the server or the instance has too many sessions, or the instance has reached its maximum connections.
+// Upon successful return, the returned function must be called once to release the slot.
+func (m *querySessionManager) reserve(instance *store.InstanceMessage) (func(), bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.total >= maximumQuerySessions || m.reserved[instance.UID] >= getMaximumQuerySessionsPerInstance(instance) {
+		return nil, false
+	}
+	if !m.dbFactory.TryAcquireInstanceConnection(instance) {
+		return nil, false
+	}
+	m.total++
+	m.reserved[instance.UID]++
+	return func() {
+		m.dbFactory.ReleaseInstanceConnection(instance)
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.total--
+		m.reserved[instance.UID]--
+		if m.reserved[instance.UID] <= 0 {
+			delete(m.reserved, instance.UID)
+		}
+	}, true
+}
+
+// add registers the session, and closes the least recently used session of the user if the user has too many.
+func (m *querySessionManager) add(session *querySession) {
+	session.idleTimer = time.AfterFunc(querySessionIdleTimeout, func() {
+		m.remove(session.token)
+	})
+
+	var evicted *querySession
+	m.mu.Lock()
+	session.lastAccess = time.Now()
+	var count int
+	for _, s := range m.sessions {
+		if s.userID != session.userID {
+			continue
+		}
+		count++
+		if evicted == nil || s.lastAccess.Before(evicted.lastAccess) {
+			evicted = s
+		}
+	}
+	if count < maximumQuerySessionsPerUser {
+		evicted = nil
+	} else {
+		delete(m.sessions, evicted.token)
+	}
+	m.sessions[session.token] = session
+	m.mu.Unlock()
+
+	if evicted != nil {
+		evicted.close()
+	}
+}
+
+// get returns the session of the token owned by the user, or nil if not found.
+func (m *querySessionManager) get(token string, userID int) *querySession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[token]
+	if !ok || session.userID != userID {
+		return nil
+	}
+	session.lastAccess = time.Now()
+	return session
+}
+
+// remove closes the session of the token.
+func (m *querySessionManager) remove(token string) {
+	m.mu.Lock()
+	session, ok := m.sessions[token]
+	delete(m.sessions, token)
+	m.mu.Unlock()
+	if ok {
+		session.close()
+	}
+}
+
+// canOpenQuerySession returns whether the query can be fetched page by page with a server-side cursor.
+// Otherwise, the query returns all the rows up to the limit as usual.
+func canOpenQuerySession(request *v1pb.QueryRequest, instance *store.InstanceMessage) bool {
+	if request.PageSize <= 0 || request.Explain || !util.SupportCursor(instance.Engine) {
+		return false
+	}
+	singleSQLs, err := base.SplitMultiSQL(instance.Engine, request.Statement)
+	if err != nil {
+		return false
+	}
+	return len(base.FilterEmptySQL(singleSQLs)) == 1
+}
+
+// openQuerySession opens a query session and fetches the first page of the rows.
+// The returned session is nil if there are no more rows.
+// If the session cannot be opened for the session or connection limits, the rows are returned with the limit as usual.
+func (s *SQLService) openQuerySession(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]*v1pb.QueryResult, *querySession, int64, error) {
+	release, ok := s.querySessions.reserve(instance)
+	if !ok {
+		slog.Debug("too many query sessions, fall back to the query with the limit", slog.String("instance", instance.ResourceID))
+		results, durationNs, err := s.doQuery(ctx, request, nil /* args */, instance, database)
+		return results, nil, durationNs, err
+	}
+
+	start := time.Now().UnixNano()
+	// The driver shares the connection reserved for the session.
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(dbfactory.WithReservedInstanceConnection(ctx, instance), instance, database, request.DataSourceId)
+	if err != nil {
+		release()
+		return nil, nil, 0, err
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		driver.Close(ctx)
+		release()
+		return nil, nil, 0, err
+	}
+
+	timeout := defaultTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+	// The session outlives the request, so its context is detached from the request context.
+	sessionCtx, cancel := context.WithCancel(context.Background())
+	openTimer := time.AfterFunc(timeout, cancel)
+	cursor, err := util.OpenCursor(sessionCtx, instance.Engine, conn, request.Statement)
+	if !openTimer.Stop() {
+		if err == nil {
+			cursor.Close()
+		}
+		err = errors.Errorf("timeout reached: %v", timeout)
+	}
+	if err != nil {
+		cancel()
+		conn.Close()
+		driver.Close(ctx)
+		release()
+		// Keep consistent with QueryConn, which returns the statement errors in the results.
+		return []*v1pb.QueryResult{{Error: err.Error()}}, nil, time.Now().UnixNano() - start, nil
+	}
+
+	token, err := common.RandomString(32)
+	if err != nil {
+		cancel()
+		cursor.Close()
+		conn.Close()
+		driver.Close(ctx)
+		release()
+		return nil, nil, 0, errors.Wrapf(err, "failed to generate page token")
+	}
+	session := &querySession{
+		token:    token,
+		userID:   user.ID,
+		instance: instance,
+		spans:    spans,
+		pageSize: int(request.PageSize),
+		timeout:  timeout,
+		limit:    int(request.Limit),
+		cancel:   cancel,
+		driver:   driver,
+		conn:     conn,
+		cursor:   cursor,
+		release:  release,
+	}
+	s.querySessions.add(session)
+
+	result, err := session.fetch(session.pageSize)
+	if err != nil {
+		s.querySessions.remove(token)
+		return []*v1pb.QueryResult{{Error: err.Error()}}, nil, time.Now().UnixNano() - start, nil
+	}
+	results := []*v1pb.QueryResult{result}
+	sanitizeResults(results)
+	if session.done() {
+		s.querySessions.remove(token)
+		return results, nil, time.Now().UnixNano() - start, nil
+	}
+	return results, session, time.Now().UnixNano() - start, nil
+}
+
+// FetchNext fetches the next page of the rows from the query session.
+func (s *SQLService) FetchNext(ctx context.Context, request *v1pb.FetchNextRequest) (*v1pb.QueryResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be non-negative")
+	}
+	session := s.querySessions.get(request.PageToken, user.ID)
+	if session == nil {
+		return nil, status.Errorf(codes.NotFound, "query session not found or expired, please run the query again")
+	}
+
+	pageSize := session.pageSize
+	if request.PageSize > 0 {
+		pageSize = int(request.PageSize)
+	}
+	result, err := session.fetch(pageSize)
+	metrics.IncQuery(session.instance.Engine, err)
+	if err != nil {
+		s.querySessions.remove(request.PageToken)
+		return nil, status.Errorf(codes.Internal, "failed to fetch the next page: %v", err)
+	}
+	results := []*v1pb.QueryResult{result}
+	sanitizeResults(results)
+	if err := s.maskQueryResults(ctx, session.spans, results, session.instance); err != nil {
+		s.querySessions.remove(request.PageToken)
+		return nil, err
+	}
+
+	session.mu.Lock()
+	response := &v1pb.QueryResponse{
+		Results:     results,
+		AllowExport: session.allowExport,
+	}
+	session.mu.Unlock()
+	if session.done() {
+		s.querySessions.remove(request.PageToken)
+	} else {
+		response.NextPageToken = request.PageToken
+	}
+	return response, nil
+}
+
+// CloseQuerySession closes the query session.
+func (s *SQLService) CloseQuerySession(ctx context.Context, request *v1pb.CloseQuerySessionRequest) (*emptypb.Empty, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Closing an expired session is a no-op, so that the client can always close the session when the tab is closed.
+	if session := s.querySessions.get(request.PageToken, user.ID); session != nil {
+		s.querySessions.remove(session.token)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestQuerySessionLimit(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	a.NoError(err)
+	defer sqlDB.Close()
+	conn, err := sqlDB.Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, "CREATE TABLE t(id INTEGER); INSERT INTO t VALUES (1), (2), (3), (4), (5);")
+	a.NoError(err)
+
+	cursor, err := util.OpenCursor(ctx, storepb.Engine_MYSQL, conn, "SELECT id FROM t ORDER BY id")
+	a.NoError(err)
+	defer cursor.Close()
+	_, cancel := context.WithCancel(ctx)
+	defer cancel()
+	session := &querySession{
+		pageSize:  2,
+		timeout:   time.Minute,
+		limit:     3,
+		cancel:    cancel,
+		idleTimer: time.NewTimer(time.Hour),
+		cursor:    cursor,
+	}
+
+	// The pages never go past the limit even if the client asks for more rows.
+	var ids []int64
+	for !session.done() {
+		result, err := session.fetch(10)
+		a.NoError(err)
+		for _, row := range result.Rows {
+			ids = append(ids, row.Values[0].GetInt64Value())
+		}
+	}
+	a.Equal([]int64{1, 2, 3}, ids)
+	a.False(cursor.Done())
+}
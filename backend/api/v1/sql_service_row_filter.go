@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// applyRowFilters rewrites the statement with the row filters of the user's query grants on the tables in the spans.
+// The rewritten statement is verified against the spans, the query is rejected if any filtered table is not wrapped.
+func (s *SQLService) applyRowFilters(
+	ctx context.Context,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	dataSourceID string,
+	user *store.UserMessage,
+	spans []*base.QuerySpan,
+	statement string,
+	limit int32,
+	isExport bool) (string, error) {
+	filters, err := s.getRowFilters(ctx, instance, user, spans, limit, isExport)
+	if err != nil {
+		return "", err
+	}
+	if len(filters) == 0 {
+		return statement, nil
+	}
+	if !base.SupportRowFilters(instance.Engine) {
+		return "", status.Errorf(codes.FailedPrecondition, "row filter is not supported for engine %s", instance.Engine)
+	}
+
+	schema := ""
+	if instance.Engine == storepb.Engine_POSTGRES {
+		schema, err = s.getCurrentSchema(ctx, instance, database, dataSourceID)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get current schema: %v", err)
+		}
+	}
+	rewritten, applied, err := base.ApplyRowFilters(instance.Engine, statement, database.DatabaseName, schema, filters, store.IgnoreDatabaseAndTableCaseSensitive(instance))
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to apply row filters: %v", err)
+	}
+	// The filters are collected from the tables in the spans, so every one of them must be applied,
+	// otherwise the table is accessed in a way the rewriter does not recognize and the rows could leak.
+	for _, filter := range filters {
+		if !slices.Contains(applied, filter) {
+			return "", status.Errorf(codes.PermissionDenied, "cannot enforce the row filter on table %q", base.SchemaResource{Database: filter.Database, Schema: filter.Schema, Table: filter.Table}.Pretty())
+		}
+	}
+	return rewritten, nil
+}
+
+// getCurrentSchema returns the schema that the unqualified tables are resolved in by the search path
+// of the data source the query runs on.
+func (s *SQLService) getCurrentSchema(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, dataSourceID string) (string, error) {
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, dataSourceID)
+	if err != nil {
+		return "", err
+	}
+	defer driver.Close(ctx)
+
+	var schema sql.NullString
+	if err := driver.GetDB().QueryRowContext(ctx, "SELECT current_schema();").Scan(&schema); err != nil {
+		return "", err
+	}
+	if !schema.Valid {
+		return "", errors.Errorf("no schema in the search path exists")
+	}
+	return schema.String, nil
+}
+
+// getRowFilters returns the row filters of the user on the tables in the spans.
+// A table is not filtered if any binding granting the access has no row filter on it,
+// otherwise the predicates of the bindings are combined by OR.
+func (s *SQLService) getRowFilters(
+	ctx context.Context,
+	instance *store.InstanceMessage,
+	user *store.UserMessage,
+	spans []*base.QuerySpan,
+	limit int32,
+	isExport bool) ([]*base.RowFilter, error) {
+	wantPermission := iam.PermissionDatabasesQuery
+	if isExport {
+		wantPermission = iam.PermissionDatabasesExport
+	}
+	for _, role := range user.Roles {
+		permissions, err := s.iamManager.GetPermissions(ctx, common.FormatRole(role.String()))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+		}
+		if slices.Contains(permissions, wantPermission) {
+			return nil, nil
+		}
+	}
+
+	ignoreCaseSensitive := store.IgnoreDatabaseAndTableCaseSensitive(instance)
+	visited := make(map[string]bool)
+	var filters []*base.RowFilter
+	for _, span := range spans {
+		for column := range span.SourceColumns {
+			table := base.SchemaResource{Database: column.Database, Schema: column.Schema, Table: column.Table}
+			key := table.String()
+			if ignoreCaseSensitive {
+				key = strings.ToLower(key)
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			project, _, err := s.getProjectAndDatabaseMessage(ctx, instance, column.Database)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+			if project == nil {
+				continue
+			}
+			projectPolicy, err := s.store.GetProjectIamPolicy(ctx, project.UID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+			databaseResourceURL := common.FormatDatabase(instance.ResourceID, column.Database)
+			attributes := map[string]any{
+				"request.time":      time.Now(),
+				"resource.database": databaseResourceURL,
+				"resource.schema":   column.Schema,
+				"resource.table":    column.Table,
+				"request.row_limit": limit,
+			}
+			resource := base.SchemaResource{Database: databaseResourceURL, Schema: column.Schema, Table: column.Table}
+			predicates, err := s.getTableRowFilterPredicates(ctx, user, projectPolicy, wantPermission, attributes, resource, ignoreCaseSensitive)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get row filters for table %q: %v", table.Pretty(), err)
+			}
+			for _, predicate := range predicates {
+				filters = append(filters, &base.RowFilter{
+					Database:  column.Database,
+					Schema:    column.Schema,
+					Table:     column.Table,
+					Predicate: predicate,
+				})
+			}
+		}
+	}
+	return filters, nil
+}
+
+// getTableRowFilterPredicates returns the predicates of the table from the bindings granting the permission,
+// it returns nil if any of the bindings grants the unrestricted access to the table.
+func (s *SQLService) getTableRowFilterPredicates(ctx context.Context, user *store.UserMessage, projectPolicy *storepb.ProjectIamPolicy, wantPermission iam.Permission, attributes map[string]any, resource base.SchemaResource, ignoreCaseSensitive bool) ([]string, error) {
+	var predicates []string
+	for _, binding := range utils.GetUserIAMPolicyBindings(ctx, s.store, user, projectPolicy) {
+		permissions, err := s.iamManager.GetPermissions(ctx, binding.Role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get permissions")
+		}
+		if !slices.Contains(permissions, wantPermission) {
+			continue
+		}
+		ok, err := evaluateQueryExportPolicyCondition(binding.Condition.GetExpression(), attributes)
+		if err != nil {
+			slog.Error("failed to evaluate condition", log.BBError(err), slog.String("condition", binding.Condition.GetExpression()))
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		bindingPredicates := getBindingRowFilterPredicates(binding, resource, ignoreCaseSensitive)
+		if len(bindingPredicates) == 0 {
+			return nil, nil
+		}
+		predicates = append(predicates, bindingPredicates...)
+	}
+	return predicates, nil
+}
+
+// getBindingRowFilterPredicates returns the predicates of the binding on the table,
+// the database of the resource is in the format of instances/{instance}/databases/{database}.
+func getBindingRowFilterPredicates(binding *storepb.Binding, resource base.SchemaResource, ignoreCaseSensitive bool) []string {
+	equal := func(a, b string) bool {
+		if ignoreCaseSensitive {
+			return strings.EqualFold(a, b)
+		}
+		return a == b
+	}
+	var predicates []string
+	for _, rowFilter := range binding.RowFilters {
+		if equal(rowFilter.Database, resource.Database) && rowFilter.Schema == resource.Schema && equal(rowFilter.Table, resource.Table) {
+			predicates = append(predicates, rowFilter.Predicate)
+		}
+	}
+	return predicates
+}
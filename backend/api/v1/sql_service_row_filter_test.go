@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetBindingRowFilterPredicates(t *testing.T) {
+	binding := &storepb.Binding{
+		RowFilters: []*storepb.RowFilter{
+			{Database: "instances/i/databases/db", Table: "Orders", Predicate: "region = 'EU'"},
+			{Database: "instances/i/databases/db", Table: "orders", Predicate: "region = 'US'"},
+			{Database: "instances/i/databases/db", Schema: "public", Table: "orders", Predicate: "tenant_id = 1"},
+		},
+	}
+	tests := []struct {
+		resource            base.SchemaResource
+		ignoreCaseSensitive bool
+		want                []string
+	}{
+		{
+			resource: base.SchemaResource{Database: "instances/i/databases/db", Table: "orders"},
+			want:     []string{"region = 'US'"},
+		},
+		{
+			resource:            base.SchemaResource{Database: "instances/i/databases/db", Table: "orders"},
+			ignoreCaseSensitive: true,
+			want:                []string{"region = 'EU'", "region = 'US'"},
+		},
+		{
+			resource: base.SchemaResource{Database: "instances/i/databases/db", Schema: "public", Table: "orders"},
+			want:     []string{"tenant_id = 1"},
+		},
+		{
+			resource: base.SchemaResource{Database: "instances/i/databases/other", Table: "orders"},
+			want:     nil,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getBindingRowFilterPredicates(binding, test.resource, test.ignoreCaseSensitive), test.resource.String())
+	}
+}
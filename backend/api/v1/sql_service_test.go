@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -291,3 +293,89 @@ func TestGetOffsetAndOriginTable(t *testing.T) {
 		a.Equal(test.tableName, tabaleName)
 	}
 }
+
+func TestCanOpenQuerySession(t *testing.T) {
+	tests := []struct {
+		engine  storepb.Engine
+		request *v1pb.QueryRequest
+		want    bool
+	}{
+		{
+			engine:  storepb.Engine_POSTGRES,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t;", PageSize: 100},
+			want:    true,
+		},
+		{
+			engine:  storepb.Engine_MYSQL,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t", PageSize: 100},
+			want:    true,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t;", Limit: 100},
+			want:    false,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t;", PageSize: 100, Explain: true},
+			want:    false,
+		},
+		{
+			engine:  storepb.Engine_MYSQL,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t1; SELECT * FROM t2;", PageSize: 100},
+			want:    false,
+		},
+		{
+			engine:  storepb.Engine_CLICKHOUSE,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t", PageSize: 100},
+			want:    false,
+		},
+		{
+			// TiDB doesn't support READ ONLY transactions.
+			engine:  storepb.Engine_TIDB,
+			request: &v1pb.QueryRequest{Statement: "SELECT * FROM t", PageSize: 100},
+			want:    false,
+		},
+	}
+
+	for _, test := range tests {
+		got := canOpenQuerySession(test.request, &store.InstanceMessage{Engine: test.engine})
+		assert.Equal(t, test.want, got, test.request.Statement)
+	}
+}
+
+func TestReserveQuerySession(t *testing.T) {
+	dbFactory := dbfactory.New("", "", "", "", "")
+	m := newQuerySessionManager(dbFactory)
+	prod := &store.InstanceMessage{UID: 1, ResourceID: "prod", Options: &storepb.InstanceOptions{MaximumConnections: 4}}
+	test := &store.InstanceMessage{UID: 2, ResourceID: "test", Options: &storepb.InstanceOptions{MaximumConnections: 4}}
+
+	// The sessions take at most half of the instance maximum connections.
+	release1, ok := m.reserve(prod)
+	assert.True(t, ok)
+	release2, ok := m.reserve(prod)
+	assert.True(t, ok)
+	_, ok = m.reserve(prod)
+	assert.False(t, ok)
+	release3, ok := m.reserve(test)
+	assert.True(t, ok)
+
+	// The sessions share the connections with the background jobs.
+	release1()
+	assert.True(t, dbFactory.TryAcquireInstanceConnection(prod))
+	assert.True(t, dbFactory.TryAcquireInstanceConnection(prod))
+	assert.True(t, dbFactory.TryAcquireInstanceConnection(prod))
+	_, ok = m.reserve(prod)
+	assert.False(t, ok)
+	dbFactory.ReleaseInstanceConnection(prod)
+	release4, ok := m.reserve(prod)
+	assert.True(t, ok)
+
+	release2()
+	release3()
+	release4()
+	dbFactory.ReleaseInstanceConnection(prod)
+	dbFactory.ReleaseInstanceConnection(prod)
+	assert.Empty(t, m.reserved)
+	assert.Zero(t, m.total)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"path"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -19,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -127,16 +129,22 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 		VCSUID:        vcsProvider.ID,
 		VCSResourceID: vcsProvider.ResourceID,
 		Payload: &storepb.VCSConnector{
-			Title:              request.GetVcsConnector().Title,
-			FullPath:           request.GetVcsConnector().FullPath,
-			WebUrl:             request.GetVcsConnector().WebUrl,
-			Branch:             request.GetVcsConnector().Branch,
-			BaseDirectory:      request.GetVcsConnector().BaseDirectory,
-			ExternalId:         request.GetVcsConnector().ExternalId,
-			WebhookSecretToken: secretToken,
-			DatabaseGroup:      request.GetVcsConnector().DatabaseGroup,
+			Title:                 request.GetVcsConnector().Title,
+			FullPath:              request.GetVcsConnector().FullPath,
+			WebUrl:                request.GetVcsConnector().WebUrl,
+			Branch:                request.GetVcsConnector().Branch,
+			BaseDirectory:         request.GetVcsConnector().BaseDirectory,
+			ExternalId:            request.GetVcsConnector().ExternalId,
+			WebhookSecretToken:    secretToken,
+			DatabaseGroup:         request.GetVcsConnector().DatabaseGroup,
+			FileLayout:            storepb.VCSConnector_FileLayout(request.GetVcsConnector().FileLayout),
+			IncludeSubdirectories: request.GetVcsConnector().IncludeSubdirectories,
+			SchemaWriteBack:       convertToStoreSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack),
 		},
 	}
+	if err := validateSchemaWriteBack(vcsConnectorCreate.Payload.SchemaWriteBack); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 
 	// Create the webhook.
 	bytebaseEndpointURL := setting.GitopsWebhookUrl
@@ -291,6 +299,20 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 			update.BaseDirectory = &baseDir
 		case "database_group":
 			update.DatabaseGroup = &request.GetVcsConnector().DatabaseGroup
+		case "file_layout":
+			fileLayout := storepb.VCSConnector_FileLayout(request.GetVcsConnector().FileLayout)
+			update.FileLayout = &fileLayout
+		case "include_subdirectories":
+			update.IncludeSubdirectories = &request.GetVcsConnector().IncludeSubdirectories
+		case "schema_write_back":
+			schemaWriteBack := convertToStoreSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack)
+			if err := validateSchemaWriteBack(schemaWriteBack); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if schemaWriteBack == nil {
+				schemaWriteBack = &storepb.VCSConnector_SchemaWriteBack{}
+			}
+			update.SchemaWriteBack = schemaWriteBack
 		}
 	}
 
@@ -389,23 +411,69 @@ func convertStoreVCSConnector(ctx context.Context, stores *store.Store, vcsConne
 	}
 
 	v1VCSConnector := &v1pb.VCSConnector{
-		Name:          fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
-		CreateTime:    timestamppb.New(vcsConnector.CreatedTime),
-		UpdateTime:    timestamppb.New(vcsConnector.UpdatedTime),
-		Creator:       fmt.Sprintf("users/%s", creator.Email),
-		Updater:       fmt.Sprintf("users/%s", updater.Email),
-		Title:         vcsConnector.Payload.Title,
-		VcsProvider:   fmt.Sprintf("%s%s", common.VCSProviderPrefix, vcsConnector.VCSResourceID),
-		ExternalId:    vcsConnector.Payload.ExternalId,
-		BaseDirectory: vcsConnector.Payload.BaseDirectory,
-		Branch:        vcsConnector.Payload.Branch,
-		FullPath:      vcsConnector.Payload.FullPath,
-		WebUrl:        vcsConnector.Payload.WebUrl,
-		DatabaseGroup: vcsConnector.Payload.DatabaseGroup,
+		Name:                  fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
+		CreateTime:            timestamppb.New(vcsConnector.CreatedTime),
+		UpdateTime:            timestamppb.New(vcsConnector.UpdatedTime),
+		Creator:               fmt.Sprintf("users/%s", creator.Email),
+		Updater:               fmt.Sprintf("users/%s", updater.Email),
+		Title:                 vcsConnector.Payload.Title,
+		VcsProvider:           fmt.Sprintf("%s%s", common.VCSProviderPrefix, vcsConnector.VCSResourceID),
+		ExternalId:            vcsConnector.Payload.ExternalId,
+		BaseDirectory:         vcsConnector.Payload.BaseDirectory,
+		Branch:                vcsConnector.Payload.Branch,
+		FullPath:              vcsConnector.Payload.FullPath,
+		WebUrl:                vcsConnector.Payload.WebUrl,
+		DatabaseGroup:         vcsConnector.Payload.DatabaseGroup,
+		FileLayout:            v1pb.VCSConnector_FileLayout(vcsConnector.Payload.FileLayout),
+		IncludeSubdirectories: vcsConnector.Payload.IncludeSubdirectories,
+		SchemaWriteBack:       convertStoreSchemaWriteBack(vcsConnector.Payload.SchemaWriteBack),
 	}
 	return v1VCSConnector, nil
 }
 
+func convertToStoreSchemaWriteBack(schemaWriteBack *v1pb.VCSConnector_SchemaWriteBack) *storepb.VCSConnector_SchemaWriteBack {
+	if schemaWriteBack == nil {
+		return nil
+	}
+	return &storepb.VCSConnector_SchemaWriteBack{
+		Mode:             storepb.VCSConnector_SchemaWriteBack_Mode(schemaWriteBack.Mode),
+		FilePathTemplate: schemaWriteBack.FilePathTemplate,
+		PerObject:        schemaWriteBack.PerObject,
+	}
+}
+
+func convertStoreSchemaWriteBack(schemaWriteBack *storepb.VCSConnector_SchemaWriteBack) *v1pb.VCSConnector_SchemaWriteBack {
+	if schemaWriteBack == nil {
+		return nil
+	}
+	return &v1pb.VCSConnector_SchemaWriteBack{
+		Mode:             v1pb.VCSConnector_SchemaWriteBack_Mode(schemaWriteBack.Mode),
+		FilePathTemplate: schemaWriteBack.FilePathTemplate,
+		PerObject:        schemaWriteBack.PerObject,
+	}
+}
+
+func validateSchemaWriteBack(schemaWriteBack *storepb.VCSConnector_SchemaWriteBack) error {
+	if schemaWriteBack.GetMode() == storepb.VCSConnector_SchemaWriteBack_MODE_UNSPECIFIED {
+		return nil
+	}
+	filePathTemplate := schemaWriteBack.GetFilePathTemplate()
+	if filePathTemplate == "" {
+		return errors.Errorf("file path template is required for the schema write-back")
+	}
+	if strings.HasPrefix(filePathTemplate, "/") {
+		return errors.Errorf("file path template %q should be relative to the repository root", filePathTemplate)
+	}
+	if !strings.Contains(filePathTemplate, "{{DB_NAME}}") {
+		return errors.Errorf("file path template %q should contain {{DB_NAME}} to distinguish the databases", filePathTemplate)
+	}
+	// The stale schema files in the directory are deleted for the per-object layout, so the directory cannot be shared by the databases.
+	if schemaWriteBack.GetPerObject() && !strings.Contains(path.Dir(filePathTemplate), "{{DB_NAME}}") {
+		return errors.Errorf("the directory of file path template %q should contain {{DB_NAME}} to write one schema file per object", filePathTemplate)
+	}
+	return nil
+}
+
 func checkBranchExistence(ctx context.Context, vcsProvider *store.VCSProviderMessage, externalID, branch string) error {
 	if branch == "" {
 		return status.Errorf(codes.InvalidArgument, "branch name is required")
@@ -461,6 +529,21 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
+	case storepb.VCSType_GITEA:
+		webhookCreate := gitea.WebhookCreate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/%s", bytebaseEndpointURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      webhookSecretToken,
+			},
+			Events: []string{"pull_request"},
+			Active: true,
+		}
+		webhookCreatePayload, err = json.Marshal(webhookCreate)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
 	case storepb.VCSType_AZURE_DEVOPS:
 		part := strings.Split(externalRepoID, "/")
 		if len(part) != 3 {
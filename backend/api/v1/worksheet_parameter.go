@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	// worksheetParameterRegexp matches the {{name}} parameter references in the worksheet statement.
+	worksheetParameterRegexp     = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+	worksheetParameterNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+const worksheetParameterDateLayout = "2006-01-02"
+
+// validateWorksheetParameters validates the parameter declarations of the worksheet.
+func validateWorksheetParameters(parameters []*storepb.WorksheetParameter) error {
+	names := make(map[string]bool)
+	for _, parameter := range parameters {
+		if !worksheetParameterNameRegexp.MatchString(parameter.Name) {
+			return errors.Errorf("invalid parameter name %q, it must start with a letter or underscore and contain only letters, digits and underscores", parameter.Name)
+		}
+		if names[parameter.Name] {
+			return errors.Errorf("duplicate parameter %q", parameter.Name)
+		}
+		names[parameter.Name] = true
+
+		if parameter.Type == storepb.WorksheetParameter_TYPE_UNSPECIFIED {
+			return errors.Errorf("type of parameter %q is required", parameter.Name)
+		}
+		if parameter.Pattern != "" {
+			if parameter.Type != storepb.WorksheetParameter_STRING {
+				return errors.Errorf("pattern is only supported for the STRING parameter %q", parameter.Name)
+			}
+			if _, err := regexp.Compile(parameter.Pattern); err != nil {
+				return errors.Wrapf(err, "invalid pattern of parameter %q", parameter.Name)
+			}
+		}
+		if parameter.Minimum != nil || parameter.Maximum != nil {
+			if parameter.Type != storepb.WorksheetParameter_INT {
+				return errors.Errorf("minimum and maximum are only supported for the INT parameter %q", parameter.Name)
+			}
+			if parameter.Minimum != nil && parameter.Maximum != nil && *parameter.Minimum > *parameter.Maximum {
+				return errors.Errorf("minimum of parameter %q is greater than the maximum", parameter.Name)
+			}
+		}
+		if parameter.Type == storepb.WorksheetParameter_ENUM {
+			if len(parameter.AllowedValues) == 0 {
+				return errors.Errorf("allowed values of the ENUM parameter %q are required", parameter.Name)
+			}
+			for i, value := range parameter.AllowedValues {
+				if slices.Contains(parameter.AllowedValues[:i], value) {
+					return errors.Errorf("duplicate allowed value %q of parameter %q", value, parameter.Name)
+				}
+			}
+		} else if len(parameter.AllowedValues) > 0 {
+			return errors.Errorf("allowed values are only supported for the ENUM parameter %q", parameter.Name)
+		}
+		if parameter.DefaultValue != "" {
+			if _, err := convertWorksheetParameterValue(parameter, parameter.DefaultValue); err != nil {
+				return errors.Wrapf(err, "invalid default value")
+			}
+		}
+	}
+	return nil
+}
+
+// convertWorksheetParameterValue validates the value of the parameter and converts it to the value bound to the driver placeholder.
+func convertWorksheetParameterValue(parameter *storepb.WorksheetParameter, value string) (any, error) {
+	switch parameter.Type {
+	case storepb.WorksheetParameter_STRING:
+		if parameter.Pattern != "" {
+			pattern, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", parameter.Pattern))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid pattern of parameter %q", parameter.Name)
+			}
+			if !pattern.MatchString(value) {
+				return nil, errors.Errorf("value %q of parameter %q does not match the pattern %q", value, parameter.Name, parameter.Pattern)
+			}
+		}
+		return value, nil
+	case storepb.WorksheetParameter_INT:
+		v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("value %q of parameter %q is not an integer", value, parameter.Name)
+		}
+		if parameter.Minimum != nil && v < *parameter.Minimum {
+			return nil, errors.Errorf("value %d of parameter %q is less than the minimum %d", v, parameter.Name, *parameter.Minimum)
+		}
+		if parameter.Maximum != nil && v > *parameter.Maximum {
+			return nil, errors.Errorf("value %d of parameter %q is greater than the maximum %d", v, parameter.Name, *parameter.Maximum)
+		}
+		return v, nil
+	case storepb.WorksheetParameter_DATE:
+		// The date is bound as the string in the canonical format, so that it is compared to the DATE columns without the time zone conversion.
+		v, err := time.Parse(worksheetParameterDateLayout, strings.TrimSpace(value))
+		if err != nil {
+			return nil, errors.Errorf("value %q of parameter %q is not a date in the format of YYYY-MM-DD", value, parameter.Name)
+		}
+		return v.Format(worksheetParameterDateLayout), nil
+	case storepb.WorksheetParameter_ENUM:
+		if !slices.Contains(parameter.AllowedValues, value) {
+			return nil, errors.Errorf("value %q of parameter %q is not one of the allowed values %q", value, parameter.Name, parameter.AllowedValues)
+		}
+		return value, nil
+	default:
+		return nil, errors.Errorf("unsupported type %s of parameter %q", parameter.Type, parameter.Name)
+	}
+}
+
+func supportWorksheetParameters(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+		return true
+	default:
+		return false
+	}
+}
+
+// bindWorksheetParameters replaces the {{name}} parameter references in the statement with the driver placeholders,
+// and returns the validated values bound to the placeholders in order.
+// The values are never concatenated into the statement.
+func bindWorksheetParameters(engine storepb.Engine, statement string, parameters []*storepb.WorksheetParameter, values map[string]string) (string, []any, error) {
+	if !supportWorksheetParameters(engine) {
+		return "", nil, errors.Errorf("parameterized query is not supported for engine %s", engine)
+	}
+	declared := make(map[string]*storepb.WorksheetParameter)
+	for _, parameter := range parameters {
+		declared[parameter.Name] = parameter
+	}
+	for name := range values {
+		if _, ok := declared[name]; !ok {
+			return "", nil, errors.Errorf("parameter %q is not declared by the worksheet", name)
+		}
+	}
+
+	var buf strings.Builder
+	var args []any
+	// Postgres placeholders are numbered, so the same parameter referenced more than once is bound only once.
+	positions := make(map[string]int)
+	last := 0
+	for _, match := range worksheetParameterRegexp.FindAllStringSubmatchIndex(statement, -1) {
+		name := statement[match[2]:match[3]]
+		parameter, ok := declared[name]
+		if !ok {
+			return "", nil, errors.Errorf("parameter %q is not declared by the worksheet", name)
+		}
+		_, _ = buf.WriteString(statement[last:match[0]])
+		last = match[1]
+
+		if engine == storepb.Engine_POSTGRES {
+			if position, ok := positions[name]; ok {
+				_, _ = buf.WriteString(fmt.Sprintf("$%d", position))
+				continue
+			}
+		}
+		value, ok := values[name]
+		if !ok {
+			if parameter.DefaultValue == "" {
+				return "", nil, errors.Errorf("value of parameter %q is required", name)
+			}
+			value = parameter.DefaultValue
+		}
+		arg, err := convertWorksheetParameterValue(parameter, value)
+		if err != nil {
+			return "", nil, err
+		}
+		args = append(args, arg)
+		if engine == storepb.Engine_POSTGRES {
+			positions[name] = len(args)
+			_, _ = buf.WriteString(fmt.Sprintf("$%d", len(args)))
+		} else {
+			_, _ = buf.WriteString("?")
+		}
+	}
+	_, _ = buf.WriteString(statement[last:])
+	return buf.String(), args, nil
+}
+
+// bindQueryParameters binds the parameter values of the query request to the {{name}} parameters declared by the worksheet.
+func (s *SQLService) bindQueryParameters(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, instance *store.InstanceMessage) (string, []any, error) {
+	if !supportWorksheetParameters(instance.Engine) {
+		return "", nil, status.Errorf(codes.FailedPrecondition, "parameterized query is not supported for engine %s", instance.Engine)
+	}
+	worksheetUID, err := common.GetWorksheetUID(request.Worksheet)
+	if err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	worksheet, err := s.store.GetWorkSheet(ctx, &store.FindWorkSheetMessage{UID: &worksheetUID}, user.ID)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get worksheet: %v", err)
+	}
+	if worksheet == nil {
+		return "", nil, status.Errorf(codes.NotFound, "worksheet %q not found", request.Worksheet)
+	}
+	canRead, err := canReadWorksheet(ctx, s.store, worksheet)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to check access with error: %v", err)
+	}
+	if !canRead {
+		return "", nil, status.Errorf(codes.PermissionDenied, "cannot read worksheet %s", worksheet.Title)
+	}
+
+	statement, args, err := bindWorksheetParameters(instance.Engine, request.Statement, worksheet.Payload.GetParameters(), request.ParameterValues)
+	if err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if len(args) > 0 {
+		singleSQLs, err := base.SplitMultiSQL(instance.Engine, statement)
+		if err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "failed to split statement: %v", err)
+		}
+		if len(base.FilterEmptySQL(singleSQLs)) != 1 {
+			return "", nil, status.Errorf(codes.InvalidArgument, "parameterized query must be a single statement")
+		}
+	}
+	return statement, args, nil
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestBindWorksheetParameters(t *testing.T) {
+	minimum := int64(1)
+	parameters := []*storepb.WorksheetParameter{
+		{Name: "customer_id", Type: storepb.WorksheetParameter_INT, Minimum: &minimum},
+		{Name: "status", Type: storepb.WorksheetParameter_ENUM, AllowedValues: []string{"OPEN", "CLOSED"}, DefaultValue: "OPEN"},
+		{Name: "since", Type: storepb.WorksheetParameter_DATE},
+		{Name: "email", Type: storepb.WorksheetParameter_STRING, Pattern: `[^@]+@[^@]+`},
+	}
+	tests := []struct {
+		engine        storepb.Engine
+		statement     string
+		values        map[string]string
+		wantStatement string
+		wantArgs      []any
+		wantErr       bool
+	}{
+		{
+			engine:        storepb.Engine_MYSQL,
+			statement:     "SELECT * FROM orders WHERE customer_id = {{customer_id}} AND status = {{ status }} AND created_at >= {{since}}",
+			values:        map[string]string{"customer_id": "42", "since": "2024-01-31"},
+			wantStatement: "SELECT * FROM orders WHERE customer_id = ? AND status = ? AND created_at >= ?",
+			wantArgs:      []any{int64(42), "OPEN", "2024-01-31"},
+		},
+		{
+			engine:        storepb.Engine_POSTGRES,
+			statement:     "SELECT * FROM orders WHERE customer_id = {{customer_id}} OR referrer_id = {{customer_id}} AND status = {{status}}",
+			values:        map[string]string{"customer_id": "42", "status": "CLOSED"},
+			wantStatement: "SELECT * FROM orders WHERE customer_id = $1 OR referrer_id = $1 AND status = $2",
+			wantArgs:      []any{int64(42), "CLOSED"},
+		},
+		{
+			// The value is bound instead of being concatenated into the statement.
+			engine:        storepb.Engine_MYSQL,
+			statement:     "SELECT * FROM users WHERE email = {{email}}",
+			values:        map[string]string{"email": "' OR 1=1 --@example.com"},
+			wantStatement: "SELECT * FROM users WHERE email = ?",
+			wantArgs:      []any{"' OR 1=1 --@example.com"},
+		},
+		{
+			engine:        storepb.Engine_MYSQL,
+			statement:     "SELECT 1",
+			wantStatement: "SELECT 1",
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM orders WHERE customer_id = {{customer_id}}",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM orders WHERE customer_id = {{customer_id}}",
+			values:    map[string]string{"customer_id": "0"},
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM orders WHERE status = {{status}}",
+			values:    map[string]string{"status": "PENDING"},
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM orders WHERE created_at >= {{since}}",
+			values:    map[string]string{"since": "2024-02-30"},
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM users WHERE email = {{email}}",
+			values:    map[string]string{"email": "alice"},
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM orders WHERE id = {{order_id}}",
+			values:    map[string]string{"order_id": "1"},
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_ORACLE,
+			statement: "SELECT * FROM orders WHERE customer_id = {{customer_id}}",
+			values:    map[string]string{"customer_id": "42"},
+			wantErr:   true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		statement, args, err := bindWorksheetParameters(test.engine, test.statement, parameters, test.values)
+		if test.wantErr {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.wantStatement, statement)
+		a.Equal(test.wantArgs, args)
+	}
+}
+
+func TestValidateWorksheetParameters(t *testing.T) {
+	minimum, maximum := int64(10), int64(1)
+	tests := []struct {
+		parameters []*storepb.WorksheetParameter
+		wantErr    bool
+	}{
+		{
+			parameters: []*storepb.WorksheetParameter{
+				{Name: "id", Type: storepb.WorksheetParameter_INT, DefaultValue: "1"},
+				{Name: "region", Type: storepb.WorksheetParameter_ENUM, AllowedValues: []string{"EU", "US"}},
+			},
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "1id", Type: storepb.WorksheetParameter_INT}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "id", Type: storepb.WorksheetParameter_INT}, {Name: "id", Type: storepb.WorksheetParameter_STRING}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "id"}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "id", Type: storepb.WorksheetParameter_INT, Minimum: &minimum, Maximum: &maximum}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "region", Type: storepb.WorksheetParameter_ENUM}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "name", Type: storepb.WorksheetParameter_STRING, Pattern: "("}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.WorksheetParameter{{Name: "day", Type: storepb.WorksheetParameter_DATE, DefaultValue: "yesterday"}},
+			wantErr:    true,
+		},
+	}
+
+	a := require.New(t)
+	for i, test := range tests {
+		err := validateWorksheetParameters(test.parameters)
+		if test.wantErr {
+			a.Error(err, i)
+		} else {
+			a.NoError(err, i)
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -135,7 +136,7 @@ func (s *WorksheetService) GetWorksheet(ctx context.Context, request *v1pb.GetWo
 		return nil, err
 	}
 
-	canAccess, err := s.canReadWorksheet(ctx, worksheet)
+	canAccess, err := canReadWorksheet(ctx, s.store, worksheet)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
 	}
@@ -227,7 +228,7 @@ func (s *WorksheetService) SearchWorksheets(ctx context.Context, request *v1pb.S
 
 	var v1pbWorksheets []*v1pb.Worksheet
 	for _, worksheet := range worksheetList {
-		canAccess, err := s.canReadWorksheet(ctx, worksheet)
+		canAccess, err := canReadWorksheet(ctx, s.store, worksheet)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
 		}
@@ -326,6 +327,12 @@ func (s *WorksheetService) UpdateWorksheet(ctx context.Context, request *v1pb.Up
 				return nil, status.Errorf(codes.InvalidArgument, `database "%q" not found`, request.Worksheet.Database)
 			}
 			worksheetPatch.DatabaseUID = &database.UID
+		case "parameters":
+			parameters := convertToStoreWorksheetParameters(request.Worksheet.Parameters)
+			if err := validateWorksheetParameters(parameters); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			worksheetPatch.Payload = &storepb.WorksheetPayload{Parameters: parameters}
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid update mask path %q", path))
 		}
@@ -403,7 +410,7 @@ func (s *WorksheetService) UpdateWorksheetOrganizer(ctx context.Context, request
 		return nil, err
 	}
 
-	canAccess, err := s.canReadWorksheet(ctx, worksheet)
+	canAccess, err := canReadWorksheet(ctx, s.store, worksheet)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
 	}
@@ -496,7 +503,7 @@ func (s *WorksheetService) canWriteWorksheet(ctx context.Context, worksheet *sto
 // PRIVATE: workspace Owner/DBA and the creator only.
 // PROJECT_WRITE: workspace Owner/DBA and all members in the project.
 // PROJECT_READ: workspace Owner/DBA and all members in the project.
-func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *store.WorkSheetMessage) (bool, error) {
+func canReadWorksheet(ctx context.Context, stores *store.Store, worksheet *store.WorkSheetMessage) (bool, error) {
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
 	if !ok {
 		return false, status.Errorf(codes.Internal, "user not found")
@@ -515,7 +522,7 @@ func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *stor
 		return false, nil
 	case store.ProjectReadWorkSheet, store.ProjectWriteWorkSheet:
 		// For project level visibility, users can read the worksheet as long as they're the project member.
-		projectRoles, err := findProjectRoles(ctx, s.store, worksheet.ProjectUID, user)
+		projectRoles, err := findProjectRoles(ctx, stores, worksheet.ProjectUID, user)
 		if err != nil {
 			return false, err
 		}
@@ -576,6 +583,7 @@ func (s *WorksheetService) convertToAPIWorksheetMessage(ctx context.Context, wor
 		ContentSize: worksheet.Size,
 		Visibility:  visibility,
 		Starred:     worksheet.Starred,
+		Parameters:  convertToV1WorksheetParameters(worksheet.Payload.GetParameters()),
 	}, nil
 }
 
@@ -585,6 +593,11 @@ func convertToStoreWorksheetMessage(projectUID int, databaseUID *int, creatorID
 		return nil, err
 	}
 
+	parameters := convertToStoreWorksheetParameters(worksheet.Parameters)
+	if err := validateWorksheetParameters(parameters); err != nil {
+		return nil, err
+	}
+
 	worksheetMessage := &store.WorkSheetMessage{
 		ProjectUID:  projectUID,
 		DatabaseUID: databaseUID,
@@ -592,11 +605,46 @@ func convertToStoreWorksheetMessage(projectUID int, databaseUID *int, creatorID
 		Title:       worksheet.Title,
 		Statement:   string(worksheet.Content),
 		Visibility:  visibility,
+		Payload:     &storepb.WorksheetPayload{Parameters: parameters},
 	}
 
 	return worksheetMessage, nil
 }
 
+func convertToV1WorksheetParameters(parameters []*storepb.WorksheetParameter) []*v1pb.WorksheetParameter {
+	var result []*v1pb.WorksheetParameter
+	for _, parameter := range parameters {
+		result = append(result, &v1pb.WorksheetParameter{
+			Name:          parameter.Name,
+			Type:          v1pb.WorksheetParameter_Type(parameter.Type),
+			Description:   parameter.Description,
+			DefaultValue:  parameter.DefaultValue,
+			Pattern:       parameter.Pattern,
+			Minimum:       parameter.Minimum,
+			Maximum:       parameter.Maximum,
+			AllowedValues: parameter.AllowedValues,
+		})
+	}
+	return result
+}
+
+func convertToStoreWorksheetParameters(parameters []*v1pb.WorksheetParameter) []*storepb.WorksheetParameter {
+	var result []*storepb.WorksheetParameter
+	for _, parameter := range parameters {
+		result = append(result, &storepb.WorksheetParameter{
+			Name:          parameter.Name,
+			Type:          storepb.WorksheetParameter_Type(parameter.Type),
+			Description:   parameter.Description,
+			DefaultValue:  parameter.DefaultValue,
+			Pattern:       parameter.Pattern,
+			Minimum:       parameter.Minimum,
+			Maximum:       parameter.Maximum,
+			AllowedValues: parameter.AllowedValues,
+		})
+	}
+	return result
+}
+
 func convertToStoreWorksheetVisibility(visibility v1pb.Worksheet_Visibility) (store.WorkSheetVisibility, error) {
 	switch visibility {
 	case v1pb.Worksheet_VISIBILITY_UNSPECIFIED:
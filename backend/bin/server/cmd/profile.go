@@ -25,6 +25,8 @@ func getBaseProfile(dataDir string) config.Profile {
 		Port:                 flags.port,     // Using flags.port as our gRPC server port.
 		DatastorePort:        flags.port + 2, // Using flags.port + 2 as our datastore port.
 		SampleDatabasePort:   sampleDatabasePort,
+		MetricsPort:          flags.metricsPort,
+		OTLPEndpoint:         flags.otlpEndpoint,
 		Readonly:             flags.readonly,
 		SaaS:                 flags.saas,
 		Debug:                flags.debug,
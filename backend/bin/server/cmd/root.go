@@ -60,7 +60,10 @@ var (
 	flags struct {
 		// Used for Bytebase command line config
 		port        int
-		externalURL string
+		metricsPort int
+		// otlpEndpoint is the OTLP/gRPC endpoint URL for exporting the tracing spans, e.g. http://localhost:4317.
+		otlpEndpoint string
+		externalURL  string
 		// pgURL must follow PostgreSQL connection URIs pattern.
 		// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING
 		pgURL   string
@@ -112,6 +115,8 @@ func init() {
 	// In the release build, Bytebase bundles frontend and backend together and runs on a single port as a mono server.
 	// During development, Bytebase frontend runs on a separate port.
 	rootCmd.PersistentFlags().IntVar(&flags.port, "port", 8080, "port where Bytebase server runs. Default to 80")
+	rootCmd.PersistentFlags().StringVar(&flags.otlpEndpoint, "otlp-endpoint", "", "OTLP/gRPC endpoint URL where Bytebase exports the tracing spans, e.g. http://localhost:4317. Tracing is disabled if not set")
+	rootCmd.PersistentFlags().IntVar(&flags.metricsPort, "metrics-port", 0, "port where Bytebase serves the operational Prometheus metrics on /metrics. Default to 0, which disables the endpoint")
 	// When running the release build in production, most of the time, users would not expose Bytebase directly to the public.
 	// Instead they would configure a gateway to forward the traffic to Bytebase. Users need to set --external-url to the address
 	// exposed on that gateway accordingly.
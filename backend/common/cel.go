@@ -1,10 +1,15 @@
 package common
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
 	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/parser"
 	"github.com/pkg/errors"
 	exprproto "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/genproto/googleapis/type/expr"
@@ -30,6 +35,28 @@ var RiskFactors = []cel.EnvOption{
 	cel.Variable("expiration_days", cel.IntType),
 	cel.Variable("export_rows", cel.IntType),
 	cel.Variable("table_rows", cel.IntType),
+	// the count of the SQL review errors.
+	cel.Variable("sql_review_error_count", cel.IntType),
+	// the hour of day [0, 23] and the day of week [0, 6] starting from Sunday in UTC when the change is scheduled to run.
+	cel.Variable("hour_of_day", cel.IntType),
+	cel.Variable("day_of_week", cel.IntType),
+
+	// bool factors
+	cel.Variable("drops_object", cel.BoolType),
+	cel.Variable("renames_object", cel.BoolType),
+
+	// list factors
+	// the names of the changed tables qualified by the database and the schema if any, e.g. db.table for MySQL and db.public.table for PostgreSQL.
+	cel.Variable("table_names", cel.ListType(cel.StringType)),
+	// the classification level ids of the changed tables.
+	cel.Variable("classification_levels", cel.ListType(cel.StringType)),
+	// the classification level ids of the columns of the changed tables.
+	// All the columns of a changed table are included because the changed columns are not tracked.
+	cel.Variable("column_classification_levels", cel.ListType(cel.StringType)),
+	// the workspace and project roles of the issue creator, e.g. roles/projectDeveloper.
+	cel.Variable("creator_roles", cel.ListType(cel.StringType)),
+	// the groups of the issue creator, e.g. groups/dba@example.com.
+	cel.Variable("creator_groups", cel.ListType(cel.StringType)),
 }
 
 // ApprovalFactors are the variables when finding the approval template.
@@ -304,3 +331,108 @@ func doEvalBindingCondition(expr string, input map[string]any) (bool, error) {
 	}
 	return res, nil
 }
+
+// GetBindingExpireTime returns the earliest expire time in the `request.time < timestamp("...")` conditions joined by && in the IAM binding condition.
+// It returns nil if the binding never expires.
+func GetBindingExpireTime(expression string) (*time.Time, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	e, err := cel.NewEnv(IAMPolicyConditionCELAttributes...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cel env")
+	}
+	ast, iss := e.Parse(expression)
+	if iss != nil && iss.Err() != nil {
+		return nil, errors.Wrapf(iss.Err(), "failed to parse expression %q", expression)
+	}
+
+	var expireTime *time.Time
+	for _, conjunct := range getConjuncts(ast.NativeRep().Expr()) {
+		t, ok := getExpireTime(conjunct)
+		if !ok {
+			continue
+		}
+		if expireTime == nil || t.Before(*expireTime) {
+			expireTime = &t
+		}
+	}
+	return expireTime, nil
+}
+
+// SetBindingExpireTime replaces the `request.time < timestamp("...")` conditions joined by && in the IAM binding condition with the expire time.
+func SetBindingExpireTime(expression string, expireTime time.Time) (string, error) {
+	var conjuncts []string
+	if expression != "" {
+		e, err := cel.NewEnv(IAMPolicyConditionCELAttributes...)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create cel env")
+		}
+		ast, iss := e.Parse(expression)
+		if iss != nil && iss.Err() != nil {
+			return "", errors.Wrapf(iss.Err(), "failed to parse expression %q", expression)
+		}
+		for _, conjunct := range getConjuncts(ast.NativeRep().Expr()) {
+			if _, ok := getExpireTime(conjunct); ok {
+				continue
+			}
+			s, err := parser.Unparse(conjunct, ast.NativeRep().SourceInfo())
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to unparse expression %q", expression)
+			}
+			// The conjunct has lower precedence than && if it is a || or conditional expression.
+			if conjunct.Kind() == celast.CallKind && (conjunct.AsCall().FunctionName() == operators.LogicalOr || conjunct.AsCall().FunctionName() == operators.Conditional) {
+				s = fmt.Sprintf("(%s)", s)
+			}
+			conjuncts = append(conjuncts, s)
+		}
+	}
+	conjuncts = append(conjuncts, fmt.Sprintf(`request.time < timestamp("%s")`, expireTime.UTC().Format(time.RFC3339)))
+	return strings.Join(conjuncts, " && "), nil
+}
+
+// getConjuncts returns the operands of the expressions joined by &&.
+func getConjuncts(expr celast.Expr) []celast.Expr {
+	if expr.Kind() == celast.CallKind && expr.AsCall().FunctionName() == operators.LogicalAnd {
+		var conjuncts []celast.Expr
+		for _, arg := range expr.AsCall().Args() {
+			conjuncts = append(conjuncts, getConjuncts(arg)...)
+		}
+		return conjuncts
+	}
+	return []celast.Expr{expr}
+}
+
+// getExpireTime returns the expire time if the expression is `request.time < timestamp("...")`.
+func getExpireTime(expr celast.Expr) (time.Time, bool) {
+	if expr.Kind() != celast.CallKind || expr.AsCall().FunctionName() != operators.Less {
+		return time.Time{}, false
+	}
+	args := expr.AsCall().Args()
+	if len(args) != 2 {
+		return time.Time{}, false
+	}
+	if args[0].Kind() != celast.SelectKind {
+		return time.Time{}, false
+	}
+	operand := args[0].AsSelect().Operand()
+	if operand.Kind() != celast.IdentKind || operand.AsIdent() != "request" || args[0].AsSelect().FieldName() != "time" {
+		return time.Time{}, false
+	}
+	if args[1].Kind() != celast.CallKind || args[1].AsCall().FunctionName() != "timestamp" || len(args[1].AsCall().Args()) != 1 {
+		return time.Time{}, false
+	}
+	value := args[1].AsCall().Args()[0]
+	if value.Kind() != celast.LiteralKind {
+		return time.Time{}, false
+	}
+	s, ok := value.AsLiteral().Value().(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -114,3 +114,49 @@ func TestGetQueryExportFactors(t *testing.T) {
 		a.Equal(tt.want, *factors)
 	}
 }
+
+func TestBindingExpireTime(t *testing.T) {
+	a := require.New(t)
+
+	expireTime, err := GetBindingExpireTime(`request.time < timestamp("2024-02-01T00:00:00Z") && resource.database == "instances/prod/databases/crm" && request.time < timestamp("2024-01-01T00:00:00Z")`)
+	a.NoError(err)
+	a.NotNil(expireTime)
+	a.Equal("2024-01-01T00:00:00Z", expireTime.Format(time.RFC3339))
+
+	// The expire time in the || condition is not respected.
+	expireTime, err = GetBindingExpireTime(`request.time < timestamp("2024-02-01T00:00:00Z") || resource.database == "instances/prod/databases/crm"`)
+	a.NoError(err)
+	a.Nil(expireTime)
+
+	expireTime, err = GetBindingExpireTime("")
+	a.NoError(err)
+	a.Nil(expireTime)
+
+	newExpireTime, err := time.Parse(time.RFC3339, "2024-03-01T08:00:00Z")
+	a.NoError(err)
+	testCases := []struct {
+		expr string
+		want string
+	}{
+		{
+			expr: "",
+			want: `request.time < timestamp("2024-03-01T08:00:00Z")`,
+		},
+		{
+			expr: `resource.database in ["instances/prod/databases/crm"] && resource.table == "customers" && request.time < timestamp("2024-02-01T00:00:00Z")`,
+			want: `resource.database in ["instances/prod/databases/crm"] && resource.table == "customers" && request.time < timestamp("2024-03-01T08:00:00Z")`,
+		},
+		{
+			expr: `(resource.table == "customers" || resource.table == "orders") && request.row_limit <= 1000`,
+			want: `(resource.table == "customers" || resource.table == "orders") && request.row_limit <= 1000 && request.time < timestamp("2024-03-01T08:00:00Z")`,
+		},
+	}
+	for _, tc := range testCases {
+		got, err := SetBindingExpireTime(tc.expr, newExpireTime)
+		a.NoError(err, tc.expr)
+		a.Equal(tc.want, got)
+		expireTime, err := GetBindingExpireTime(got)
+		a.NoError(err)
+		a.True(newExpireTime.Equal(*expireTime))
+	}
+}
@@ -0,0 +1,136 @@
+// Package classifier suggests the data classifications of the columns by the discovery rules.
+package classifier
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// MaximumSampleSize is the maximum number of rows sampled from a table.
+	MaximumSampleSize = 1000
+	// minimumDetectedRatio is the minimum ratio of the detected values in the non-empty sampled values for a rule with value detector to match.
+	minimumDetectedRatio = 0.8
+)
+
+// Classifier suggests the classifications of the columns by the discovery rules of the data classification config.
+type Classifier struct {
+	rules      []*discoveryRule
+	sampleSize int
+}
+
+type discoveryRule struct {
+	*storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule
+	index       int
+	namePattern *regexp.Regexp
+	typePattern *regexp.Regexp
+}
+
+// New creates a classifier from the data classification config, the discovery rules are validated.
+func New(config *storepb.DataClassificationSetting_DataClassificationConfig) (*Classifier, error) {
+	if config.DiscoverySampleSize < 0 || config.DiscoverySampleSize > MaximumSampleSize {
+		return nil, errors.Errorf("discovery sample size must be between 0 and %d", MaximumSampleSize)
+	}
+	c := &Classifier{sampleSize: int(config.DiscoverySampleSize)}
+	for i, rule := range config.DiscoveryRules {
+		if _, ok := config.Classification[rule.ClassificationId]; !ok {
+			return nil, errors.Errorf("classification %q of discovery rule %d not found", rule.ClassificationId, i+1)
+		}
+		if rule.ColumnNamePattern == "" && rule.ColumnTypePattern == "" && rule.ValueDetector == storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_VALUE_DETECTOR_UNSPECIFIED {
+			return nil, errors.Errorf("discovery rule %d must have a column name pattern, a column type pattern or a value detector", i+1)
+		}
+		r := &discoveryRule{DataClassificationSetting_DataClassificationConfig_DiscoveryRule: rule, index: i + 1}
+		if rule.ColumnNamePattern != "" {
+			pattern, err := regexp.Compile(rule.ColumnNamePattern)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid column name pattern of discovery rule %d", i+1)
+			}
+			r.namePattern = pattern
+		}
+		if rule.ColumnTypePattern != "" {
+			pattern, err := regexp.Compile(rule.ColumnTypePattern)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid column type pattern of discovery rule %d", i+1)
+			}
+			r.typePattern = pattern
+		}
+		c.rules = append(c.rules, r)
+	}
+	return c, nil
+}
+
+// IsEmpty returns whether the classifier has no rule.
+func (c *Classifier) IsEmpty() bool {
+	return len(c.rules) == 0
+}
+
+// SampleSize returns the maximum number of rows sampled from a table.
+func (c *Classifier) SampleSize() int {
+	return c.sampleSize
+}
+
+// NeedSample returns whether the sampled values of the column are required to match the rules.
+func (c *Classifier) NeedSample(name, columnType string) bool {
+	if c.sampleSize == 0 {
+		return false
+	}
+	for _, rule := range c.rules {
+		if rule.ValueDetector != storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_VALUE_DETECTOR_UNSPECIFIED && rule.matchColumn(name, columnType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Suggest returns the classification suggestion of the column by the first matched rule, or nil if no rule matches.
+// The values are the sampled values of the column, nil if the column is not sampled.
+func (c *Classifier) Suggest(name, columnType string, values []string) *storepb.ClassificationSuggestion {
+	for _, rule := range c.rules {
+		if !rule.matchColumn(name, columnType) {
+			continue
+		}
+		if rule.ValueDetector == storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_VALUE_DETECTOR_UNSPECIFIED {
+			return &storepb.ClassificationSuggestion{
+				ClassificationId: rule.ClassificationId,
+				SemanticTypeId:   rule.SemanticTypeId,
+				Reason:           fmt.Sprintf("column %q of type %q matches discovery rule %d", name, columnType, rule.index),
+			}
+		}
+		if c.sampleSize == 0 {
+			continue
+		}
+		detected, total := 0, 0
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+			total++
+			if Detect(rule.ValueDetector, value) {
+				detected++
+			}
+		}
+		if total == 0 || float64(detected)/float64(total) < minimumDetectedRatio {
+			continue
+		}
+		return &storepb.ClassificationSuggestion{
+			ClassificationId: rule.ClassificationId,
+			SemanticTypeId:   rule.SemanticTypeId,
+			Reason:           fmt.Sprintf("%d of %d sampled values of column %q are detected as %s by discovery rule %d", detected, total, name, rule.ValueDetector, rule.index),
+		}
+	}
+	return nil
+}
+
+func (r *discoveryRule) matchColumn(name, columnType string) bool {
+	if r.namePattern != nil && !r.namePattern.MatchString(name) {
+		return false
+	}
+	if r.typePattern != nil && !r.typePattern.MatchString(columnType) {
+		return false
+	}
+	return true
+}
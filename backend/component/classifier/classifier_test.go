@@ -0,0 +1,82 @@
+package classifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		detector storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_ValueDetector
+		value    string
+		want     bool
+	}{
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_EMAIL, "alice@example.com", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_EMAIL, "alice.smith+tag@mail.example.co.uk", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_EMAIL, "alice@localhost", false},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_PHONE_NUMBER, "+1 (415) 555-2671", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_PHONE_NUMBER, "4155552671", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_PHONE_NUMBER, "555-2671", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_PHONE_NUMBER, "5552671", false},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_PHONE_NUMBER, "call me", false},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_CREDIT_CARD, "4111 1111 1111 1111", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_CREDIT_CARD, "5500-0000-0000-0004", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_CREDIT_CARD, "4111 1111 1111 1112", false},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_NATIONAL_ID, "123-45-6789", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_NATIONAL_ID, "666-45-6789", false},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_NATIONAL_ID, "123-00-6789", false},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_IBAN, "GB82 WEST 1234 5698 7654 32", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_IBAN, "DE89370400440532013000", true},
+		{storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_IBAN, "DE89370400440532013001", false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, Detect(test.detector, test.value), "%s %q", test.detector, test.value)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	config := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {Id: "1-1", Title: "Contact"},
+			"1-2": {Id: "1-2", Title: "Payment"},
+		},
+		DiscoveryRules: []*storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule{
+			{ClassificationId: "1-1", ColumnNamePattern: `(?i)^e_?mail$`},
+			{ClassificationId: "1-2", SemanticTypeId: "card", ColumnTypePattern: `(?i)char|text`, ValueDetector: storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_CREDIT_CARD},
+		},
+		DiscoverySampleSize: 100,
+	}
+	a := require.New(t)
+	c, err := New(config)
+	a.NoError(err)
+
+	suggestion := c.Suggest("Email", "varchar(255)", nil)
+	a.NotNil(suggestion)
+	a.Equal("1-1", suggestion.ClassificationId)
+
+	a.True(c.NeedSample("card_no", "varchar(32)"))
+	a.False(c.NeedSample("amount", "int"))
+	suggestion = c.Suggest("card_no", "varchar(32)", []string{"4111 1111 1111 1111", "5500-0000-0000-0004", "", "4012888888881881", "378282246310005", "n/a"})
+	a.NotNil(suggestion)
+	a.Equal("1-2", suggestion.ClassificationId)
+	a.Equal("card", suggestion.SemanticTypeId)
+	a.Nil(c.Suggest("card_no", "varchar(32)", []string{"4111 1111 1111 1111", "n/a"}))
+	a.Nil(c.Suggest("note", "text", nil))
+
+	config.DiscoverySampleSize = 0
+	c, err = New(config)
+	a.NoError(err)
+	a.False(c.NeedSample("card_no", "varchar(32)"))
+
+	config.DiscoveryRules = append(config.DiscoveryRules, &storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule{ClassificationId: "9-9", ColumnNamePattern: "ssn"})
+	_, err = New(config)
+	a.Error(err)
+	config.DiscoveryRules[2] = &storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule{ClassificationId: "1-1", ColumnNamePattern: "("}
+	_, err = New(config)
+	a.Error(err)
+}
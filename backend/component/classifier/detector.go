@@ -0,0 +1,114 @@
+package classifier
+
+import (
+	"regexp"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}$`)
+	phoneRegexp = regexp.MustCompile(`^\+?[0-9 ().\-]+$`)
+	ssnRegexp   = regexp.MustCompile(`^([0-9]{3})-?([0-9]{2})-?([0-9]{4})$`)
+	ibanRegexp  = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+)
+
+// Detect returns whether the value is detected by the detector.
+func Detect(detector storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_ValueDetector, value string) bool {
+	value = strings.TrimSpace(value)
+	switch detector {
+	case storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_EMAIL:
+		return emailRegexp.MatchString(value)
+	case storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_PHONE_NUMBER:
+		return isPhoneNumber(value)
+	case storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_CREDIT_CARD:
+		return isCreditCard(value)
+	case storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_NATIONAL_ID:
+		return isSSN(value)
+	case storepb.DataClassificationSetting_DataClassificationConfig_DiscoveryRule_IBAN:
+		return isIBAN(value)
+	default:
+		return false
+	}
+}
+
+// isPhoneNumber detects the phone numbers of 7 to 15 digits as E.164 defines.
+// The plain numbers shorter than 10 digits are ignored, since they are more likely to be the ids or the amounts.
+func isPhoneNumber(value string) bool {
+	if !phoneRegexp.MatchString(value) {
+		return false
+	}
+	digits := onlyDigits(value)
+	if len(digits) < 7 || len(digits) > 15 {
+		return false
+	}
+	return len(digits) >= 10 || len(digits) != len(value)
+}
+
+// isCreditCard detects the card numbers of 13 to 19 digits with the valid Luhn checksum.
+func isCreditCard(value string) bool {
+	for _, r := range value {
+		if (r < '0' || r > '9') && r != ' ' && r != '-' {
+			return false
+		}
+	}
+	digits := onlyDigits(value)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isSSN detects the US social security numbers, the area 000, 666 and 900-999, the group 00 and the serial 0000 are never assigned.
+func isSSN(value string) bool {
+	matches := ssnRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return false
+	}
+	area, group, serial := matches[1], matches[2], matches[3]
+	if area == "000" || area == "666" || area[0] == '9' {
+		return false
+	}
+	return group != "00" && serial != "0000"
+}
+
+// isIBAN detects the international bank account numbers with the valid ISO 7064 MOD 97-10 checksum.
+func isIBAN(value string) bool {
+	value = strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if !ibanRegexp.MatchString(value) {
+		return false
+	}
+	// Move the country code and the check digits to the end, and convert the letters to the numbers from 10 to 35.
+	rearranged := value[4:] + value[:4]
+	remainder := 0
+	for _, r := range rearranged {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
+
+func onlyDigits(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			_, _ = b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
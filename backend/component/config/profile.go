@@ -22,6 +22,12 @@ type Profile struct {
 	SampleDatabasePort int
 	// Port is the binding port for the server.
 	Port int
+	// MetricsPort is the binding port for the operational Prometheus metrics endpoint.
+	// The endpoint is disabled if MetricsPort is 0.
+	MetricsPort int
+	// OTLPEndpoint is the OTLP/gRPC endpoint URL where the tracing spans are exported to.
+	// The tracing is disabled if OTLPEndpoint is empty.
+	OTLPEndpoint string
 	// PgUser is the user we use to connect to bytebase's Postgres database.
 	// The name of the database storing metadata is the same as pgUser.
 	PgUser string
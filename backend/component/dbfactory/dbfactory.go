@@ -3,9 +3,14 @@ package dbfactory
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/secret"
+	"github.com/bytebase/bytebase/backend/component/tracing"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
@@ -20,6 +25,9 @@ type DBFactory struct {
 	mongoBinDir string
 	dataDir     string
 	secret      string
+
+	pool    *driverPool
+	limiter *connectionLimiter
 }
 
 // New creates a new database driver factory.
@@ -30,12 +38,65 @@ func New(mysqlBinDir, mongoBinDir, pgBinDir, dataDir, secret string) *DBFactory
 		pgBinDir:    pgBinDir,
 		dataDir:     dataDir,
 		secret:      secret,
+		pool:        newDriverPool(),
+		limiter:     newConnectionLimiter(),
+	}
+}
+
+// Run closes the idle pooled drivers periodically, and closes all of them when the context is done.
+func (d *DBFactory) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(poolEvictionInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	for {
+		select {
+		case <-ticker.C:
+			d.pool.evict()
+		case <-ctx.Done():
+			d.pool.close()
+			return
+		}
 	}
 }
 
+// InvalidateInstance closes the pooled drivers of the instance.
+// It should be called after the instance or its data sources are updated or deleted.
+func (d *DBFactory) InvalidateInstance(instance *store.InstanceMessage) {
+	d.pool.invalidate(instance.ResourceID)
+}
+
 // GetAdminDatabaseDriver gets the admin database driver using the instance's admin data source.
 // Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
+// The driver is leased from the pool, and closing it returns the driver to the pool.
 func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connectionContext db.ConnectionContext) (db.Driver, error) {
+	return d.getAdminDatabaseDriver(ctx, instance, database, connectionContext, true /* pooled */)
+}
+
+// GetDedicatedAdminDatabaseDriver is the same as GetAdminDatabaseDriver except that the driver is opened outside of the pool.
+// It is used by the interactive sessions, e.g. SQL editor execution, whose session states must not be shared with others.
+func (d *DBFactory) GetDedicatedAdminDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connectionContext db.ConnectionContext) (db.Driver, error) {
+	return d.getAdminDatabaseDriver(ctx, instance, database, connectionContext, false /* pooled */)
+}
+
+// GetDedicatedUserDatabaseDriver opens a database driver outside of the pool that connects as the given user with password authentication.
+// The other connection settings, e.g. the host and the SSH tunnel, are taken from the instance's admin data source.
+// It is used to run statements with fewer privileges than the admin data source, e.g. the changelist dry runs.
+func (d *DBFactory) GetDedicatedUserDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, databaseName, username, password string) (db.Driver, error) {
+	adminDataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+	if adminDataSource == nil {
+		return nil, common.Errorf(common.Internal, "admin data source not found for instance %q", instance.Title)
+	}
+	dataSource := adminDataSource.Copy()
+	dataSource.Username = username
+	dataSource.ObfuscatedPassword = common.Obfuscate(password, d.secret)
+	dataSource.ExternalSecret = nil
+	dataSource.AuthenticationType = storepb.DataSourceOptions_PASSWORD
+	dataSource.AuthenticationPrivateKeyObfuscated = ""
+	dataSource.SASLConfig = nil
+	return d.OpenDataSourceDriver(ctx, instance, dataSource, databaseName, false /* datashare */, false /* readOnly */, db.ConnectionContext{})
+}
+
+func (d *DBFactory) getAdminDatabaseDriver(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connectionContext db.ConnectionContext, pooled bool) (db.Driver, error) {
 	dataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
 	if dataSource == nil {
 		return nil, common.Errorf(common.Internal, "admin data source not found for instance %q", instance.Title)
@@ -58,6 +119,9 @@ func (d *DBFactory) GetAdminDatabaseDriver(ctx context.Context, instance *store.
 		dataSource.SID = ""
 		databaseName = database.DatabaseName
 	}
+	if !pooled {
+		return d.OpenDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, false /* readOnly */, connectionContext)
+	}
 	return d.GetDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, false /* readOnly */, connectionContext)
 }
 
@@ -117,7 +181,56 @@ func (*DBFactory) GetReadOnlyDatabaseSource(instance *store.InstanceMessage, dat
 }
 
 // GetDataSourceDriver returns the database driver for a data source.
+// The driver is leased from the pool keyed by the data source, the database and the read-only flag,
+// and closing it returns the driver to the pool.
+// The lease holds an outstanding connection of the instance until the driver is closed.
 func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, connectionContext db.ConnectionContext) (db.Driver, error) {
+	if databaseName == "" {
+		databaseName = dataSource.Database
+	}
+	key, err := newPoolKey(instance, dataSource, databaseName, datashare, readOnly, connectionContext)
+	if err != nil {
+		return nil, err
+	}
+	release, err := d.acquireInstanceConnection(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	driver := d.pool.get(ctx, key)
+	if driver == nil {
+		driver, err = d.openDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, readOnly, connectionContext)
+		if err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return &pooledDriver{
+		Driver:  driver,
+		pool:    d.pool,
+		key:     key,
+		engine:  instance.Engine,
+		maxIdle: GetInstanceMaximumIdleConnections(instance),
+		release: release,
+	}, nil
+}
+
+// OpenDataSourceDriver opens the database driver for a data source outside of the pool.
+// It is used to test the connections of the unsaved data sources as well.
+// Upon successful return, caller must call driver.Close(). Otherwise, it will leak the database connection.
+func (d *DBFactory) OpenDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, connectionContext db.ConnectionContext) (db.Driver, error) {
+	release, err := d.acquireInstanceConnection(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := d.openDataSourceDriver(ctx, instance, dataSource, databaseName, datashare, readOnly, connectionContext)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &limitedDriver{Driver: driver, release: release}, nil
+}
+
+func (d *DBFactory) openDataSourceDriver(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, connectionContext db.ConnectionContext) (db.Driver, error) {
 	dbBinDir := ""
 	switch instance.Engine {
 	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
@@ -195,6 +308,12 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 	}
 	connectionContext.InstanceID = instance.ResourceID
 	connectionContext.EngineVersion = instance.EngineVersion
+	attrs := []attribute.KeyValue{
+		tracing.Engine(instance.Engine),
+		tracing.Instance(instance.ResourceID),
+		tracing.Database(databaseName),
+	}
+	ctx, span := tracing.Start(ctx, "dbfactory.Open", attrs...)
 	driver, err := db.Open(
 		ctx,
 		instance.Engine,
@@ -231,9 +350,10 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 			WarehouseID:              dataSource.WarehouseID,
 		},
 	)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
 
-	return driver, nil
+	return &tracedDriver{Driver: driver, attrs: attrs}, nil
 }
@@ -0,0 +1,142 @@
+package dbfactory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/metrics"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// DefaultInstanceMaximumConnections is the maximum number of connections outstanding per instance by default.
+	DefaultInstanceMaximumConnections = 10
+	// DefaultInstanceMaximumIdleConnections is the maximum number of idle pooled drivers per instance by default.
+	DefaultInstanceMaximumIdleConnections = 2
+	// connectionAcquireTimeout is the duration to wait for an outstanding connection of the instance to be released.
+	connectionAcquireTimeout = 30 * time.Second
+)
+
+// GetInstanceMaximumConnections returns the maximum number of connections outstanding for the instance.
+func GetInstanceMaximumConnections(instance *store.InstanceMessage) int {
+	maximumConnections := int(instance.Options.GetMaximumConnections())
+	if maximumConnections <= 0 {
+		maximumConnections = DefaultInstanceMaximumConnections
+	}
+	return maximumConnections
+}
+
+// GetInstanceMaximumIdleConnections returns the maximum number of idle pooled drivers for the instance.
+// Idle drivers keep their connections open, so it never exceeds the maximum connections of the instance.
+func GetInstanceMaximumIdleConnections(instance *store.InstanceMessage) int {
+	return min(DefaultInstanceMaximumIdleConnections, GetInstanceMaximumConnections(instance))
+}
+
+type reservedInstanceConnectionKey struct{}
+
+// WithReservedInstanceConnection returns a copy of ctx carrying the connection reserved by TryAcquireInstanceConnection.
+// The drivers of the instance got with the returned context share the reserved connection instead of acquiring their own,
+// so that a job never waits for the connections held by itself.
+func WithReservedInstanceConnection(ctx context.Context, instance *store.InstanceMessage) context.Context {
+	return context.WithValue(ctx, reservedInstanceConnectionKey{}, instance.UID)
+}
+
+func hasReservedInstanceConnection(ctx context.Context, instance *store.InstanceMessage) bool {
+	uid, ok := ctx.Value(reservedInstanceConnectionKey{}).(int)
+	return ok && uid == instance.UID
+}
+
+// connectionLimiter limits the outstanding connections per instance.
+type connectionLimiter struct {
+	mu sync.Mutex
+	// outstanding is the number of outstanding connections per instance UID.
+	outstanding map[int]int
+	// released is closed and replaced whenever a connection is released, to wake up the waiters.
+	released chan struct{}
+}
+
+func newConnectionLimiter() *connectionLimiter {
+	return &connectionLimiter{
+		outstanding: make(map[int]int),
+		released:    make(chan struct{}),
+	}
+}
+
+// tryAcquire reserves a connection of the instance, or returns the channel closed upon the next release if there is none left.
+func (l *connectionLimiter) tryAcquire(instance *store.InstanceMessage) (bool, <-chan struct{}) {
+	maximumConnections := GetInstanceMaximumConnections(instance)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.outstanding[instance.UID] >= maximumConnections {
+		return false, l.released
+	}
+	l.outstanding[instance.UID]++
+	metrics.SetInstanceConnections(instance.UID, l.outstanding[instance.UID], maximumConnections)
+	return true, nil
+}
+
+// acquire reserves a connection of the instance, waiting for connectionAcquireTimeout at most.
+func (l *connectionLimiter) acquire(ctx context.Context, instance *store.InstanceMessage) error {
+	timer := time.NewTimer(connectionAcquireTimeout)
+	defer timer.Stop()
+	for {
+		ok, released := l.tryAcquire(instance)
+		if ok {
+			return nil
+		}
+		select {
+		case <-released:
+		case <-timer.C:
+			return errors.Errorf("instance %q has reached its maximum connections %d", instance.ResourceID, GetInstanceMaximumConnections(instance))
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *connectionLimiter) release(instance *store.InstanceMessage) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.outstanding[instance.UID]--
+	if l.outstanding[instance.UID] <= 0 {
+		delete(l.outstanding, instance.UID)
+	}
+	metrics.SetInstanceOutstandingConnections(instance.UID, l.outstanding[instance.UID])
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// acquireInstanceConnection reserves a connection of the instance for a driver, unless ctx carries a reserved connection of the instance.
+// It returns the function releasing the connection.
+func (d *DBFactory) acquireInstanceConnection(ctx context.Context, instance *store.InstanceMessage) (func(), error) {
+	// Unsaved instances, e.g. the ones being tested before creation, are not limited.
+	if instance.UID == 0 || hasReservedInstanceConnection(ctx, instance) {
+		return func() {}, nil
+	}
+	if err := d.limiter.acquire(ctx, instance); err != nil {
+		return nil, err
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			d.limiter.release(instance)
+		})
+	}, nil
+}
+
+// TryAcquireInstanceConnection reserves a connection of the instance for a background job, e.g. a task run or a plan check run.
+// It returns false without blocking if the instance has reached its maximum connections.
+// Upon successful return, caller must call ReleaseInstanceConnection after the job is done,
+// and get the drivers of the instance with the context returned by WithReservedInstanceConnection.
+func (d *DBFactory) TryAcquireInstanceConnection(instance *store.InstanceMessage) bool {
+	ok, _ := d.limiter.tryAcquire(instance)
+	return ok
+}
+
+// ReleaseInstanceConnection releases the connection reserved by TryAcquireInstanceConnection.
+func (d *DBFactory) ReleaseInstanceConnection(instance *store.InstanceMessage) {
+	d.limiter.release(instance)
+}
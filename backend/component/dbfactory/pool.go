@@ -0,0 +1,337 @@
+package dbfactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// poolIdleTimeout is the duration after which an idle pooled driver is closed.
+	poolIdleTimeout = 10 * time.Minute
+	// poolEvictionInterval is the interval to close the idle pooled drivers.
+	poolEvictionInterval = time.Minute
+	// sessionResetTimeout is the timeout to reset the sessions of a driver returned to the pool.
+	sessionResetTimeout = 5 * time.Second
+)
+
+// poolKey is the key of the pooled drivers.
+// Drivers with the same key are interchangeable.
+type poolKey struct {
+	instanceID        string
+	dataSourceID      string
+	databaseName      string
+	datashare         bool
+	readOnly          bool
+	connectionContext db.ConnectionContext
+	// fingerprint is the hash of the instance and data source configs,
+	// so that the drivers opened with an outdated config are never reused.
+	fingerprint string
+}
+
+func newPoolKey(instance *store.InstanceMessage, dataSource *store.DataSourceMessage, databaseName string, datashare, readOnly bool, connectionContext db.ConnectionContext) (poolKey, error) {
+	b, err := json.Marshal(struct {
+		Engine        string
+		EngineVersion string
+		DataSource    *store.DataSourceMessage
+	}{
+		Engine:        instance.Engine.String(),
+		EngineVersion: instance.EngineVersion,
+		DataSource:    dataSource,
+	})
+	if err != nil {
+		return poolKey{}, err
+	}
+	h := sha256.Sum256(b)
+	return poolKey{
+		instanceID:        instance.ResourceID,
+		dataSourceID:      dataSource.ID,
+		databaseName:      databaseName,
+		datashare:         datashare,
+		readOnly:          readOnly,
+		connectionContext: connectionContext,
+		fingerprint:       hex.EncodeToString(h[:]),
+	}, nil
+}
+
+type idleDriver struct {
+	driver    db.Driver
+	idleSince time.Time
+}
+
+// driverPool keeps the idle drivers for reuse.
+// A pooled driver is leased to one caller at a time and is returned to the pool when the caller closes it.
+type driverPool struct {
+	mu   sync.Mutex
+	idle map[poolKey][]*idleDriver
+	// idleCount is the number of idle drivers per instance.
+	idleCount map[string]int
+	closed    bool
+}
+
+func newDriverPool() *driverPool {
+	return &driverPool{
+		idle:      make(map[poolKey][]*idleDriver),
+		idleCount: make(map[string]int),
+	}
+}
+
+// get leases an idle driver of the key, or returns nil if there is no healthy idle driver.
+func (p *driverPool) get(ctx context.Context, key poolKey) db.Driver {
+	for {
+		p.mu.Lock()
+		drivers := p.idle[key]
+		if len(drivers) == 0 {
+			p.mu.Unlock()
+			return nil
+		}
+		// Reuse the most recently returned driver, so that the others can be evicted.
+		d := drivers[len(drivers)-1]
+		p.removeLocked(key, len(drivers)-1)
+		p.mu.Unlock()
+
+		// The connection may have been closed by the server or the network while idle.
+		if err := d.driver.Ping(ctx); err != nil {
+			slog.Debug("close the unhealthy pooled driver", slog.String("instance", key.instanceID), log.BBError(err))
+			closeDriver(d.driver)
+			continue
+		}
+		return d.driver
+	}
+}
+
+// put returns the driver to the pool.
+// The driver is closed if the instance has maxIdle idle drivers already.
+func (p *driverPool) put(key poolKey, driver db.Driver, maxIdle int) {
+	p.mu.Lock()
+	if p.closed || p.idleCount[key.instanceID] >= maxIdle {
+		p.mu.Unlock()
+		closeDriver(driver)
+		return
+	}
+	p.idle[key] = append(p.idle[key], &idleDriver{driver: driver, idleSince: time.Now()})
+	p.idleCount[key.instanceID]++
+	p.mu.Unlock()
+}
+
+// evict closes the drivers idle for longer than poolIdleTimeout.
+func (p *driverPool) evict() {
+	deadline := time.Now().Add(-poolIdleTimeout)
+	var evicted []db.Driver
+	p.mu.Lock()
+	for key, drivers := range p.idle {
+		// Drivers are appended in the order of being returned, so the front ones are the oldest.
+		for len(drivers) > 0 && drivers[0].idleSince.Before(deadline) {
+			evicted = append(evicted, drivers[0].driver)
+			p.removeLocked(key, 0)
+			drivers = p.idle[key]
+		}
+	}
+	p.mu.Unlock()
+	for _, driver := range evicted {
+		closeDriver(driver)
+	}
+}
+
+// invalidate closes the idle drivers of the instance.
+// Leased drivers are closed when they are returned because their fingerprints no longer match if the configs changed.
+func (p *driverPool) invalidate(instanceID string) {
+	var invalidated []db.Driver
+	p.mu.Lock()
+	for key, drivers := range p.idle {
+		if key.instanceID != instanceID {
+			continue
+		}
+		for _, d := range drivers {
+			invalidated = append(invalidated, d.driver)
+		}
+		delete(p.idle, key)
+	}
+	delete(p.idleCount, instanceID)
+	p.mu.Unlock()
+	for _, driver := range invalidated {
+		closeDriver(driver)
+	}
+}
+
+// close closes all the idle drivers, and the drivers returned afterwards.
+func (p *driverPool) close() {
+	var drivers []db.Driver
+	p.mu.Lock()
+	p.closed = true
+	for _, idle := range p.idle {
+		for _, d := range idle {
+			drivers = append(drivers, d.driver)
+		}
+	}
+	p.idle = make(map[poolKey][]*idleDriver)
+	p.idleCount = make(map[string]int)
+	p.mu.Unlock()
+	for _, driver := range drivers {
+		closeDriver(driver)
+	}
+}
+
+func (p *driverPool) removeLocked(key poolKey, i int) {
+	drivers := p.idle[key]
+	drivers = append(drivers[:i], drivers[i+1:]...)
+	if len(drivers) == 0 {
+		delete(p.idle, key)
+	} else {
+		p.idle[key] = drivers
+	}
+	p.idleCount[key.instanceID]--
+	if p.idleCount[key.instanceID] <= 0 {
+		delete(p.idleCount, key.instanceID)
+	}
+}
+
+func closeDriver(driver db.Driver) {
+	if err := driver.Close(context.Background()); err != nil {
+		slog.Debug("failed to close the pooled driver", log.BBError(err))
+	}
+}
+
+// pooledDriver is the driver leased from the pool.
+// Closing it returns the driver to the pool instead of closing the connection.
+type pooledDriver struct {
+	db.Driver
+	pool    *driverPool
+	key     poolKey
+	engine  storepb.Engine
+	maxIdle int
+	// release releases the outstanding connection of the instance held by the lease.
+	release func()
+	once    sync.Once
+}
+
+// Close resets the sessions of the driver and returns the driver to the pool.
+// The driver is closed instead if its sessions cannot be reset.
+func (d *pooledDriver) Close(_ context.Context) error {
+	d.once.Do(func() {
+		if d.release != nil {
+			defer d.release()
+		}
+		if err := resetSessions(d.engine, d.Driver); err != nil {
+			slog.Debug("close the pooled driver failed to reset sessions", slog.String("instance", d.key.instanceID), log.BBError(err))
+			closeDriver(d.Driver)
+			return
+		}
+		d.pool.put(d.key, d.Driver, d.maxIdle)
+	})
+	return nil
+}
+
+// getResetSessionStatements returns the statements resetting the session states of a connection,
+// e.g. the current role, the search path and the session variables.
+// It returns nil if the engine cannot reset the session states completely with statements.
+func getResetSessionStatements(engine storepb.Engine) []string {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return []string{
+			// RESET ALL skips the role and the session authorization.
+			"SET SESSION AUTHORIZATION DEFAULT",
+			"RESET ALL",
+			"DISCARD TEMP",
+		}
+	default:
+		return nil
+	}
+}
+
+// isSessionResetByDriver returns true if the database driver resets the session states by itself whenever a connection is reused.
+// The SQL Server driver flags the next request of a reused connection with RESETCONNECTION, so the server runs sp_reset_connection.
+func isSessionResetByDriver(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MSSQL:
+		return true
+	default:
+		return false
+	}
+}
+
+// resetSessions resets the session states left by the lease on the idle connections of the driver,
+// so that the next lease never inherits them, e.g. the current database changed by USE.
+// The idle connections are kept only for PostgreSQL, which is reset by statements, and SQL Server, which is reset by its driver.
+// The other engines cannot reset the session states, e.g. the MySQL driver does not support COM_RESET_CONNECTION,
+// so their idle connections are discarded and reopened on demand, and only the drivers with their SSH tunnels are reused.
+func resetSessions(engine storepb.Engine, driver db.Driver) error {
+	sqlDB := driver.GetDB()
+	if sqlDB == nil || isSessionResetByDriver(engine) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sessionResetTimeout)
+	defer cancel()
+	statements := getResetSessionStatements(engine)
+	// Hold all the idle connections at the same time, so that each of them is reset exactly once.
+	var conns []*sql.Conn
+	defer func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}()
+	for i := 0; i < sqlDB.Stats().Idle; i++ {
+		conn, err := sqlDB.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		conns = append(conns, conn)
+	}
+	for _, conn := range conns {
+		if len(statements) == 0 {
+			// Returning driver.ErrBadConn discards the connection.
+			_ = conn.Raw(func(any) error {
+				return sqldriver.ErrBadConn
+			})
+			continue
+		}
+		for _, statement := range statements {
+			if _, err := conn.ExecContext(ctx, statement); err != nil {
+				return errors.Wrapf(err, "failed to reset session with %q", statement)
+			}
+		}
+	}
+	return nil
+}
+
+// limitedDriver is the driver opened outside of the pool, holding an outstanding connection of the instance.
+type limitedDriver struct {
+	db.Driver
+	release func()
+}
+
+// Close closes the driver and releases the outstanding connection of the instance.
+func (d *limitedDriver) Close(ctx context.Context) error {
+	defer d.release()
+	return d.Driver.Close(ctx)
+}
+
+// Unwrap returns the concrete driver under the pooled and traced driver wrappers.
+// It is for the callers depending on the engine-specific methods of the concrete driver types.
+func Unwrap(driver db.Driver) db.Driver {
+	for {
+		switch d := driver.(type) {
+		case *pooledDriver:
+			driver = d.Driver
+		case *limitedDriver:
+			driver = d.Driver
+		case *tracedDriver:
+			driver = d.Driver
+		default:
+			return driver
+		}
+	}
+}
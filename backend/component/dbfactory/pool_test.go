@@ -0,0 +1,175 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type fakeDriver struct {
+	db.Driver
+	sqlDB   *sql.DB
+	pingErr error
+	closed  bool
+}
+
+func (d *fakeDriver) GetDB() *sql.DB {
+	return d.sqlDB
+}
+
+func (d *fakeDriver) Ping(context.Context) error {
+	return d.pingErr
+}
+
+func (d *fakeDriver) Close(context.Context) error {
+	d.closed = true
+	return nil
+}
+
+func TestDriverPool(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	p := newDriverPool()
+	key := poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db"}
+	otherKey := poolKey{instanceID: "prod", dataSourceID: "admin", databaseName: "db", readOnly: true}
+
+	a.Nil(p.get(ctx, key))
+
+	// A returned driver is reused by the same key only.
+	d1 := &fakeDriver{}
+	var released int
+	leased := &pooledDriver{Driver: d1, pool: p, key: key, maxIdle: 2, release: func() { released++ }}
+	a.NoError(leased.Close(ctx))
+	// Closing twice does not return the driver twice.
+	a.NoError(leased.Close(ctx))
+	a.Equal(1, released)
+	a.Nil(p.get(ctx, otherKey))
+	a.Equal(db.Driver(d1), p.get(ctx, key))
+	a.Nil(p.get(ctx, key))
+	a.False(d1.closed)
+
+	// The drivers beyond the maximum idle drivers per instance are closed.
+	d2, d3 := &fakeDriver{}, &fakeDriver{}
+	p.put(key, d1, 2)
+	p.put(otherKey, d2, 2)
+	p.put(key, d3, 2)
+	a.True(d3.closed)
+	a.Equal(2, p.idleCount["prod"])
+
+	// Unhealthy drivers are closed instead of being leased.
+	d1.pingErr = errors.New("broken pipe")
+	a.Nil(p.get(ctx, key))
+	a.True(d1.closed)
+	a.Equal(1, p.idleCount["prod"])
+
+	// Drivers idle for too long are evicted.
+	p.idle[otherKey][0].idleSince = time.Now().Add(-poolIdleTimeout - time.Second)
+	p.evict()
+	a.True(d2.closed)
+	a.Empty(p.idle)
+	a.Empty(p.idleCount)
+
+	// Invalidation closes the idle drivers of the instance only.
+	d4, d5 := &fakeDriver{}, &fakeDriver{}
+	p.put(key, d4, 2)
+	p.put(poolKey{instanceID: "test"}, d5, 2)
+	p.invalidate("prod")
+	a.True(d4.closed)
+	a.False(d5.closed)
+
+	// Drivers returned after the pool is closed are closed.
+	p.close()
+	a.True(d5.closed)
+	d6 := &fakeDriver{}
+	p.put(key, d6, 2)
+	a.True(d6.closed)
+}
+
+func TestResetSessions(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	a.NoError(err)
+	defer sqlDB.Close()
+	conn, err := sqlDB.Conn(ctx)
+	a.NoError(err)
+	a.NoError(conn.Close())
+	a.Equal(1, sqlDB.Stats().Idle)
+
+	// The connections that cannot be reset are discarded.
+	a.NoError(resetSessions(storepb.Engine_MYSQL, &fakeDriver{sqlDB: sqlDB}))
+	a.Equal(0, sqlDB.Stats().Idle)
+	a.Equal(0, sqlDB.Stats().OpenConnections)
+
+	// The connections reset by the driver are kept.
+	conn, err = sqlDB.Conn(ctx)
+	a.NoError(err)
+	a.NoError(conn.Close())
+	a.NoError(resetSessions(storepb.Engine_MSSQL, &fakeDriver{sqlDB: sqlDB}))
+	a.Equal(1, sqlDB.Stats().Idle)
+
+	// The driver is closed instead of being returned to the pool if the sessions cannot be reset.
+	conn, err = sqlDB.Conn(ctx)
+	a.NoError(err)
+	a.NoError(conn.Close())
+	p := newDriverPool()
+	d := &fakeDriver{sqlDB: sqlDB}
+	leased := &pooledDriver{Driver: d, pool: p, key: poolKey{instanceID: "prod"}, engine: storepb.Engine_POSTGRES, maxIdle: 2}
+	a.NoError(leased.Close(ctx))
+	a.True(d.closed)
+	a.Empty(p.idle)
+}
+
+func TestConnectionLimiter(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	d := New("", "", "", "", "")
+	instance := &store.InstanceMessage{UID: 1, ResourceID: "prod", Options: &storepb.InstanceOptions{MaximumConnections: 2}}
+
+	a.True(d.TryAcquireInstanceConnection(instance))
+	release, err := d.acquireInstanceConnection(ctx, instance)
+	a.NoError(err)
+	a.False(d.TryAcquireInstanceConnection(instance))
+
+	// The drivers of a job share the connection reserved for the job.
+	reserved, err := d.acquireInstanceConnection(WithReservedInstanceConnection(ctx, instance), instance)
+	a.NoError(err)
+	reserved()
+	a.Equal(2, d.limiter.outstanding[instance.UID])
+
+	// The acquisition waits for the outstanding connections to be released.
+	cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = d.acquireInstanceConnection(cancelCtx, instance)
+	a.ErrorIs(err, context.DeadlineExceeded)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		d.ReleaseInstanceConnection(instance)
+	}()
+	waited, err := d.acquireInstanceConnection(ctx, instance)
+	a.NoError(err)
+
+	release()
+	// Releasing twice does not release the connection twice.
+	release()
+	waited()
+	a.Empty(d.limiter.outstanding)
+}
+
+func TestUnwrap(t *testing.T) {
+	a := require.New(t)
+	d := &fakeDriver{}
+	a.Same(d, Unwrap(d))
+	a.Same(d, Unwrap(&tracedDriver{Driver: d}))
+	a.Same(d, Unwrap(&pooledDriver{Driver: &tracedDriver{Driver: d}}))
+	a.Same(d, Unwrap(&limitedDriver{Driver: &tracedDriver{Driver: d}}))
+}
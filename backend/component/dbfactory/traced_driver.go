@@ -0,0 +1,34 @@
+package dbfactory
+
+import (
+	"context"
+	"database/sql"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bytebase/bytebase/backend/component/tracing"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// tracedDriver wraps the database driver with spans around statement execution and queries.
+type tracedDriver struct {
+	db.Driver
+	attrs []attribute.KeyValue
+}
+
+// Execute executes the statement in a child span.
+func (d *tracedDriver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	ctx, span := tracing.Start(ctx, "db.Driver.Execute", d.attrs...)
+	rowsAffected, err := d.Driver.Execute(ctx, statement, opts)
+	tracing.End(span, err)
+	return rowsAffected, err
+}
+
+// QueryConn runs the read-only query in a child span.
+func (d *tracedDriver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	ctx, span := tracing.Start(ctx, "db.Driver.QueryConn", d.attrs...)
+	results, err := d.Driver.QueryConn(ctx, conn, statement, queryContext)
+	tracing.End(span, err)
+	return results, err
+}
@@ -0,0 +1,171 @@
+package masker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// ff1 is the FF1 format-preserving encryption defined in NIST SP 800-38G.
+// It encrypts a numeral string of the radix into another numeral string of the same length and radix.
+type ff1 struct {
+	block cipher.Block
+}
+
+func newFF1(key []byte) (*ff1, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create AES cipher")
+	}
+	return &ff1{block: block}, nil
+}
+
+// encrypt encrypts the numerals, the length of the numerals must be at least 2.
+func (f *ff1) encrypt(tweak []byte, radix int, numerals []int) []int {
+	n := len(numerals)
+	u := n / 2
+	a, b := numerals[:u], numerals[u:]
+	p := f.p(tweak, radix, n, u)
+	for i := 0; i < 10; i++ {
+		m := u
+		if i%2 == 1 {
+			m = n - u
+		}
+		y := f.round(p, tweak, radix, n, i, b)
+		c := new(big.Int).Add(num(a, radix), y)
+		c.Mod(c, pow(radix, m))
+		a, b = b, str(c, radix, m)
+	}
+	return append(append([]int{}, a...), b...)
+}
+
+// decrypt decrypts the numerals encrypted by encrypt.
+func (f *ff1) decrypt(tweak []byte, radix int, numerals []int) []int {
+	n := len(numerals)
+	u := n / 2
+	a, b := numerals[:u], numerals[u:]
+	p := f.p(tweak, radix, n, u)
+	for i := 9; i >= 0; i-- {
+		m := u
+		if i%2 == 1 {
+			m = n - u
+		}
+		y := f.round(p, tweak, radix, n, i, a)
+		c := new(big.Int).Sub(num(b, radix), y)
+		c.Mod(c, pow(radix, m))
+		a, b = str(c, radix, m), a
+	}
+	return append(append([]int{}, a...), b...)
+}
+
+// p returns the fixed block P of the round function.
+func (*ff1) p(tweak []byte, radix, n, u int) []byte {
+	p := make([]byte, aes.BlockSize)
+	p[0], p[1], p[2] = 1, 2, 1
+	p[3] = byte(radix >> 16)
+	p[4] = byte(radix >> 8)
+	p[5] = byte(radix)
+	p[6] = 10
+	p[7] = byte(u % 256)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(len(tweak)))
+	return p
+}
+
+// round returns the number y of the round i derived from the half x.
+func (f *ff1) round(p, tweak []byte, radix, n, i int, x []int) *big.Int {
+	v := n - n/2
+	// b is the number of bytes to encode the larger half.
+	b := (pow(radix, v).BitLen() + 7) / 8
+	d := 4*((b+3)/4) + 4
+
+	q := make([]byte, 0, len(tweak)+aes.BlockSize+b)
+	q = append(q, tweak...)
+	q = append(q, make([]byte, mod(-len(tweak)-b-1, aes.BlockSize))...)
+	q = append(q, byte(i))
+	q = append(q, num(x, radix).FillBytes(make([]byte, b))...)
+
+	// R = PRF(P || Q), the CBC-MAC of AES with zero IV.
+	r := make([]byte, aes.BlockSize)
+	for _, message := range [][]byte{p, q} {
+		for j := 0; j < len(message); j += aes.BlockSize {
+			for k := 0; k < aes.BlockSize; k++ {
+				r[k] ^= message[j+k]
+			}
+			f.block.Encrypt(r, r)
+		}
+	}
+
+	// S = R || CIPH(R xor [1]^16) || CIPH(R xor [2]^16) ..., truncated to d bytes.
+	s := append([]byte{}, r...)
+	for j := 1; len(s) < d; j++ {
+		block := append([]byte{}, r...)
+		counter := make([]byte, aes.BlockSize)
+		binary.BigEndian.PutUint64(counter[8:], uint64(j))
+		for k := range block {
+			block[k] ^= counter[k]
+		}
+		f.block.Encrypt(block, block)
+		s = append(s, block...)
+	}
+	return new(big.Int).SetBytes(s[:d])
+}
+
+// num returns the number of the numerals in the radix, the most significant numeral first.
+func num(numerals []int, radix int) *big.Int {
+	if radix == 256 {
+		bytes := make([]byte, len(numerals))
+		for i, numeral := range numerals {
+			bytes[i] = byte(numeral)
+		}
+		return new(big.Int).SetBytes(bytes)
+	}
+	x := new(big.Int)
+	r := big.NewInt(int64(radix))
+	for _, numeral := range numerals {
+		x.Mul(x, r)
+		x.Add(x, big.NewInt(int64(numeral)))
+	}
+	return x
+}
+
+// str returns the m numerals of the number in the radix.
+func str(x *big.Int, radix, m int) []int {
+	numerals := make([]int, m)
+	if radix == 256 {
+		for i, b := range x.FillBytes(make([]byte, m)) {
+			numerals[i] = int(b)
+		}
+		return numerals
+	}
+	x = new(big.Int).Set(x)
+	r := big.NewInt(int64(radix))
+	remainder := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		x.DivMod(x, r, remainder)
+		numerals[i] = int(remainder.Int64())
+	}
+	return numerals
+}
+
+func pow(radix, m int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(int64(radix)), big.NewInt(int64(m)), nil)
+}
+
+func mod(x, m int) int {
+	return ((x % m) + m) % m
+}
+
+// cycleWalk applies the permutation until the numerals are valid again.
+// It's a permutation on the valid numerals, which is used to encrypt the numerals in a subset of the domain.
+func cycleWalk(numerals []int, permute func([]int) []int, valid func([]int) bool) []int {
+	for {
+		numerals = permute(numerals)
+		if valid(numerals) {
+			return numerals
+		}
+	}
+}
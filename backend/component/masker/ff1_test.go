@@ -0,0 +1,66 @@
+package masker
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFF1(t *testing.T) {
+	// The samples of FF1-AES128 from NIST.
+	key, err := hex.DecodeString("2B7E151628AED2A6ABF7158809CF4F3C")
+	require.NoError(t, err)
+	testCases := []struct {
+		tweak      string
+		radix      int
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			tweak:      "",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "2433477484",
+		},
+		{
+			tweak:      "39383736353433323130",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "6124200773",
+		},
+		{
+			tweak:      "3737373770717273373737",
+			radix:      36,
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "a9tv40mll9kdu509eum",
+		},
+	}
+
+	a := require.New(t)
+	f, err := newFF1(key)
+	a.NoError(err)
+	const alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+	toNumerals := func(s string) []int {
+		var numerals []int
+		for _, c := range s {
+			numerals = append(numerals, strings.IndexRune(alphabet, c))
+		}
+		return numerals
+	}
+	toString := func(numerals []int) string {
+		var s []byte
+		for _, numeral := range numerals {
+			s = append(s, alphabet[numeral])
+		}
+		return string(s)
+	}
+	for _, tc := range testCases {
+		tweak, err := hex.DecodeString(tc.tweak)
+		a.NoError(err)
+		ciphertext := f.encrypt(tweak, tc.radix, toNumerals(tc.plaintext))
+		a.Equal(tc.ciphertext, toString(ciphertext))
+		a.Equal(tc.plaintext, toString(f.decrypt(tweak, tc.radix, ciphertext)))
+	}
+}
@@ -0,0 +1,570 @@
+package masker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ReversibleMasker is the masker whose masked data can be restored with its key.
+type ReversibleMasker interface {
+	Masker
+	Unmask(value *v1pb.RowValue) (*v1pb.RowValue, error)
+}
+
+// TokenizationMasker is the masker that replaces the data with their keyed HMAC-SHA256 tokens.
+// The same data always get the same token under the same key, so that the joins on the masked data still work.
+type TokenizationMasker struct {
+	key string
+}
+
+// NewTokenizationMasker returns a new TokenizationMasker.
+func NewTokenizationMasker(key string) *TokenizationMasker {
+	return &TokenizationMasker{
+		key: key,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *TokenizationMasker) Mask(data *MaskData) *v1pb.RowValue {
+	value := noneMask(data)
+	if v, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, v.ValueValue),
+			},
+		}
+	}
+	s, ok := rowValueToString(value)
+	if !ok {
+		return value
+	}
+	token := hex.EncodeToString(hmacSHA256(m.key, s))
+	if _, ok := value.Kind.(*v1pb.RowValue_BytesValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_BytesValue{
+				BytesValue: []byte(token),
+			},
+		}
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: token,
+		},
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *TokenizationMasker) Equal(other Masker) bool {
+	if otherTokenizationMasker, ok := other.(*TokenizationMasker); ok {
+		return m.key == otherTokenizationMasker.key
+	}
+	return false
+}
+
+const (
+	fpeDigits = "0123456789"
+	fpeLower  = "abcdefghijklmnopqrstuvwxyz"
+	fpeUpper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// fpeAlphabets are the character classes encrypted by FPEMasker, the characters are encrypted within their classes.
+// The index of the alphabet is used as the FF1 tweak, so that the classes are encrypted independently.
+var fpeAlphabets = []string{fpeDigits, fpeLower, fpeUpper}
+
+// FPEMasker is the masker that encrypts the data with FF1 format-preserving encryption.
+//
+// The digits, lower and upper case letters of the strings are encrypted within their own classes,
+// and the other characters and the domain of email addresses are kept,
+// so that the masked phone numbers are still phone numbers and the masked emails are still emails.
+// The integers are encrypted into the integers with the same number of digits.
+// The floating-point numbers are encrypted in their decimal representations and returned as strings.
+type FPEMasker struct {
+	key    string
+	cipher *ff1
+}
+
+// NewFPEMasker returns a new FPEMasker, the AES-256 key is derived from the SHA-256 hash of the key.
+func NewFPEMasker(key string) *FPEMasker {
+	hash := sha256.Sum256([]byte(key))
+	// The AES cipher never fails with a 32 bytes key.
+	cipher, _ := newFF1(hash[:])
+	return &FPEMasker{
+		key:    key,
+		cipher: cipher,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *FPEMasker) Mask(data *MaskData) *v1pb.RowValue {
+	value := noneMask(data)
+	if v, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, v.ValueValue),
+			},
+		}
+	}
+	result, err := m.transform(value, m.cipher.encrypt)
+	if err != nil {
+		return fullMaskRowValue(value)
+	}
+	return result
+}
+
+// Unmask implements ReversibleMasker.Unmask.
+func (m *FPEMasker) Unmask(value *v1pb.RowValue) (*v1pb.RowValue, error) {
+	return m.transform(value, m.cipher.decrypt)
+}
+
+func (m *FPEMasker) transform(value *v1pb.RowValue, permute func(tweak []byte, radix int, numerals []int) []int) (*v1pb.RowValue, error) {
+	switch kind := value.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return value, nil
+	case *v1pb.RowValue_BoolValue:
+		return nil, errors.New("boolean values are not supported by format-preserving encryption")
+	case *v1pb.RowValue_BytesValue:
+		numerals := make([]int, len(kind.BytesValue))
+		for i, b := range kind.BytesValue {
+			numerals[i] = int(b)
+		}
+		bytes := make([]byte, len(numerals))
+		for i, numeral := range fpePermute(numerals, 256, nil, permute) {
+			bytes[i] = byte(numeral)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: bytes}}, nil
+	case *v1pb.RowValue_StringValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: fpeString(kind.StringValue, permute)}}, nil
+	case *v1pb.RowValue_DoubleValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: fpeString(strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), permute)}}, nil
+	case *v1pb.RowValue_FloatValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: fpeString(strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32), permute)}}, nil
+	case *v1pb.RowValue_Int32Value:
+		v := fpeInteger(int64(kind.Int32Value), math.MinInt32, math.MaxInt32, permute)
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v)}}, nil
+	case *v1pb.RowValue_Int64Value:
+		v := fpeInteger(kind.Int64Value, math.MinInt64, math.MaxInt64, permute)
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}, nil
+	case *v1pb.RowValue_Uint32Value:
+		digits := fpeDigitString(strconv.FormatUint(uint64(kind.Uint32Value), 10), strconv.FormatUint(math.MaxUint32, 10), permute)
+		v, err := strconv.ParseUint(digits, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v)}}, nil
+	case *v1pb.RowValue_Uint64Value:
+		digits := fpeDigitString(strconv.FormatUint(kind.Uint64Value, 10), strconv.FormatUint(math.MaxUint64, 10), permute)
+		v, err := strconv.ParseUint(digits, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: v}}, nil
+	default:
+		return nil, errors.Errorf("unsupported value type %T", value.Kind)
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *FPEMasker) Equal(other Masker) bool {
+	if otherFPEMasker, ok := other.(*FPEMasker); ok {
+		return m.key == otherFPEMasker.key
+	}
+	return false
+}
+
+// fpeString encrypts the characters of the string within their classes, the domain of email addresses is kept.
+func fpeString(s string, permute func(tweak []byte, radix int, numerals []int) []int) string {
+	runes := []rune(s)
+	end := len(runes)
+	if at := strings.LastIndex(s, "@"); at > 0 {
+		end = len([]rune(s[:at]))
+	}
+	for i, alphabet := range fpeAlphabets {
+		var positions, numerals []int
+		for j := 0; j < end; j++ {
+			if index := strings.IndexRune(alphabet, runes[j]); index >= 0 {
+				positions = append(positions, j)
+				numerals = append(numerals, index)
+			}
+		}
+		if len(numerals) == 0 {
+			continue
+		}
+		for j, numeral := range fpePermute(numerals, len(alphabet), []byte{byte(i)}, permute) {
+			runes[positions[j]] = rune(alphabet[numeral])
+		}
+	}
+	return string(runes)
+}
+
+// fpeInteger encrypts the integer into an integer in [minValue, maxValue] with the same sign and number of digits.
+func fpeInteger(v, minValue, maxValue int64, permute func(tweak []byte, radix int, numerals []int) []int) int64 {
+	if v < 0 {
+		// The absolute value of math.MinInt64 overflows int64, so the digits are handled in strings.
+		digits := fpeDigitString(strings.TrimPrefix(strconv.FormatInt(v, 10), "-"), strings.TrimPrefix(strconv.FormatInt(minValue, 10), "-"), permute)
+		result, _ := strconv.ParseInt("-"+digits, 10, 64)
+		return result
+	}
+	digits := fpeDigitString(strconv.FormatInt(v, 10), strconv.FormatInt(maxValue, 10), permute)
+	result, _ := strconv.ParseInt(digits, 10, 64)
+	return result
+}
+
+// fpeDigitString encrypts the decimal digits without leading zeros into the digits of the same length,
+// which are not greater than the maximum.
+func fpeDigitString(digits string, maximum string, permute func(tweak []byte, radix int, numerals []int) []int) string {
+	numerals := make([]int, len(digits))
+	for i, c := range digits {
+		numerals[i] = int(c - '0')
+	}
+	valid := func(numerals []int) bool {
+		if len(numerals) > 1 && numerals[0] == 0 {
+			return false
+		}
+		if len(numerals) < len(maximum) {
+			return true
+		}
+		for i, numeral := range numerals {
+			if d := int(maximum[i] - '0'); numeral != d {
+				return numeral < d
+			}
+		}
+		return true
+	}
+	var b strings.Builder
+	for _, numeral := range fpePermute(numerals, 10, []byte("integer"), permute, valid) {
+		b.WriteByte(byte('0' + numeral))
+	}
+	return b.String()
+}
+
+// fpePermute permutes the numerals with cycle walking on the valid numerals.
+// FF1 requires at least 2 numerals, so a single numeral is encrypted as 2 numerals with the leading zero.
+func fpePermute(numerals []int, radix int, tweak []byte, permute func(tweak []byte, radix int, numerals []int) []int, valid ...func([]int) bool) []int {
+	if len(numerals) == 0 {
+		return numerals
+	}
+	isValid := func(x []int) bool {
+		for _, f := range valid {
+			if !f(x) {
+				return false
+			}
+		}
+		return true
+	}
+	if len(numerals) == 1 {
+		result := cycleWalk([]int{0, numerals[0]}, func(x []int) []int {
+			return permute(tweak, radix, x)
+		}, func(x []int) bool {
+			return x[0] == 0 && isValid(x[1:])
+		})
+		return result[1:]
+	}
+	return cycleWalk(numerals, func(x []int) []int {
+		return permute(tweak, radix, x)
+	}, isValid)
+}
+
+// DateShiftMasker is the masker that shifts the dates by a number of days derived from the key.
+// The intervals between the dates are kept, so that the masked data are still useful for the analysis.
+//
+// The strings starting with the dates in the format of YYYY-MM-DD or YYYY/MM/DD are shifted with the rest kept,
+// and the numbers are regarded as Unix timestamps in seconds.
+type DateShiftMasker struct {
+	key          string
+	maxShiftDays int32
+	offset       int
+}
+
+// NewDateShiftMasker returns a new DateShiftMasker.
+func NewDateShiftMasker(key string, maxShiftDays int32) *DateShiftMasker {
+	return &DateShiftMasker{
+		key:          key,
+		maxShiftDays: maxShiftDays,
+		offset:       dateShiftOffset(key, maxShiftDays),
+	}
+}
+
+// dateShiftOffset returns the non-zero number of days in [-maxShiftDays, maxShiftDays] derived from the key.
+func dateShiftOffset(key string, maxShiftDays int32) int {
+	if maxShiftDays <= 0 {
+		maxShiftDays = 1
+	}
+	hash := hmacSHA256(key, "date-shift")
+	offset := int(binary.BigEndian.Uint64(hash[:8])%uint64(maxShiftDays)) + 1
+	if hash[8]&1 == 1 {
+		offset = -offset
+	}
+	return offset
+}
+
+var datePrefixRegexp = regexp.MustCompile(`^(\d{4})([-/])(\d{2})([-/])(\d{2})`)
+
+// Mask implements Masker.Mask.
+func (m *DateShiftMasker) Mask(data *MaskData) *v1pb.RowValue {
+	value := noneMask(data)
+	if v, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, v.ValueValue),
+			},
+		}
+	}
+	result, err := m.shift(value, m.offset)
+	if err != nil {
+		return fullMaskRowValue(value)
+	}
+	return result
+}
+
+// Unmask implements ReversibleMasker.Unmask.
+func (m *DateShiftMasker) Unmask(value *v1pb.RowValue) (*v1pb.RowValue, error) {
+	return m.shift(value, -m.offset)
+}
+
+func (*DateShiftMasker) shift(value *v1pb.RowValue, days int) (*v1pb.RowValue, error) {
+	seconds := int64(days) * 24 * 60 * 60
+	switch kind := value.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return value, nil
+	case *v1pb.RowValue_BytesValue:
+		s, err := shiftDateString(string(kind.BytesValue), days)
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte(s)}}, nil
+	case *v1pb.RowValue_StringValue:
+		s, err := shiftDateString(kind.StringValue, days)
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}, nil
+	case *v1pb.RowValue_DoubleValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: kind.DoubleValue + float64(seconds)}}, nil
+	case *v1pb.RowValue_FloatValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_FloatValue{FloatValue: kind.FloatValue + float32(seconds)}}, nil
+	case *v1pb.RowValue_Int32Value:
+		v := int64(kind.Int32Value) + seconds
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return nil, errors.Errorf("the shifted timestamp %d overflows int32", v)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v)}}, nil
+	case *v1pb.RowValue_Int64Value:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: kind.Int64Value + seconds}}, nil
+	case *v1pb.RowValue_Uint32Value:
+		v := int64(kind.Uint32Value) + seconds
+		if v < 0 || v > math.MaxUint32 {
+			return nil, errors.Errorf("the shifted timestamp %d overflows uint32", v)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v)}}, nil
+	case *v1pb.RowValue_Uint64Value:
+		if seconds < 0 && kind.Uint64Value < uint64(-seconds) {
+			return nil, errors.Errorf("the shifted timestamp of %d is negative", kind.Uint64Value)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: kind.Uint64Value + uint64(seconds)}}, nil
+	default:
+		return nil, errors.Errorf("unsupported value type %T", value.Kind)
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *DateShiftMasker) Equal(other Masker) bool {
+	if otherDateShiftMasker, ok := other.(*DateShiftMasker); ok {
+		return m.key == otherDateShiftMasker.key && m.maxShiftDays == otherDateShiftMasker.maxShiftDays
+	}
+	return false
+}
+
+// shiftDateString shifts the date at the beginning of the string, the time and the time zone are kept.
+func shiftDateString(s string, days int) (string, error) {
+	matches := datePrefixRegexp.FindStringSubmatch(s)
+	if matches == nil || matches[2] != matches[4] {
+		return "", errors.Errorf("%q is not a date", s)
+	}
+	date, err := time.Parse("2006-01-02", strings.Join([]string{matches[1], matches[3], matches[5]}, "-"))
+	if err != nil {
+		return "", errors.Wrapf(err, "%q is not a date", s)
+	}
+	layout := strings.ReplaceAll("2006-01-02", "-", matches[2])
+	return date.AddDate(0, 0, days).Format(layout) + s[len(matches[0]):], nil
+}
+
+// NumericPerturbationMasker is the masker that adds the bounded noise to the numbers.
+// The noise is derived from the key and the value, so that the same value always gets the same noise.
+//
+// The strings are perturbed if they are numbers, and the number of decimal places is kept.
+type NumericPerturbationMasker struct {
+	key        string
+	noiseRatio float64
+}
+
+// NewNumericPerturbationMasker returns a new NumericPerturbationMasker.
+func NewNumericPerturbationMasker(key string, noiseRatio float64) *NumericPerturbationMasker {
+	return &NumericPerturbationMasker{
+		key:        key,
+		noiseRatio: noiseRatio,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *NumericPerturbationMasker) Mask(data *MaskData) *v1pb.RowValue {
+	value := noneMask(data)
+	switch kind := value.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return value
+	case *v1pb.RowValue_BytesValue:
+		s, ok := m.perturbString(string(kind.BytesValue))
+		if !ok {
+			return fullMaskRowValue(value)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte(s)}}
+	case *v1pb.RowValue_StringValue:
+		s, ok := m.perturbString(kind.StringValue)
+		if !ok {
+			return fullMaskRowValue(value)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	case *v1pb.RowValue_DoubleValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: m.perturb(kind.DoubleValue, strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64))}}
+	case *v1pb.RowValue_FloatValue:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_FloatValue{FloatValue: float32(m.perturb(float64(kind.FloatValue), strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32)))}}
+	case *v1pb.RowValue_Int32Value:
+		v := m.perturb(float64(kind.Int32Value), strconv.FormatInt(int64(kind.Int32Value), 10))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(clamp(math.Round(v), math.MinInt32, math.MaxInt32))}}
+	case *v1pb.RowValue_Int64Value:
+		v := m.perturb(float64(kind.Int64Value), strconv.FormatInt(kind.Int64Value, 10))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: clampInt64(math.Round(v))}}
+	case *v1pb.RowValue_Uint32Value:
+		v := m.perturb(float64(kind.Uint32Value), strconv.FormatUint(uint64(kind.Uint32Value), 10))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(clamp(math.Round(v), 0, math.MaxUint32))}}
+	case *v1pb.RowValue_Uint64Value:
+		v := m.perturb(float64(kind.Uint64Value), strconv.FormatUint(kind.Uint64Value, 10))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: clampUint64(math.Round(v))}}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	default:
+		return fullMaskRowValue(value)
+	}
+}
+
+// perturb adds the noise in [-noiseRatio*|v|, noiseRatio*|v|] derived from the text of the value.
+func (m *NumericPerturbationMasker) perturb(v float64, text string) float64 {
+	hash := hmacSHA256(m.key, text)
+	// u is uniformly distributed in [-1, 1].
+	u := float64(binary.BigEndian.Uint64(hash[:8]))/float64(math.MaxUint64)*2 - 1
+	return v + math.Abs(v)*m.noiseRatio*u
+}
+
+func (m *NumericPerturbationMasker) perturbString(s string) (string, bool) {
+	text := strings.TrimSpace(s)
+	if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return strconv.FormatInt(clampInt64(math.Round(m.perturb(float64(i), text))), 10), true
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", false
+	}
+	precision := -1
+	if dot := strings.Index(text, "."); dot >= 0 && !strings.ContainsAny(text, "eE") {
+		precision = len(text) - dot - 1
+	}
+	return strconv.FormatFloat(m.perturb(f, text), 'f', precision, 64), true
+}
+
+// Equal implements Masker.Equal.
+func (m *NumericPerturbationMasker) Equal(other Masker) bool {
+	if otherNumericPerturbationMasker, ok := other.(*NumericPerturbationMasker); ok {
+		return m.key == otherNumericPerturbationMasker.key && m.noiseRatio == otherNumericPerturbationMasker.noiseRatio
+	}
+	return false
+}
+
+func hmacSHA256(key, s string) []byte {
+	h := hmac.New(sha256.New, []byte(key))
+	_, _ = h.Write([]byte(s))
+	return h.Sum(nil)
+}
+
+// rowValueToString returns the text of the scalar value, it returns false for the null value.
+func rowValueToString(value *v1pb.RowValue) (string, bool) {
+	switch kind := value.Kind.(type) {
+	case *v1pb.RowValue_BoolValue:
+		return strconv.FormatBool(kind.BoolValue), true
+	case *v1pb.RowValue_BytesValue:
+		return string(kind.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	default:
+		return "", false
+	}
+}
+
+// fullMaskRowValue returns the full masked value for the value that cannot be masked by the algorithm.
+func fullMaskRowValue(value *v1pb.RowValue) *v1pb.RowValue {
+	if _, ok := value.Kind.(*v1pb.RowValue_NullValue); ok {
+		return value
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: "******",
+		},
+	}
+}
+
+func clamp(v, minValue, maxValue float64) float64 {
+	return max(minValue, min(maxValue, v))
+}
+
+// clampInt64 converts the float to int64, the float64(math.MaxInt64) is rounded up to 2^63 which overflows int64.
+func clampInt64(v float64) int64 {
+	if v >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	if v <= math.MinInt64 {
+		return math.MinInt64
+	}
+	return int64(v)
+}
+
+func clampUint64(v float64) uint64 {
+	if v >= math.MaxUint64 {
+		return math.MaxUint64
+	}
+	if v <= 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
+var (
+	_ ReversibleMasker = (*FPEMasker)(nil)
+	_ ReversibleMasker = (*DateShiftMasker)(nil)
+	_ Masker           = (*TokenizationMasker)(nil)
+	_ Masker           = (*NumericPerturbationMasker)(nil)
+)
@@ -704,80 +704,86 @@ func (m *MD5Masker) Equal(other Masker) bool {
 	return false
 }
 
+// maskProtoValue masks the scalar values in the structpb.Value recursively.
 func maskProtoValue(m Masker, value *structpb.Value) *structpb.Value {
+	var rowValue *v1pb.RowValue
 	switch kindValue := value.Kind.(type) {
 	case *structpb.Value_NullValue:
-		nullValue := m.Mask(&MaskData{
-			DataV2: &v1pb.RowValue{
-				Kind: &v1pb.RowValue_NullValue{
-					NullValue: kindValue.NullValue,
-				},
-			},
-		}).GetNullValue()
-		return &structpb.Value{
-			Kind: &structpb.Value_NullValue{
-				NullValue: nullValue,
+		rowValue = &v1pb.RowValue{
+			Kind: &v1pb.RowValue_NullValue{
+				NullValue: kindValue.NullValue,
 			},
 		}
 	case *structpb.Value_NumberValue:
-		numberValue := m.Mask(&MaskData{
-			DataV2: &v1pb.RowValue{
-				Kind: &v1pb.RowValue_DoubleValue{
-					DoubleValue: kindValue.NumberValue,
-				},
-			},
-		}).GetDoubleValue()
-		return &structpb.Value{
-			Kind: &structpb.Value_NumberValue{
-				NumberValue: numberValue,
+		rowValue = &v1pb.RowValue{
+			Kind: &v1pb.RowValue_DoubleValue{
+				DoubleValue: kindValue.NumberValue,
 			},
 		}
 	case *structpb.Value_StringValue:
-		stringValue := m.Mask(&MaskData{
-			DataV2: &v1pb.RowValue{
-				Kind: &v1pb.RowValue_StringValue{
-					StringValue: kindValue.StringValue,
-				},
-			},
-		}).GetStringValue()
-		return &structpb.Value{
-			Kind: &structpb.Value_StringValue{
-				StringValue: stringValue,
+		rowValue = &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: kindValue.StringValue,
 			},
 		}
 	case *structpb.Value_BoolValue:
-		boolValue := m.Mask(&MaskData{
-			DataV2: &v1pb.RowValue{
-				Kind: &v1pb.RowValue_BoolValue{
-					BoolValue: kindValue.BoolValue,
-				},
+		rowValue = &v1pb.RowValue{
+			Kind: &v1pb.RowValue_BoolValue{
+				BoolValue: kindValue.BoolValue,
 			},
-		}).GetBoolValue()
+		}
+	case *structpb.Value_StructValue:
+		fields := make(map[string]*structpb.Value, len(kindValue.StructValue.GetFields()))
+		for field, value := range kindValue.StructValue.GetFields() {
+			fields[field] = maskProtoValue(m, value)
+		}
 		return &structpb.Value{
-			Kind: &structpb.Value_BoolValue{
-				BoolValue: boolValue,
+			Kind: &structpb.Value_StructValue{
+				StructValue: &structpb.Struct{Fields: fields},
 			},
 		}
-	case *structpb.Value_StructValue:
-		//nolint
-		v := proto.Clone(value).(*structpb.Value)
-		//nolint
-		f := v.Kind.(*structpb.Value_StructValue)
-		for field, value := range f.StructValue.Fields {
-			kindValue.StructValue.Fields[field] = maskProtoValue(m, value)
-		}
-		return v
 	case *structpb.Value_ListValue:
-		//nolint
-		v := proto.Clone(value).(*structpb.Value)
-		//nolint
-		l := v.Kind.(*structpb.Value_ListValue)
-		for i, value := range l.ListValue.Values {
-			kindValue.ListValue.Values[i] = maskProtoValue(m, value)
+		values := make([]*structpb.Value, len(kindValue.ListValue.GetValues()))
+		for i, value := range kindValue.ListValue.GetValues() {
+			values[i] = maskProtoValue(m, value)
 		}
-		return v
+		return &structpb.Value{
+			Kind: &structpb.Value_ListValue{
+				ListValue: &structpb.ListValue{Values: values},
+			},
+		}
+	default:
+		return nil
+	}
+	return convertRowValueToProtoValue(m.Mask(&MaskData{DataV2: rowValue}))
+}
+
+// convertRowValueToProtoValue converts the masked value to structpb.Value, the masked value may be in a different type.
+func convertRowValueToProtoValue(value *v1pb.RowValue) *structpb.Value {
+	switch kind := value.GetKind().(type) {
+	case *v1pb.RowValue_BoolValue:
+		return structpb.NewBoolValue(kind.BoolValue)
+	case *v1pb.RowValue_BytesValue:
+		return structpb.NewStringValue(string(kind.BytesValue))
+	case *v1pb.RowValue_DoubleValue:
+		return structpb.NewNumberValue(kind.DoubleValue)
+	case *v1pb.RowValue_FloatValue:
+		return structpb.NewNumberValue(float64(kind.FloatValue))
+	case *v1pb.RowValue_Int32Value:
+		return structpb.NewNumberValue(float64(kind.Int32Value))
+	case *v1pb.RowValue_Int64Value:
+		return structpb.NewNumberValue(float64(kind.Int64Value))
+	case *v1pb.RowValue_StringValue:
+		return structpb.NewStringValue(kind.StringValue)
+	case *v1pb.RowValue_Uint32Value:
+		return structpb.NewNumberValue(float64(kind.Uint32Value))
+	case *v1pb.RowValue_Uint64Value:
+		return structpb.NewNumberValue(float64(kind.Uint64Value))
+	case *v1pb.RowValue_ValueValue:
+		return kind.ValueValue
+	default:
+		return structpb.NewNullValue()
 	}
-	return nil
 }
 
 func NewInnerOuterMasker(storeMaskerType storepb.MaskingAlgorithmSetting_Algorithm_InnerOuterMask_MaskType, prefixLen, suffixLen int32, substitution string) *InnerOuterMasker {
@@ -2,9 +2,14 @@ package masker
 
 import (
 	"database/sql"
+	"math"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -321,3 +326,156 @@ func TestInnerOuterMask(t *testing.T) {
 		a.Equal(tc.want, got)
 	}
 }
+
+func TestTokenizationMask(t *testing.T) {
+	a := require.New(t)
+	m := NewTokenizationMasker("secret")
+	alice := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "alice@example.com"}}})
+	a.Len(alice.GetStringValue(), 64)
+	a.Equal(alice.GetStringValue(), m.Mask(&MaskData{Data: &sql.NullString{String: "alice@example.com", Valid: true}}).GetStringValue())
+	a.NotEqual(alice.GetStringValue(), NewTokenizationMasker("another").Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "alice@example.com"}}}).GetStringValue())
+	// The same number in different types gets the same token.
+	a.Equal(
+		m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 42}}}).GetStringValue(),
+		m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 42}}}).GetStringValue(),
+	)
+	a.IsType(&v1pb.RowValue_NullValue{}, m.Mask(&MaskData{Data: &sql.NullString{}}).Kind)
+}
+
+func TestFPEMask(t *testing.T) {
+	a := require.New(t)
+	m := NewFPEMasker("secret")
+	testCases := []*v1pb.RowValue{
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "alice.smith42@example.com"}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "+1 (555) 010-9999"}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "A"}},
+		{Kind: &v1pb.RowValue_StringValue{StringValue: "你好 Bob"}},
+		{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte{0x00, 0xff, 0x10}}},
+		{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte{0x7}}},
+		{Kind: &v1pb.RowValue_Int32Value{Int32Value: 7}},
+		{Kind: &v1pb.RowValue_Int32Value{Int32Value: math.MinInt32}},
+		{Kind: &v1pb.RowValue_Int64Value{Int64Value: 123456789}},
+		{Kind: &v1pb.RowValue_Int64Value{Int64Value: math.MaxInt64}},
+		{Kind: &v1pb.RowValue_Int64Value{Int64Value: -9000}},
+		{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: math.MaxUint32}},
+		{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 10}},
+		{Kind: &v1pb.RowValue_NullValue{}},
+	}
+	for _, value := range testCases {
+		masked := m.Mask(&MaskData{DataV2: value})
+		a.IsType(value.Kind, masked.Kind)
+		if _, ok := value.Kind.(*v1pb.RowValue_NullValue); !ok {
+			a.False(proto.Equal(value, masked))
+		}
+		unmasked, err := m.Unmask(masked)
+		a.NoError(err)
+		a.True(proto.Equal(value, unmasked), "%v", value)
+	}
+
+	email := m.Mask(&MaskData{DataV2: testCases[0]}).GetStringValue()
+	a.Regexp(`^[a-z]{5}\.[a-z]{5}[0-9]{2}@example\.com$`, email)
+	a.Regexp(`^\+[0-9] \([0-9]{3}\) [0-9]{3}-[0-9]{4}$`, m.Mask(&MaskData{DataV2: testCases[1]}).GetStringValue())
+	a.Len(strconv.FormatInt(m.Mask(&MaskData{DataV2: testCases[8]}).GetInt64Value(), 10), 9)
+	a.Less(m.Mask(&MaskData{DataV2: testCases[10]}).GetInt64Value(), int64(-999))
+
+	// The floating-point numbers are returned as strings.
+	masked := m.Mask(&MaskData{Data: &sql.NullFloat64{Float64: -12.5, Valid: true}})
+	a.Regexp(`^-[0-9]{2}\.[0-9]$`, masked.GetStringValue())
+	unmasked, err := m.Unmask(masked)
+	a.NoError(err)
+	a.Equal("-12.5", unmasked.GetStringValue())
+}
+
+func TestDateShiftMask(t *testing.T) {
+	a := require.New(t)
+	m := NewDateShiftMasker("secret", 30)
+	a.NotZero(m.offset)
+	a.LessOrEqual(m.offset, 30)
+	a.GreaterOrEqual(m.offset, -30)
+
+	testCases := []struct {
+		input *v1pb.RowValue
+		want  *v1pb.RowValue
+	}{
+		{
+			input: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-02-28 13:45:00.123+08"}},
+			want:  &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC).AddDate(0, 0, m.offset).Format("2006-01-02") + " 13:45:00.123+08"}},
+		},
+		{
+			input: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024/01/01"}},
+			want:  &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, m.offset).Format("2006/01/02")}},
+		},
+		{
+			input: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1700000000}},
+			want:  &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1700000000 + int64(m.offset)*86400}},
+		},
+		{
+			input: &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}},
+			want:  &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}},
+		},
+	}
+	for _, tc := range testCases {
+		masked := m.Mask(&MaskData{DataV2: tc.input})
+		a.True(proto.Equal(tc.want, masked), "%v", masked)
+		unmasked, err := m.Unmask(masked)
+		a.NoError(err)
+		a.True(proto.Equal(tc.input, unmasked), "%v", unmasked)
+	}
+
+	// The values that are not dates are fully masked.
+	a.Equal("******", m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "tomorrow"}}}).GetStringValue())
+	a.Equal("******", m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}}}).GetStringValue())
+}
+
+func TestNumericPerturbationMask(t *testing.T) {
+	a := require.New(t)
+	m := NewNumericPerturbationMasker("secret", 0.1)
+
+	for _, v := range []int64{1000, -1000, 987654321} {
+		masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}}).GetInt64Value()
+		a.InDelta(v, masked, math.Abs(float64(v))*0.1)
+		a.Equal(masked, m.Mask(&MaskData{Data: &sql.NullInt64{Int64: v, Valid: true}}).GetInt64Value())
+	}
+	masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 12.5}}})
+	a.InDelta(12.5, masked.GetDoubleValue(), 1.25)
+	a.Equal(uint32(0), m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: 0}}}).GetUint32Value())
+
+	// The decimal places of the strings are kept.
+	s := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "1999.90"}}}).GetStringValue()
+	a.Regexp(`^[0-9]+\.[0-9]{2}$`, s)
+	f, err := strconv.ParseFloat(s, 64)
+	a.NoError(err)
+	a.InDelta(1999.90, f, 199.99)
+	a.Equal("******", m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "n/a"}}}).GetStringValue())
+}
+
+func TestMaskProtoValue(t *testing.T) {
+	a := require.New(t)
+	value, err := structpb.NewValue(map[string]any{
+		"name":   "alice",
+		"age":    30,
+		"admin":  true,
+		"phones": []any{"555-0100", nil},
+	})
+	a.NoError(err)
+	original := proto.Clone(value)
+
+	m := NewTokenizationMasker("secret")
+	token := func(s string) string {
+		return m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}}).GetStringValue()
+	}
+	masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: value}}})
+	want, err := structpb.NewValue(map[string]any{
+		"name":   token("alice"),
+		"age":    token("30"),
+		"admin":  token("true"),
+		"phones": []any{token("555-0100"), nil},
+	})
+	a.NoError(err)
+	a.True(proto.Equal(want, masked.GetValueValue()))
+	// The original value is not modified.
+	a.True(proto.Equal(original, value))
+
+	masked = NewNumericPerturbationMasker("secret", 0.5).Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: value}}})
+	a.InDelta(30, masked.GetValueValue().GetStructValue().Fields["age"].GetNumberValue(), 15)
+}
@@ -0,0 +1,159 @@
+// Package metrics exposes the operational Prometheus metrics of Bytebase itself.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const namespace = "bytebase"
+
+// Outcome labels shared by the duration metrics.
+const (
+	OutcomeDone     = "done"
+	OutcomeFailed   = "failed"
+	OutcomeCanceled = "canceled"
+)
+
+var (
+	// registry is kept separate from the default registerer so that the operational metrics
+	// are not mixed with the echo request metrics served on the API port.
+	registry = prometheus.NewRegistry()
+
+	taskRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_run_duration_seconds",
+		Help:      "Duration of task runs by task type, engine and outcome.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600, 4 * 3600},
+	}, []string{"task_type", "engine", "outcome"})
+
+	planCheckRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "plan_check_run_duration_seconds",
+		Help:      "Duration of plan check runs by check type, engine and outcome.",
+		Buckets:   []float64{0.05, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+	}, []string{"check_type", "engine", "outcome"})
+
+	instanceLastSyncTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "instance_last_sync_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful schema sync of the instance.",
+	}, []string{"instance", "engine"})
+
+	instanceSyncFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "instance_sync_failures_total",
+		Help:      "Number of failed schema syncs of the instance.",
+	}, []string{"instance", "engine"})
+
+	instanceOutstandingConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "instance_outstanding_connections",
+		Help:      "Number of outstanding connections held by task runs and plan checks per instance.",
+	}, []string{"instance_uid"})
+
+	instanceMaximumConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "instance_maximum_connections",
+		Help:      "Maximum number of outstanding connections allowed per instance.",
+	}, []string{"instance_uid"})
+
+	webhookDeliveryFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_delivery_failures_total",
+		Help:      "Number of project webhook deliveries that failed after retries.",
+	}, []string{"webhook_type"})
+
+	queries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queries_total",
+		Help:      "Number of SQL editor queries by engine and outcome.",
+	}, []string{"engine", "outcome"})
+
+	activeAnomalies = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_anomalies",
+		Help:      "Number of active anomalies by type.",
+	}, []string{"type"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		taskRunDuration,
+		planCheckRunDuration,
+		instanceLastSyncTimestamp,
+		instanceSyncFailures,
+		instanceOutstandingConnections,
+		instanceMaximumConnections,
+		webhookDeliveryFailures,
+		queries,
+		activeAnomalies,
+	)
+}
+
+// Handler returns the HTTP handler serving the operational metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// ObserveTaskRun records the duration and outcome of a task run.
+func ObserveTaskRun(taskType string, engine storepb.Engine, outcome string, duration time.Duration) {
+	taskRunDuration.WithLabelValues(taskType, engine.String(), outcome).Observe(duration.Seconds())
+}
+
+// ObservePlanCheckRun records the duration and outcome of a plan check run.
+func ObservePlanCheckRun(checkType string, engine storepb.Engine, outcome string, duration time.Duration) {
+	planCheckRunDuration.WithLabelValues(checkType, engine.String(), outcome).Observe(duration.Seconds())
+}
+
+// ObserveInstanceSync records the result of an instance schema sync.
+func ObserveInstanceSync(instance string, engine storepb.Engine, syncErr error) {
+	if syncErr != nil {
+		instanceSyncFailures.WithLabelValues(instance, engine.String()).Inc()
+		return
+	}
+	instanceLastSyncTimestamp.WithLabelValues(instance, engine.String()).SetToCurrentTime()
+}
+
+// SetInstanceConnections records the outstanding and maximum connections of an instance.
+func SetInstanceConnections(instanceUID int, outstanding, maximum int) {
+	uid := strconv.Itoa(instanceUID)
+	instanceOutstandingConnections.WithLabelValues(uid).Set(float64(outstanding))
+	instanceMaximumConnections.WithLabelValues(uid).Set(float64(maximum))
+}
+
+// SetInstanceOutstandingConnections records the outstanding connections of an instance.
+func SetInstanceOutstandingConnections(instanceUID int, outstanding int) {
+	instanceOutstandingConnections.WithLabelValues(strconv.Itoa(instanceUID)).Set(float64(outstanding))
+}
+
+// IncWebhookDeliveryFailure records a failed webhook delivery.
+func IncWebhookDeliveryFailure(webhookType string) {
+	webhookDeliveryFailures.WithLabelValues(webhookType).Inc()
+}
+
+// IncQuery records a SQL editor query.
+func IncQuery(engine storepb.Engine, queryErr error) {
+	outcome := OutcomeDone
+	if queryErr != nil {
+		outcome = OutcomeFailed
+	}
+	queries.WithLabelValues(engine.String(), outcome).Inc()
+}
+
+// SetActiveAnomalies replaces the active anomaly counts with the given counts by type.
+func SetActiveAnomalies(counts map[string]int) {
+	activeAnomalies.Reset()
+	for tp, count := range counts {
+		activeAnomalies.WithLabelValues(tp).Set(float64(count))
+	}
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestHandler(t *testing.T) {
+	a := require.New(t)
+
+	ObserveTaskRun("bb.task.database.schema.update", storepb.Engine_MYSQL, OutcomeDone, 2*time.Second)
+	ObserveInstanceSync("prod-mysql", storepb.Engine_MYSQL, errors.New("connection refused"))
+	SetInstanceConnections(101, 3, 10)
+	SetActiveAnomalies(map[string]int{"bb.anomaly.database.schema.drift": 2})
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(recorder.Body)
+	a.NoError(err)
+
+	for _, want := range []string{
+		`bytebase_task_run_duration_seconds_count{engine="MYSQL",outcome="done",task_type="bb.task.database.schema.update"} 1`,
+		`bytebase_instance_sync_failures_total{engine="MYSQL",instance="prod-mysql"} 1`,
+		`bytebase_instance_outstanding_connections{instance_uid="101"} 3`,
+		`bytebase_instance_maximum_connections{instance_uid="101"} 10`,
+		`bytebase_active_anomalies{type="bb.anomaly.database.schema.drift"} 2`,
+		`go_goroutines`,
+	} {
+		a.Contains(string(body), want)
+	}
+}
@@ -0,0 +1,316 @@
+package queryguard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+// parseMySQLPlan parses the plan from EXPLAIN FORMAT=JSON.
+//
+//	{"query_block": {"cost_info": {"query_cost": "1.20"}, "table": {"table_name": "t", "access_type": "ALL", "rows_examined_per_scan": 10}}}
+//
+// MariaDB reports "rows" instead of "rows_examined_per_scan" and has no cost info.
+func parseMySQLPlan(plan string) (*Estimate, error) {
+	var root map[string]any
+	if err := json.Unmarshal([]byte(plan), &root); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal MySQL plan")
+	}
+	estimate := &Estimate{}
+	if queryBlock, ok := root["query_block"].(map[string]any); ok {
+		if costInfo, ok := queryBlock["cost_info"].(map[string]any); ok {
+			estimate.Cost = jsonNumber(costInfo["query_cost"])
+		}
+	}
+	walkJSON(root, func(node map[string]any) {
+		tableName, ok := node["table_name"].(string)
+		if !ok {
+			return
+		}
+		rows := int64(jsonNumber(node["rows_examined_per_scan"]))
+		if rows == 0 {
+			rows = int64(jsonNumber(node["rows"]))
+		}
+		rows = max(rows, int64(jsonNumber(node["rows_produced_per_join"])))
+		estimate.Rows = max(estimate.Rows, rows)
+		if node["access_type"] == "ALL" {
+			estimate.FullScans = append(estimate.FullScans, &FullScan{Table: tableName, Rows: rows})
+		}
+	})
+	return estimate, nil
+}
+
+// parsePostgresPlan parses the plan from EXPLAIN (FORMAT JSON).
+//
+//	[{"Plan": {"Node Type": "Seq Scan", "Relation Name": "t", "Total Cost": 35.5, "Plan Rows": 2550, "Plans": [...]}}]
+func parsePostgresPlan(plan string) (*Estimate, error) {
+	var root []map[string]any
+	if err := json.Unmarshal([]byte(plan), &root); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal PostgreSQL plan")
+	}
+	estimate := &Estimate{}
+	for _, statement := range root {
+		top, ok := statement["Plan"].(map[string]any)
+		if !ok {
+			continue
+		}
+		estimate.Cost = max(estimate.Cost, jsonNumber(top["Total Cost"]))
+		walkJSON(top, func(node map[string]any) {
+			nodeType, ok := node["Node Type"].(string)
+			if !ok {
+				return
+			}
+			rows := int64(jsonNumber(node["Plan Rows"]))
+			estimate.Rows = max(estimate.Rows, rows)
+			if nodeType == "Seq Scan" {
+				relation, _ := node["Relation Name"].(string)
+				schema, _ := node["Schema"].(string)
+				estimate.FullScans = append(estimate.FullScans, &FullScan{Schema: schema, Table: relation, Rows: rows})
+			}
+		})
+	}
+	return estimate, nil
+}
+
+// parseSnowflakePlan parses the plan from EXPLAIN USING JSON.
+//
+//	{"GlobalStats": {"partitionsTotal": 10, "partitionsAssigned": 10, "bytesAssigned": 1024}, "Operations": [[{"operation": "TableScan", "objects": ["DB.PUBLIC.T"], ...}]]}
+//
+// Snowflake doesn't estimate rows, a table scan without partition pruning is regarded as a full table scan.
+func parseSnowflakePlan(plan string) (*Estimate, error) {
+	var root struct {
+		GlobalStats struct {
+			BytesAssigned int64 `json:"bytesAssigned"`
+		} `json:"GlobalStats"`
+		Operations [][]struct {
+			Operation          string   `json:"operation"`
+			Objects            []string `json:"objects"`
+			PartitionsTotal    int64    `json:"partitionsTotal"`
+			PartitionsAssigned int64    `json:"partitionsAssigned"`
+		} `json:"Operations"`
+	}
+	if err := json.Unmarshal([]byte(plan), &root); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal Snowflake plan")
+	}
+	estimate := &Estimate{Bytes: root.GlobalStats.BytesAssigned}
+	for _, operations := range root.Operations {
+		for _, operation := range operations {
+			if operation.Operation != "TableScan" || len(operation.Objects) == 0 {
+				continue
+			}
+			if operation.PartitionsTotal == 0 || operation.PartitionsAssigned < operation.PartitionsTotal {
+				continue
+			}
+			// The object is in the format of DATABASE.SCHEMA.TABLE.
+			parts := strings.Split(operation.Objects[0], ".")
+			scan := &FullScan{Table: parts[len(parts)-1]}
+			if len(parts) > 1 {
+				scan.Schema = parts[len(parts)-2]
+			}
+			estimate.FullScans = append(estimate.FullScans, scan)
+		}
+	}
+	return estimate, nil
+}
+
+// estimateOracleQuery explains the query into the PLAN_TABLE and reads the plan rows.
+func estimateOracleQuery(ctx context.Context, conn *sql.Conn, statement string) (*Estimate, error) {
+	id, err := common.RandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	statementID := fmt.Sprintf("bb_%s", id)
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("EXPLAIN PLAN SET STATEMENT_ID = '%s' FOR %s", statementID, statement)); err != nil {
+		return nil, errors.Wrapf(err, "failed to explain the query")
+	}
+	defer func() {
+		// The PLAN_TABLE is a global temporary table, the rows are cleaned up to keep the session clean only.
+		_, _ = conn.ExecContext(context.Background(), "DELETE FROM PLAN_TABLE WHERE STATEMENT_ID = :1", statementID)
+	}()
+
+	rows, err := conn.QueryContext(ctx, "SELECT ID, OPERATION, OPTIONS, OBJECT_OWNER, OBJECT_NAME, CARDINALITY, COST FROM PLAN_TABLE WHERE STATEMENT_ID = :1 ORDER BY ID", statementID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query the plan")
+	}
+	defer rows.Close()
+	var planRows []*oraclePlanRow
+	for rows.Next() {
+		var id int64
+		var operation, options, owner, name sql.NullString
+		var cardinality, cost sql.NullInt64
+		if err := rows.Scan(&id, &operation, &options, &owner, &name, &cardinality, &cost); err != nil {
+			return nil, err
+		}
+		planRows = append(planRows, &oraclePlanRow{
+			id:          id,
+			operation:   operation.String,
+			options:     options.String,
+			objectOwner: owner.String,
+			objectName:  name.String,
+			cardinality: cardinality.Int64,
+			cost:        cost.Int64,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return parseOraclePlan(planRows), nil
+}
+
+type oraclePlanRow struct {
+	id          int64
+	operation   string
+	options     string
+	objectOwner string
+	objectName  string
+	cardinality int64
+	cost        int64
+}
+
+func parseOraclePlan(planRows []*oraclePlanRow) *Estimate {
+	estimate := &Estimate{}
+	for _, row := range planRows {
+		if row.id == 0 {
+			estimate.Cost = float64(row.cost)
+		}
+		estimate.Rows = max(estimate.Rows, row.cardinality)
+		if row.operation == "TABLE ACCESS" && row.options == "FULL" {
+			estimate.FullScans = append(estimate.FullScans, &FullScan{Schema: row.objectOwner, Table: row.objectName, Rows: row.cardinality})
+		}
+	}
+	return estimate
+}
+
+// estimateMSSQLQuery gets the estimated execution plan with SHOWPLAN_XML, under which the statements are not executed.
+func estimateMSSQLQuery(ctx context.Context, conn *sql.Conn, statement string) (*Estimate, error) {
+	if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_XML ON"); err != nil {
+		return nil, errors.Wrapf(err, "failed to set SHOWPLAN_XML")
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "SET SHOWPLAN_XML OFF")
+	}()
+	plan, err := queryPlan(ctx, conn, statement)
+	if err != nil {
+		return nil, err
+	}
+	return parseMSSQLPlan(plan)
+}
+
+// mssqlScanOperators are the physical operators reading all rows of a table.
+var mssqlScanOperators = map[string]bool{
+	"Table Scan":           true,
+	"Clustered Index Scan": true,
+}
+
+// parseMSSQLPlan parses the showplan XML.
+//
+//	<StmtSimple StatementSubTreeCost="0.0032" StatementEstRows="10">
+//	  <RelOp PhysicalOp="Table Scan" EstimateRows="10" TableCardinality="10"><TableScan><Object Schema="[dbo]" Table="[t]"/></TableScan></RelOp>
+//	</StmtSimple>
+func parseMSSQLPlan(plan string) (*Estimate, error) {
+	type relOp struct {
+		physicalOp string
+		rows       float64
+		schema     string
+		table      string
+	}
+	estimate := &Estimate{}
+	var stack []*relOp
+	decoder := xml.NewDecoder(strings.NewReader(plan))
+	// The plan declares utf-16 encoding, but it has been decoded by the driver already.
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse MSSQL plan")
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			attrs := make(map[string]string)
+			for _, attr := range t.Attr {
+				attrs[attr.Name.Local] = attr.Value
+			}
+			switch t.Name.Local {
+			case "StmtSimple":
+				estimate.Cost = max(estimate.Cost, parseFloat(attrs["StatementSubTreeCost"]))
+				estimate.Rows = max(estimate.Rows, int64(parseFloat(attrs["StatementEstRows"])))
+			case "RelOp":
+				op := &relOp{physicalOp: attrs["PhysicalOp"]}
+				// Prefer the rows read over the rows returned after the predicates.
+				for _, key := range []string{"TableCardinality", "EstimatedRowsRead", "EstimateRows"} {
+					if v, ok := attrs[key]; ok {
+						op.rows = parseFloat(v)
+						break
+					}
+				}
+				estimate.Rows = max(estimate.Rows, int64(parseFloat(attrs["EstimateRows"])))
+				stack = append(stack, op)
+			case "Object":
+				if len(stack) > 0 && stack[len(stack)-1].table == "" {
+					op := stack[len(stack)-1]
+					op.schema = strings.Trim(attrs["Schema"], "[]")
+					op.table = strings.Trim(attrs["Table"], "[]")
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local != "RelOp" || len(stack) == 0 {
+				continue
+			}
+			op := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if mssqlScanOperators[op.physicalOp] && op.table != "" {
+				estimate.FullScans = append(estimate.FullScans, &FullScan{Schema: op.schema, Table: op.table, Rows: int64(op.rows)})
+			}
+		}
+	}
+	return estimate, nil
+}
+
+// walkJSON calls fn on every JSON object in the value recursively.
+func walkJSON(v any, fn func(map[string]any)) {
+	switch t := v.(type) {
+	case map[string]any:
+		fn(t)
+		for _, child := range t {
+			walkJSON(child, fn)
+		}
+	case []any:
+		for _, child := range t {
+			walkJSON(child, fn)
+		}
+	}
+}
+
+// jsonNumber returns the number in the JSON value, which may be encoded as a string.
+func jsonNumber(v any) float64 {
+	switch t := v.(type) {
+	case float64:
+		return t
+	case string:
+		return parseFloat(t)
+	default:
+		return 0
+	}
+}
+
+func parseFloat(s string) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
@@ -0,0 +1,184 @@
+// Package queryguard estimates the cost of ad-hoc queries from their EXPLAIN output and rejects the expensive ones by the query guard policy.
+package queryguard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/bigquery"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Estimate is the estimated cost of a query.
+// The zero values mean the estimation is not available from the engine.
+type Estimate struct {
+	// Rows is the maximum estimated number of rows of the plan nodes.
+	Rows int64
+	// Cost is the estimated total cost of the query in the unit of the engine.
+	Cost float64
+	// Bytes is the estimated bytes processed by the query.
+	Bytes int64
+	// FullScans are the full table scans in the plan.
+	FullScans []*FullScan
+}
+
+// FullScan is a full table scan in the plan.
+type FullScan struct {
+	Schema string
+	Table  string
+	// Rows is the estimated number of rows scanned from the plan.
+	Rows int64
+}
+
+// IsPolicyEmpty returns whether the policy has no limit.
+func IsPolicyEmpty(policy *storepb.QueryGuardPolicy) bool {
+	return policy.GetMaximumEstimatedRows() <= 0 &&
+		policy.GetMaximumEstimatedCost() <= 0 &&
+		policy.GetMaximumEstimatedBytes() <= 0 &&
+		policy.GetMaximumFullScanRows() <= 0
+}
+
+// SupportEngine returns whether the engine supports the query cost estimation.
+func SupportEngine(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL,
+		storepb.Engine_MARIADB,
+		storepb.Engine_POSTGRES,
+		storepb.Engine_ORACLE,
+		storepb.Engine_MSSQL,
+		storepb.Engine_SNOWFLAKE,
+		storepb.Engine_BIGQUERY:
+		return true
+	default:
+		return false
+	}
+}
+
+// EstimateQuery estimates the cost of the single statement without running it.
+// The args are bound to the placeholders of the statement, they are only supported for MySQL, MariaDB and Postgres.
+func EstimateQuery(ctx context.Context, engine storepb.Engine, driver db.Driver, statement string, args ...any) (*Estimate, error) {
+	statement = strings.TrimSpace(strings.TrimRight(strings.TrimSpace(statement), ";"))
+	if engine == storepb.Engine_BIGQUERY {
+		d, ok := dbfactory.Unwrap(driver).(*bigquery.Driver)
+		if !ok {
+			return nil, errors.Errorf("unexpected BigQuery driver type %T", driver)
+		}
+		bytes, err := d.DryRun(ctx, statement)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to dry run the query")
+		}
+		return &Estimate{Bytes: bytes}, nil
+	}
+
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, errors.Errorf("engine %s does not support query cost estimation", engine)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		plan, err := queryPlan(ctx, conn, fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement), args...)
+		if err != nil {
+			return nil, err
+		}
+		return parseMySQLPlan(plan)
+	case storepb.Engine_POSTGRES:
+		plan, err := queryPlan(ctx, conn, fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", statement), args...)
+		if err != nil {
+			return nil, err
+		}
+		return parsePostgresPlan(plan)
+	case storepb.Engine_ORACLE:
+		return estimateOracleQuery(ctx, conn, statement)
+	case storepb.Engine_MSSQL:
+		return estimateMSSQLQuery(ctx, conn, statement)
+	case storepb.Engine_SNOWFLAKE:
+		plan, err := queryPlan(ctx, conn, fmt.Sprintf("EXPLAIN USING JSON %s", statement))
+		if err != nil {
+			return nil, err
+		}
+		return parseSnowflakePlan(plan)
+	default:
+		return nil, errors.Errorf("engine %s does not support query cost estimation", engine)
+	}
+}
+
+// queryPlan returns the plan in the first column of the first row.
+func queryPlan(ctx context.Context, conn *sql.Conn, explainStatement string, args ...any) (string, error) {
+	rows, err := conn.QueryContext(ctx, explainStatement, args...)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to explain the query")
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", err
+		}
+		return "", errors.New("no plan returned")
+	}
+	values := make([]any, len(columns))
+	var plan sql.NullString
+	values[0] = &plan
+	for i := 1; i < len(columns); i++ {
+		values[i] = new(sql.RawBytes)
+	}
+	if err := rows.Scan(values...); err != nil {
+		return "", err
+	}
+	return plan.String, nil
+}
+
+// CheckEstimationFailure returns the reason why the query whose cost cannot be estimated is rejected by the policy,
+// or nil if the policy allows such queries to run.
+func CheckEstimationFailure(policy *storepb.QueryGuardPolicy, err error) []string {
+	if err == nil || !policy.GetRejectOnEstimationFailure() {
+		return nil
+	}
+	return []string{fmt.Sprintf("the query cost cannot be estimated: %v", err)}
+}
+
+// Check returns the reasons why the query is rejected by the policy, or nil if the query is allowed.
+// The getTableRowCount returns the row count of a table from the synced metadata, as the engines may not estimate the rows of full table scans.
+func Check(policy *storepb.QueryGuardPolicy, estimate *Estimate, getTableRowCount func(schema, table string) int64) []string {
+	var reasons []string
+	if limit := policy.GetMaximumEstimatedRows(); limit > 0 && estimate.Rows > limit {
+		reasons = append(reasons, fmt.Sprintf("the estimated rows %d exceed the limit %d", estimate.Rows, limit))
+	}
+	if limit := policy.GetMaximumEstimatedCost(); limit > 0 && estimate.Cost > limit {
+		reasons = append(reasons, fmt.Sprintf("the estimated cost %.2f exceeds the limit %.2f", estimate.Cost, limit))
+	}
+	if limit := policy.GetMaximumEstimatedBytes(); limit > 0 && estimate.Bytes > limit {
+		reasons = append(reasons, fmt.Sprintf("the estimated bytes processed %d exceed the limit %d", estimate.Bytes, limit))
+	}
+	if limit := policy.GetMaximumFullScanRows(); limit > 0 {
+		for _, scan := range estimate.FullScans {
+			rows := scan.Rows
+			if getTableRowCount != nil {
+				rows = max(rows, getTableRowCount(scan.Schema, scan.Table))
+			}
+			if rows > limit {
+				table := scan.Table
+				if scan.Schema != "" {
+					table = fmt.Sprintf("%s.%s", scan.Schema, scan.Table)
+				}
+				reasons = append(reasons, fmt.Sprintf("the full table scan on %s with about %d rows exceeds the limit %d", table, rows, limit))
+			}
+		}
+	}
+	return reasons
+}
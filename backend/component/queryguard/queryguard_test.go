@@ -0,0 +1,191 @@
+package queryguard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseMySQLPlan(t *testing.T) {
+	a := require.New(t)
+	plan := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "20351.40"},
+    "nested_loop": [
+      {"table": {"table_name": "e", "access_type": "ALL", "rows_examined_per_scan": 299246, "rows_produced_per_join": 299246}},
+      {"table": {"table_name": "s", "access_type": "ref", "rows_examined_per_scan": 9, "rows_produced_per_join": 2843016}}
+    ]
+  }
+}`
+	estimate, err := parseMySQLPlan(plan)
+	a.NoError(err)
+	a.Equal(20351.40, estimate.Cost)
+	a.Equal(int64(2843016), estimate.Rows)
+	a.Equal([]*FullScan{{Table: "e", Rows: 299246}}, estimate.FullScans)
+
+	// MariaDB.
+	estimate, err = parseMySQLPlan(`{"query_block": {"select_id": 1, "table": {"table_name": "t", "access_type": "ALL", "rows": 1000, "filtered": 100}}}`)
+	a.NoError(err)
+	a.Equal(float64(0), estimate.Cost)
+	a.Equal(int64(1000), estimate.Rows)
+	a.Equal([]*FullScan{{Table: "t", Rows: 1000}}, estimate.FullScans)
+}
+
+func TestParsePostgresPlan(t *testing.T) {
+	a := require.New(t)
+	plan := `[
+  {
+    "Plan": {
+      "Node Type": "Hash Join",
+      "Total Cost": 1255.5,
+      "Plan Rows": 50000,
+      "Plans": [
+        {"Node Type": "Seq Scan", "Relation Name": "orders", "Total Cost": 800, "Plan Rows": 40000},
+        {"Node Type": "Hash", "Total Cost": 30, "Plan Rows": 100, "Plans": [
+          {"Node Type": "Index Scan", "Relation Name": "users", "Total Cost": 30, "Plan Rows": 100}
+        ]}
+      ]
+    }
+  }
+]`
+	estimate, err := parsePostgresPlan(plan)
+	a.NoError(err)
+	a.Equal(1255.5, estimate.Cost)
+	a.Equal(int64(50000), estimate.Rows)
+	a.Equal([]*FullScan{{Table: "orders", Rows: 40000}}, estimate.FullScans)
+}
+
+func TestParseSnowflakePlan(t *testing.T) {
+	a := require.New(t)
+	plan := `{
+  "GlobalStats": {"partitionsTotal": 20, "partitionsAssigned": 12, "bytesAssigned": 104857600},
+  "Operations": [[
+    {"id": 0, "operation": "Result"},
+    {"id": 1, "parentOperators": [0], "operation": "TableScan", "objects": ["DB.PUBLIC.EVENTS"], "partitionsAssigned": 10, "partitionsTotal": 10, "bytesAssigned": 100000000},
+    {"id": 2, "parentOperators": [0], "operation": "TableScan", "objects": ["DB.PUBLIC.USERS"], "partitionsAssigned": 2, "partitionsTotal": 10, "bytesAssigned": 4857600}
+  ]]
+}`
+	estimate, err := parseSnowflakePlan(plan)
+	a.NoError(err)
+	a.Equal(int64(104857600), estimate.Bytes)
+	a.Equal([]*FullScan{{Schema: "PUBLIC", Table: "EVENTS"}}, estimate.FullScans)
+}
+
+func TestParseOraclePlan(t *testing.T) {
+	a := require.New(t)
+	estimate := parseOraclePlan([]*oraclePlanRow{
+		{id: 0, operation: "SELECT STATEMENT", cardinality: 500, cost: 1200},
+		{id: 1, operation: "HASH JOIN", cardinality: 500, cost: 1200},
+		{id: 2, operation: "TABLE ACCESS", options: "FULL", objectOwner: "HR", objectName: "EMPLOYEES", cardinality: 100000, cost: 900},
+		{id: 3, operation: "TABLE ACCESS", options: "BY INDEX ROWID", objectOwner: "HR", objectName: "DEPARTMENTS", cardinality: 10, cost: 2},
+	})
+	a.Equal(float64(1200), estimate.Cost)
+	a.Equal(int64(100000), estimate.Rows)
+	a.Equal([]*FullScan{{Schema: "HR", Table: "EMPLOYEES", Rows: 100000}}, estimate.FullScans)
+}
+
+func TestParseMSSQLPlan(t *testing.T) {
+	a := require.New(t)
+	plan := `<?xml version="1.0" encoding="utf-16"?>
+<ShowPlanXML xmlns="http://schemas.microsoft.com/sqlserver/2004/07/showplan" Version="1.6">
+  <BatchSequence><Batch><Statements>
+    <StmtSimple StatementText="SELECT * FROM t JOIN u ON t.id = u.id" StatementSubTreeCost="12.5" StatementEstRows="300">
+      <QueryPlan>
+        <RelOp PhysicalOp="Hash Match" EstimateRows="300">
+          <Hash>
+            <RelOp PhysicalOp="Table Scan" EstimateRows="20" EstimatedRowsRead="50000" TableCardinality="50000">
+              <OutputList><ColumnReference Schema="[dbo]" Table="[t]" Column="id"/></OutputList>
+              <TableScan><Object Database="[db]" Schema="[dbo]" Table="[t]"/></TableScan>
+            </RelOp>
+            <RelOp PhysicalOp="Index Seek" EstimateRows="300">
+              <IndexScan><Object Database="[db]" Schema="[dbo]" Table="[u]" Index="[ix]"/></IndexScan>
+            </RelOp>
+          </Hash>
+        </RelOp>
+      </QueryPlan>
+    </StmtSimple>
+  </Statements></Batch></BatchSequence>
+</ShowPlanXML>`
+	estimate, err := parseMSSQLPlan(plan)
+	a.NoError(err)
+	a.Equal(12.5, estimate.Cost)
+	a.Equal(int64(300), estimate.Rows)
+	a.Equal([]*FullScan{{Schema: "dbo", Table: "t", Rows: 50000}}, estimate.FullScans)
+}
+
+func TestCheck(t *testing.T) {
+	a := require.New(t)
+	getTableRowCount := func(_, table string) int64 {
+		if table == "big" {
+			return 5000000
+		}
+		return 0
+	}
+	estimate := &Estimate{
+		Rows:  2000,
+		Cost:  150,
+		Bytes: 1 << 30,
+		FullScans: []*FullScan{
+			{Schema: "public", Table: "big", Rows: 10},
+			{Schema: "public", Table: "small", Rows: 100},
+		},
+	}
+
+	a.Empty(Check(&storepb.QueryGuardPolicy{}, estimate, getTableRowCount))
+	a.Empty(Check(&storepb.QueryGuardPolicy{MaximumEstimatedRows: 2000, MaximumEstimatedCost: 150, MaximumEstimatedBytes: 1 << 30, MaximumFullScanRows: 5000000}, estimate, getTableRowCount))
+	a.Equal([]string{
+		"the estimated rows 2000 exceed the limit 1000",
+		"the estimated cost 150.00 exceeds the limit 100.00",
+		"the estimated bytes processed 1073741824 exceed the limit 1048576",
+		"the full table scan on public.big with about 5000000 rows exceeds the limit 1000000",
+	}, Check(&storepb.QueryGuardPolicy{MaximumEstimatedRows: 1000, MaximumEstimatedCost: 100, MaximumEstimatedBytes: 1 << 20, MaximumFullScanRows: 1000000}, estimate, getTableRowCount))
+	// The rows of the plan are used without the synced metadata.
+	a.Equal([]string{
+		"the full table scan on public.small with about 100 rows exceeds the limit 50",
+	}, Check(&storepb.QueryGuardPolicy{MaximumFullScanRows: 50}, &Estimate{FullScans: estimate.FullScans[1:]}, nil))
+
+	a.True(IsPolicyEmpty(&storepb.QueryGuardPolicy{ExemptionRequestUrl: "https://example.com"}))
+	a.False(IsPolicyEmpty(&storepb.QueryGuardPolicy{MaximumFullScanRows: 1}))
+}
+
+func TestEstimateBigQueryFactoryDriver(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	// The driver from the factory is wrapped by the pool and tracing, and the BigQuery dry run needs the concrete driver.
+	secret := "secret"
+	credentials := `{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token"}`
+	driver, err := dbfactory.New("", "", "", "", secret).GetDataSourceDriver(
+		ctx,
+		&store.InstanceMessage{ResourceID: "bigquery", Engine: storepb.Engine_BIGQUERY},
+		&store.DataSourceMessage{ID: "admin", Host: "project", ObfuscatedPassword: common.Obfuscate(credentials, secret)},
+		"dataset",
+		false, /* datashare */
+		true,  /* readOnly */
+		db.ConnectionContext{},
+	)
+	a.NoError(err)
+	defer driver.Close(ctx)
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = EstimateQuery(canceledCtx, storepb.Engine_BIGQUERY, driver, "SELECT 1")
+	a.ErrorContains(err, "failed to dry run the query")
+}
+
+func TestCheckEstimationFailure(t *testing.T) {
+	a := require.New(t)
+	err := errors.New("syntax error")
+	a.Nil(CheckEstimationFailure(&storepb.QueryGuardPolicy{MaximumEstimatedRows: 100}, err))
+	policy := &storepb.QueryGuardPolicy{MaximumEstimatedRows: 100, RejectOnEstimationFailure: true}
+	a.Nil(CheckEstimationFailure(policy, nil))
+	a.Equal([]string{"the query cost cannot be estimated: syntax error"}, CheckEstimationFailure(policy, err))
+}
@@ -12,9 +12,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-// DefaultInstanceMaximumConnections is the maximum number of connections outstanding per instance by default.
-const DefaultInstanceMaximumConnections = 10
-
 // State is the state for all in-memory states within the server.
 type State struct {
 	// InstanceDatabaseSyncChan is the channel for synchronizing schemas for instances.
@@ -42,11 +39,11 @@ type State struct {
 
 	// RunningPlanChecks is the set of running plan checks.
 	RunningPlanChecks sync.Map
-	// InstanceOutstandingConnections is the maximum number of connections per instance.
-	InstanceOutstandingConnections map[int]int
 
 	// IssueExternalApprovalRelayCancelChan cancels the external approval from relay for issue issueUID.
 	IssueExternalApprovalRelayCancelChan chan int
+	// IssueExternalApprovalRolloutChan comments the rollout outcomes on the external approval tickets of the issue.
+	IssueExternalApprovalRolloutChan chan *IssueExternalApprovalRolloutMessage
 
 	// TaskSkippedOrDoneChan is the channel for notifying the task is skipped or done.
 	TaskSkippedOrDoneChan chan int
@@ -70,8 +67,8 @@ func New() (*State, error) {
 	}
 	return &State{
 		InstanceSlowQuerySyncChan:            make(chan *InstanceSlowQuerySyncMessage, 100),
-		InstanceOutstandingConnections:       make(map[int]int),
 		IssueExternalApprovalRelayCancelChan: make(chan int, 1),
+		IssueExternalApprovalRolloutChan:     make(chan *IssueExternalApprovalRolloutMessage, 100),
 		TaskSkippedOrDoneChan:                make(chan int, 1000),
 		InstanceSyncTickleChan:               make(chan int, 1000),
 		PlanCheckTickleChan:                  make(chan int, 1000),
@@ -80,6 +77,12 @@ func New() (*State, error) {
 	}, nil
 }
 
+// IssueExternalApprovalRolloutMessage is the message for commenting the rollout outcome on the external approval tickets.
+type IssueExternalApprovalRolloutMessage struct {
+	IssueUID int
+	Comment  string
+}
+
 // InstanceSlowQuerySyncMessage is the message for synchronizing slow query logs for instances.
 type InstanceSlowQuerySyncMessage struct {
 	InstanceID string
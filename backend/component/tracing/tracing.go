@@ -0,0 +1,96 @@
+// Package tracing provides the OpenTelemetry tracing of Bytebase itself.
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const instrumentationName = "github.com/bytebase/bytebase"
+
+// Span attribute keys shared by the Bytebase spans.
+const (
+	EngineKey           = attribute.Key("bytebase.engine")
+	InstanceKey         = attribute.Key("bytebase.instance")
+	DatabaseKey         = attribute.Key("bytebase.database")
+	TaskTypeKey         = attribute.Key("bytebase.task.type")
+	TaskRunKey          = attribute.Key("bytebase.task_run.id")
+	PlanCheckRunTypeKey = attribute.Key("bytebase.plan_check_run.type")
+)
+
+// Init sets up the global tracer provider exporting spans over OTLP/gRPC to the endpoint URL, e.g. http://localhost:4317.
+// The global tracer provider stays no-op if the endpoint is empty.
+// The returned function flushes and shuts down the tracer provider.
+func Init(ctx context.Context, endpoint string, version string) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create OTLP exporter for %q", endpoint)
+	}
+	tp := NewTracerProvider(sdktrace.WithBatcher(exporter), version)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// NewTracerProvider creates a tracer provider for the Bytebase service with the span processor.
+// Tests could use it with an in-memory exporter registered by sdktrace.WithSyncer.
+func NewTracerProvider(processor sdktrace.TracerProviderOption, version string) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName("bytebase"),
+			semconv.ServiceVersion(version),
+		)),
+	)
+}
+
+// Start starts a span with the attributes under the context.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error if any and ends the span.
+func End(span trace.Span, err error) {
+	SetError(span, err)
+	span.End()
+}
+
+// SetError records the error on the span and marks the span as failed.
+func SetError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Engine returns the engine attribute.
+func Engine(engine storepb.Engine) attribute.KeyValue {
+	return EngineKey.String(engine.String())
+}
+
+// Instance returns the instance attribute.
+func Instance(instanceID string) attribute.KeyValue {
+	return InstanceKey.String(instanceID)
+}
+
+// Database returns the database attribute.
+func Database(databaseName string) attribute.KeyValue {
+	return DatabaseKey.String(databaseName)
+}
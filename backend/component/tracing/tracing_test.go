@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestStartEnd(t *testing.T) {
+	a := require.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := NewTracerProvider(sdktrace.WithSyncer(exporter), "test")
+	otel.SetTracerProvider(tp)
+	defer func() {
+		_ = tp.Shutdown(context.Background())
+	}()
+
+	ctx, parent := Start(context.Background(), "task run")
+	_, child := Start(ctx, "driver execute", Engine(storepb.Engine_POSTGRES), Instance("prod-pg"))
+	End(child, errors.New("syntax error"))
+	End(parent, nil)
+
+	spans := exporter.GetSpans()
+	a.Len(spans, 2)
+	a.Equal("driver execute", spans[0].Name)
+	a.Equal(spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	a.Equal(codes.Error, spans[0].Status.Code)
+	a.Contains(spans[0].Attributes, EngineKey.String("POSTGRES"))
+	a.Contains(spans[0].Attributes, InstanceKey.String("prod-pg"))
+	a.Equal(codes.Unset, spans[1].Status.Code)
+}
+
+func TestInitNoop(t *testing.T) {
+	a := require.New(t)
+
+	shutdown, err := Init(context.Background(), "", "test")
+	a.NoError(err)
+	a.NoError(shutdown(context.Background()))
+}
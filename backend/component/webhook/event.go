@@ -14,7 +14,9 @@ const (
 	EventTypeIssueCommentCreate  = "bb.webhook.event.issue.comment.create"
 	EventTypeIssueApprovalCreate = "bb.webhook.event.issue.approval.create"
 	EventTypeIssueApprovalPass   = "bb.webhook.event.issue.approval.pass"
+	EventTypeIssueApprovalReject = "bb.webhook.event.issue.approval.reject"
 	EventTypeIssueRolloutReady   = "bb.webhook.event.issue.rollout.ready"
+	EventTypeIssueGrantExpiring  = "bb.webhook.event.issue.grant.expiring"
 
 	EventTypeStageStatusUpdate   = "bb.webhook.event.stage.status.update"
 	EventTypeTaskRunStatusUpdate = "bb.webhook.event.taskRun.status.update"
@@ -30,6 +32,7 @@ type Event struct {
 	IssueUpdate         *EventIssueUpdate
 	IssueApprovalCreate *EventIssueApprovalCreate
 	IssueRolloutReady   *EventIssueRolloutReady
+	IssueGrantExpiring  *EventIssueGrantExpiring
 	StageStatusUpdate   *EventStageStatusUpdate
 	TaskRunStatusUpdate *EventTaskRunStatusUpdate
 }
@@ -83,6 +86,11 @@ type EventIssueRolloutReady struct {
 	StageName     string
 }
 
+type EventIssueGrantExpiring struct {
+	// Members are the users whose granted role is expiring.
+	Members []*store.UserMessage
+}
+
 type EventStageStatusUpdate struct {
 	StageTitle string
 	StageUID   int
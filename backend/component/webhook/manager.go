@@ -13,6 +13,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
@@ -55,8 +56,12 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		activityType = api.ActivityIssueApprovalNotify
 	case EventTypeIssueApprovalPass:
 		activityType = api.ActivityNotifyIssueApproved
+	case EventTypeIssueApprovalReject:
+		activityType = api.ActivityIssueApprovalNotify
 	case EventTypeIssueRolloutReady:
 		activityType = api.ActivityNotifyPipelineRollout
+	case EventTypeIssueGrantExpiring:
+		activityType = api.ActivityNotifyGrantExpiring
 	case EventTypeStageStatusUpdate:
 		activityType = api.ActivityPipelineStageStatusUpdate
 	case EventTypeTaskRunStatusUpdate:
@@ -185,6 +190,38 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			mentions = append(mentions, phone)
 		}
 
+	case EventTypeIssueApprovalReject:
+		level = webhook.WebhookWarn
+		title = "Issue approval rejected"
+		titleZh = "工单审批被拒绝"
+
+		mentionUsers = append(mentionUsers, e.Issue.Creator)
+		phone, err := maybeGetPhoneFromUser(e.Issue.Creator)
+		if err != nil {
+			slog.Warn("failed to parse phone number", slog.String("issue_title", e.Issue.Title), log.BBError(err))
+		} else if phone != "" {
+			mentions = append(mentions, phone)
+		}
+
+	case EventTypeIssueGrantExpiring:
+		u := e.IssueGrantExpiring
+		level = webhook.WebhookWarn
+		title = "Granted role is expiring"
+		titleZh = "授权即将过期"
+		for _, user := range u.Members {
+			mentionUsers = append(mentionUsers, user)
+			phone, err := maybeGetPhoneFromUser(user)
+			if err != nil {
+				slog.Warn("failed to parse phone number",
+					slog.String("issue_name", e.Issue.Title),
+					log.BBError(err))
+				continue
+			}
+			if phone != "" {
+				mentions = append(mentions, phone)
+			}
+		}
+
 	case EventTypeIssueRolloutReady:
 		u := e.IssueRolloutReady
 		title = "Issue is waiting for rollout"
@@ -287,6 +324,13 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 				log.BBError(err))
 			return nil, err
 		}
+		users, err = m.appendApprovalDelegates(ctx, users)
+		if err != nil {
+			slog.Warn("Failed to post webhook event after changing the issue approval node status, failed to get approval delegates",
+				slog.String("issue_name", e.Issue.Title),
+				log.BBError(err))
+			return nil, err
+		}
 		for _, user := range users {
 			mentionUsers = append(mentionUsers, user)
 			phone, err := maybeGetPhoneFromUser(user)
@@ -362,6 +406,7 @@ func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Conte
 			if err := common.Retry(ctx, func() error {
 				return webhook.Post(hook.Type, *webhookCtx)
 			}); err != nil {
+				metrics.IncWebhookDeliveryFailure(hook.Type)
 				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
 				slog.Warn("Failed to post webhook event on activity",
 					slog.String("webhook type", hook.Type),
@@ -375,6 +420,32 @@ func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Conte
 	}
 }
 
+// appendApprovalDelegates appends the backup approvers of the out-of-office users.
+func (m *Manager) appendApprovalDelegates(ctx context.Context, users []*store.UserMessage) ([]*store.UserMessage, error) {
+	now := time.Now()
+	seen := map[int]bool{}
+	for _, user := range users {
+		seen[user.ID] = true
+	}
+	var delegates []*store.UserMessage
+	for _, user := range users {
+		delegateID := utils.GetApprovalDelegateID(user, now)
+		if delegateID == 0 || seen[delegateID] {
+			continue
+		}
+		seen[delegateID] = true
+		delegate, err := m.store.GetUserByID(ctx, delegateID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", delegateID)
+		}
+		if delegate == nil || delegate.MemberDeleted {
+			continue
+		}
+		delegates = append(delegates, delegate)
+	}
+	return append(users, delegates...), nil
+}
+
 func getUsersFromWorkspaceRole(s *store.Store, role api.Role) func(context.Context) ([]*store.UserMessage, error) {
 	return func(ctx context.Context) ([]*store.UserMessage, error) {
 		return s.ListUsers(ctx, &store.FindUserMessage{
@@ -11,6 +11,9 @@ const (
 	// ActivityPipelineRollout is the type for notifying releasers to rollout.
 	// Will not be stored. Only used for notification.
 	ActivityNotifyPipelineRollout ActivityType = "bb.notify.pipeline.rollout"
+	// ActivityNotifyGrantExpiring is the type for notifying the members before the granted role of the grant request expires.
+	// Will not be stored. Only used for notification.
+	ActivityNotifyGrantExpiring ActivityType = "bb.notify.grant.expiring"
 
 	// Issue related.
 
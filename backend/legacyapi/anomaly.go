@@ -12,6 +12,16 @@ const (
 	AnomalyDatabaseConnection AnomalyType = "bb.anomaly.database.connection"
 	// AnomalyDatabaseSchemaDrift is the anomaly type for database schema drifts.
 	AnomalyDatabaseSchemaDrift AnomalyType = "bb.anomaly.database.schema.drift"
+	// AnomalyInstanceReplicationLag is the anomaly type for the replication lag of read-only data sources.
+	AnomalyInstanceReplicationLag AnomalyType = "bb.anomaly.instance.replication-lag"
+	// AnomalyDatabaseTableWithoutPrimaryKey is the anomaly type for tables without a primary key.
+	AnomalyDatabaseTableWithoutPrimaryKey AnomalyType = "bb.anomaly.database.table-without-primary-key"
+	// AnomalyDatabaseUnusedIndex is the anomaly type for unused indexes.
+	AnomalyDatabaseUnusedIndex AnomalyType = "bb.anomaly.database.index.unused"
+	// AnomalyDatabaseDuplicateIndex is the anomaly type for duplicate indexes.
+	AnomalyDatabaseDuplicateIndex AnomalyType = "bb.anomaly.database.index.duplicate"
+	// AnomalyDatabaseAutoIncrementExhaustion is the anomaly type for auto-increment columns nearing exhaustion.
+	AnomalyDatabaseAutoIncrementExhaustion AnomalyType = "bb.anomaly.database.auto-increment-exhaustion"
 )
 
 // AnomalyInstanceConnectionPayload is the API message for instance connection payloads.
@@ -35,3 +45,55 @@ type AnomalyDatabaseSchemaDriftPayload struct {
 	// The actual schema dumped from the database
 	Actual string `json:"actual,omitempty"`
 }
+
+// AnomalyInstanceReplicationLagPayload is the API message for instance replication lag payloads.
+type AnomalyInstanceReplicationLagPayload struct {
+	DataSources []*AnomalyDataSourceLag `json:"dataSources,omitempty"`
+}
+
+// AnomalyDataSourceLag is the API message for the replication lag of a read-only data source.
+type AnomalyDataSourceLag struct {
+	DataSourceID string `json:"dataSourceId,omitempty"`
+	// LagSeconds is -1 if the replication is not running.
+	LagSeconds int64 `json:"lagSeconds"`
+}
+
+// AnomalyDatabaseTableWithoutPrimaryKeyPayload is the API message for database table without primary key payloads.
+type AnomalyDatabaseTableWithoutPrimaryKeyPayload struct {
+	Tables []*AnomalyTable `json:"tables,omitempty"`
+}
+
+// AnomalyTable is the API message for a table in anomaly payloads.
+type AnomalyTable struct {
+	Schema string `json:"schema,omitempty"`
+	Table  string `json:"table,omitempty"`
+}
+
+// AnomalyDatabaseIndexPayload is the API message for database unused and duplicate index payloads.
+type AnomalyDatabaseIndexPayload struct {
+	Indexes []*AnomalyIndex `json:"indexes,omitempty"`
+}
+
+// AnomalyIndex is the API message for an index in anomaly payloads.
+type AnomalyIndex struct {
+	Schema string `json:"schema,omitempty"`
+	Table  string `json:"table,omitempty"`
+	Index  string `json:"index,omitempty"`
+	// CoveringIndex is the index covering a duplicate index.
+	CoveringIndex string `json:"coveringIndex,omitempty"`
+}
+
+// AnomalyDatabaseAutoIncrementExhaustionPayload is the API message for database auto-increment exhaustion payloads.
+type AnomalyDatabaseAutoIncrementExhaustionPayload struct {
+	Columns []*AnomalyAutoIncrementColumn `json:"columns,omitempty"`
+}
+
+// AnomalyAutoIncrementColumn is the API message for an auto-increment column or sequence in anomaly payloads.
+type AnomalyAutoIncrementColumn struct {
+	Schema       string `json:"schema,omitempty"`
+	Table        string `json:"table,omitempty"`
+	Column       string `json:"column,omitempty"`
+	Sequence     string `json:"sequence,omitempty"`
+	CurrentValue uint64 `json:"currentValue"`
+	MaximumValue uint64 `json:"maximumValue"`
+}
@@ -162,6 +162,8 @@ const (
 
 	// FeatureBatchQuery enables batch query databases in SQL Editor.
 	FeatureBatchQuery FeatureType = "bb.feature.batch-query"
+	// FeatureFederatedQuery enables joining the tables across instances and databases in SQL Editor.
+	FeatureFederatedQuery FeatureType = "bb.feature.federated-query"
 
 	// Collaboration.
 
@@ -253,6 +255,8 @@ func (e FeatureType) Name() string {
 	// Efficiency
 	case FeatureBatchQuery:
 		return "Batch query"
+	case FeatureFederatedQuery:
+		return "Federated query"
 	// Collaboration
 	case FeatureSharedSQLScript:
 		return "Shared SQL script"
@@ -321,7 +325,8 @@ var FeatureMatrix = map[FeatureType][3]bool{
 	FeatureAccessControl:         {false, false, true},
 	FeatureCustomApproval:        {false, false, true},
 	// Efficiency
-	FeatureBatchQuery: {false, false, true},
+	FeatureBatchQuery:     {false, false, true},
+	FeatureFederatedQuery: {false, false, true},
 	// Collaboration
 	FeatureSharedSQLScript: {false, true, true},
 	// Plugins
@@ -45,6 +45,8 @@ const (
 	PolicyTypeRestrictIssueCreationForSQLReview PolicyType = "bb.policy.restrict-issue-creation-for-sql-review"
 	// PolicyTypeProjectIAM is the policy for IAM in the project.
 	PolicyTypeProjectIAM PolicyType = "bb.policy.project-iam"
+	// PolicyTypeQueryGuard is the policy type for rejecting the expensive ad-hoc queries.
+	PolicyTypeQueryGuard PolicyType = "bb.policy.query-guard"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -87,6 +89,7 @@ var (
 		PolicyTypeMaskingRule:                       {PolicyResourceTypeWorkspace},
 		PolicyTypeMaskingException:                  {PolicyResourceTypeProject},
 		PolicyTypeRestrictIssueCreationForSQLReview: {PolicyResourceTypeWorkspace},
+		PolicyTypeQueryGuard:                        {PolicyResourceTypeEnvironment},
 	}
 )
 
@@ -0,0 +1,11 @@
+-- database_size_history stores the size statistics of a database and its tables sampled at every schema sync.
+CREATE TABLE database_size_history (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_database_size_history_database_id_created_ts ON database_size_history(database_id, created_ts);
+
+ALTER SEQUENCE database_size_history_id_seq RESTART WITH 101;
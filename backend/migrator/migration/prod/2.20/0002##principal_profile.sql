@@ -0,0 +1,2 @@
+-- profile stores the user preferences such as the out-of-office approval delegation.
+ALTER TABLE principal ADD COLUMN IF NOT EXISTS profile JSONB NOT NULL DEFAULT '{}';
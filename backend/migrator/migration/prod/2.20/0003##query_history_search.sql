@@ -0,0 +1,7 @@
+-- The indexes are built concurrently outside the migration transaction, not to block the query histories recorded during the upgrade.
+
+-- The full-text index for searching the statements of the query histories.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_query_history_statement_fts ON query_history USING GIN (to_tsvector('simple', statement));
+
+-- The index for the time range search and the retention cleanup of the query histories.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_query_history_created_ts ON query_history(created_ts);
@@ -32,7 +32,8 @@ CREATE TABLE principal (
     email TEXT NOT NULL,
     password_hash TEXT NOT NULL,
     phone TEXT NOT NULL DEFAULT '',
-    mfa_config JSONB NOT NULL DEFAULT '{}'
+    mfa_config JSONB NOT NULL DEFAULT '{}',
+    profile JSONB NOT NULL DEFAULT '{}'
 );
 
 -- Default bytebase system account id is 1
@@ -710,6 +711,10 @@ CREATE TABLE query_history (
 
 CREATE INDEX idx_query_history_creator_id_created_ts_project_id ON query_history(creator_id, created_ts, project_id DESC);
 
+CREATE INDEX idx_query_history_statement_fts ON query_history USING GIN (to_tsvector('simple', statement));
+
+CREATE INDEX idx_query_history_created_ts ON query_history(created_ts);
+
 ALTER SEQUENCE query_history_id_seq RESTART WITH 101;
 
 -- vcs table stores the version control provider config
@@ -967,4 +972,16 @@ CREATE TABLE user_group (
   name TEXT NOT NULL,
   description TEXT NOT NULL DEFAULT '',
   payload JSONB NOT NULL DEFAULT '{}'
-);
\ No newline at end of file
+);
+
+-- database_size_history stores the size statistics of a database and its tables sampled at every schema sync.
+CREATE TABLE database_size_history (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_database_size_history_database_id_created_ts ON database_size_history(database_id, created_ts);
+
+ALTER SEQUENCE database_size_history_id_seq RESTART WITH 101;
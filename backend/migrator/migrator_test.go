@@ -230,5 +230,5 @@ func TestMigrationCompatibility(t *testing.T) {
 func TestGetCutoffVersion(t *testing.T) {
 	releaseVersion, err := getProdCutoffVersion()
 	require.NoError(t, err)
-	require.Equal(t, semver.MustParse("2.20.0"), releaseVersion)
+	require.Equal(t, semver.MustParse("2.20.3"), releaseVersion)
 }
@@ -0,0 +1,142 @@
+// Package jira is the client creating and tracking Jira Service Management issues for external approvals.
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Client is the client for Jira.
+type Client struct {
+	client *http.Client
+	config *storepb.ExternalApprovalSetting_JiraConfig
+}
+
+// NewClient returns a client.
+func NewClient(config *storepb.ExternalApprovalSetting_JiraConfig) *Client {
+	return &Client{
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		config: config,
+	}
+}
+
+// CreateIssuePayload is the message to create a Jira issue.
+type CreateIssuePayload struct {
+	Summary     string
+	Description string
+}
+
+type issueFields struct {
+	Project     *issueProject   `json:"project,omitempty"`
+	Summary     string          `json:"summary,omitempty"`
+	Description string          `json:"description,omitempty"`
+	IssueType   *issueIssueType `json:"issuetype,omitempty"`
+	Status      *issueStatus    `json:"status,omitempty"`
+}
+
+type issueProject struct {
+	Key string `json:"key"`
+}
+
+type issueIssueType struct {
+	Name string `json:"name"`
+}
+
+type issueStatus struct {
+	Name string `json:"name"`
+}
+
+type issue struct {
+	ID     string       `json:"id,omitempty"`
+	Key    string       `json:"key,omitempty"`
+	Fields *issueFields `json:"fields,omitempty"`
+}
+
+// CreateIssue creates a Jira issue and returns the issue key.
+func (c *Client) CreateIssue(ctx context.Context, payload *CreateIssuePayload) (string, error) {
+	body := &issue{
+		Fields: &issueFields{
+			Project:     &issueProject{Key: c.config.ProjectKey},
+			Summary:     payload.Summary,
+			Description: payload.Description,
+			IssueType:   &issueIssueType{Name: c.config.IssueType},
+		},
+	}
+	created := &issue{}
+	if err := c.do(ctx, http.MethodPost, "/rest/api/2/issue", body, created); err != nil {
+		return "", errors.Wrapf(err, "failed to create Jira issue")
+	}
+	if created.Key == "" {
+		return "", errors.Errorf("empty Jira issue key in the response")
+	}
+	return created.Key, nil
+}
+
+// GetIssueStatus returns the status name of the Jira issue.
+func (c *Client) GetIssueStatus(ctx context.Context, key string) (string, error) {
+	got := &issue{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/rest/api/2/issue/%s?fields=status", url.PathEscape(key)), nil, got); err != nil {
+		return "", errors.Wrapf(err, "failed to get Jira issue %s", key)
+	}
+	if got.Fields == nil || got.Fields.Status == nil {
+		return "", errors.Errorf("empty status of Jira issue %s in the response", key)
+	}
+	return got.Fields.Status.Name, nil
+}
+
+// AddComment adds a comment to the Jira issue.
+func (c *Client) AddComment(ctx context.Context, key string, comment string) error {
+	body := map[string]string{
+		"body": comment,
+	}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/rest/api/2/issue/%s/comment", url.PathEscape(key)), body, nil); err != nil {
+		return errors.Wrapf(err, "failed to comment on Jira issue %s", key)
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.config.Url, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.config.Email, c.config.ApiToken)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("unexpected status %v, body %s", resp.Status, string(b))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
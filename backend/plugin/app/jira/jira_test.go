@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func newMockServer(t *testing.T, comments *[]string) *httptest.Server {
+	mux := http.NewServeMux()
+	checkAuth := func(r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "bot@example.com", user)
+		require.Equal(t, "test-token", password)
+	}
+	mux.HandleFunc("POST /rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		checkAuth(r)
+		body := &issue{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		require.Equal(t, "OPS", body.Fields.Project.Key)
+		require.Equal(t, "Change", body.Fields.IssueType.Name)
+		require.Equal(t, "[Bytebase] Add column", body.Fields.Summary)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"10001","key":"OPS-1","self":"https://example.atlassian.net/rest/api/2/issue/10001"}`))
+	})
+	mux.HandleFunc("GET /rest/api/2/issue/OPS-1", func(w http.ResponseWriter, r *http.Request) {
+		checkAuth(r)
+		require.Equal(t, "status", r.URL.Query().Get("fields"))
+		_, _ = w.Write([]byte(`{"id":"10001","key":"OPS-1","fields":{"status":{"name":"Approved"}}}`))
+	})
+	mux.HandleFunc("POST /rest/api/2/issue/OPS-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		checkAuth(r)
+		body := map[string]string{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*comments = append(*comments, body["body"])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestClient(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	var comments []string
+	s := newMockServer(t, &comments)
+	defer s.Close()
+
+	client := NewClient(&storepb.ExternalApprovalSetting_JiraConfig{
+		Url:        s.URL + "/",
+		Email:      "bot@example.com",
+		ApiToken:   "test-token",
+		ProjectKey: "OPS",
+		IssueType:  "Change",
+	})
+	key, err := client.CreateIssue(ctx, &CreateIssuePayload{
+		Summary:     "[Bytebase] Add column",
+		Description: "ALTER TABLE t ADD COLUMN c INT;",
+	})
+	a.NoError(err)
+	a.Equal("OPS-1", key)
+
+	status, err := client.GetIssueStatus(ctx, key)
+	a.NoError(err)
+	a.Equal("Approved", status)
+
+	a.NoError(client.AddComment(ctx, key, "Rollout succeeded."))
+	a.Equal([]string{"Rollout succeeded."}, comments)
+
+	_, err = client.GetIssueStatus(ctx, "OPS-2")
+	a.ErrorContains(err, "404")
+}
@@ -0,0 +1,125 @@
+// Package servicenow is the client creating and tracking ServiceNow change requests for external approvals.
+package servicenow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Client is the client for ServiceNow.
+type Client struct {
+	client *http.Client
+	config *storepb.ExternalApprovalSetting_ServiceNowConfig
+}
+
+// NewClient returns a client.
+func NewClient(config *storepb.ExternalApprovalSetting_ServiceNowConfig) *Client {
+	return &Client{
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		config: config,
+	}
+}
+
+// CreateChangeRequestPayload is the message to create a change request.
+type CreateChangeRequestPayload struct {
+	ShortDescription string
+	Description      string
+}
+
+type changeRequest struct {
+	SysID            string `json:"sys_id,omitempty"`
+	Number           string `json:"number,omitempty"`
+	ShortDescription string `json:"short_description,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Approval         string `json:"approval,omitempty"`
+	WorkNotes        string `json:"work_notes,omitempty"`
+}
+
+type changeRequestResponse struct {
+	Result *changeRequest `json:"result"`
+}
+
+// CreateChangeRequest creates a change request and returns its sys_id.
+func (c *Client) CreateChangeRequest(ctx context.Context, payload *CreateChangeRequestPayload) (string, error) {
+	body := &changeRequest{
+		ShortDescription: payload.ShortDescription,
+		Description:      payload.Description,
+	}
+	resp := &changeRequestResponse{}
+	if err := c.do(ctx, http.MethodPost, "/api/now/table/change_request", body, resp); err != nil {
+		return "", errors.Wrapf(err, "failed to create ServiceNow change request")
+	}
+	if resp.Result == nil || resp.Result.SysID == "" {
+		return "", errors.Errorf("empty ServiceNow change request sys_id in the response")
+	}
+	return resp.Result.SysID, nil
+}
+
+// GetChangeRequestApproval returns the approval value of the change request, e.g. "requested", "approved" or "rejected".
+func (c *Client) GetChangeRequestApproval(ctx context.Context, sysID string) (string, error) {
+	resp := &changeRequestResponse{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/api/now/table/change_request/%s?sysparm_fields=sys_id,number,approval", url.PathEscape(sysID)), nil, resp); err != nil {
+		return "", errors.Wrapf(err, "failed to get ServiceNow change request %s", sysID)
+	}
+	if resp.Result == nil {
+		return "", errors.Errorf("empty ServiceNow change request %s in the response", sysID)
+	}
+	return resp.Result.Approval, nil
+}
+
+// AddWorkNote adds a work note to the change request.
+func (c *Client) AddWorkNote(ctx context.Context, sysID string, note string) error {
+	body := &changeRequest{
+		WorkNotes: note,
+	}
+	if err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/api/now/table/change_request/%s", url.PathEscape(sysID)), body, nil); err != nil {
+		return errors.Wrapf(err, "failed to add work note to ServiceNow change request %s", sysID)
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.config.InstanceUrl, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.config.Username, c.config.Password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("unexpected status %v, body %s", resp.Status, string(b))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
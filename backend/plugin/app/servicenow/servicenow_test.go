@@ -0,0 +1,73 @@
+package servicenow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func newMockServer(t *testing.T, workNotes *[]string) *httptest.Server {
+	mux := http.NewServeMux()
+	checkAuth := func(r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "bytebase", user)
+		require.Equal(t, "test-password", password)
+	}
+	mux.HandleFunc("POST /api/now/table/change_request", func(w http.ResponseWriter, r *http.Request) {
+		checkAuth(r)
+		body := &changeRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		require.Equal(t, "[Bytebase] Add column", body.ShortDescription)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"result":{"sys_id":"c83c5e5347c12200e0ef563dbb9a7190","number":"CHG0030001","approval":"requested"}}`))
+	})
+	mux.HandleFunc("GET /api/now/table/change_request/c83c5e5347c12200e0ef563dbb9a7190", func(w http.ResponseWriter, r *http.Request) {
+		checkAuth(r)
+		_, _ = w.Write([]byte(`{"result":{"sys_id":"c83c5e5347c12200e0ef563dbb9a7190","number":"CHG0030001","approval":"rejected"}}`))
+	})
+	mux.HandleFunc("PATCH /api/now/table/change_request/c83c5e5347c12200e0ef563dbb9a7190", func(w http.ResponseWriter, r *http.Request) {
+		checkAuth(r)
+		body := &changeRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		*workNotes = append(*workNotes, body.WorkNotes)
+		_, _ = w.Write([]byte(`{"result":{"sys_id":"c83c5e5347c12200e0ef563dbb9a7190"}}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestClient(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	var workNotes []string
+	s := newMockServer(t, &workNotes)
+	defer s.Close()
+
+	client := NewClient(&storepb.ExternalApprovalSetting_ServiceNowConfig{
+		InstanceUrl: s.URL,
+		Username:    "bytebase",
+		Password:    "test-password",
+	})
+	sysID, err := client.CreateChangeRequest(ctx, &CreateChangeRequestPayload{
+		ShortDescription: "[Bytebase] Add column",
+		Description:      "ALTER TABLE t ADD COLUMN c INT;",
+	})
+	a.NoError(err)
+	a.Equal("c83c5e5347c12200e0ef563dbb9a7190", sysID)
+
+	approval, err := client.GetChangeRequestApproval(ctx, sysID)
+	a.NoError(err)
+	a.Equal("rejected", approval)
+
+	a.NoError(client.AddWorkNote(ctx, sysID, "Rollout failed."))
+	a.Equal([]string{"Rollout failed."}, workNotes)
+
+	_, err = client.GetChangeRequestApproval(ctx, "unknown")
+	a.ErrorContains(err, "404")
+}
@@ -261,6 +261,26 @@ func (d *Driver) querySingleSQL(ctx context.Context, statement string) (*v1pb.Qu
 	return result, nil
 }
 
+// DryRun validates the statement without running it, and returns the estimated bytes processed by the query.
+func (d *Driver) DryRun(ctx context.Context, statement string) (int64, error) {
+	q := d.client.Query(statement)
+	q.DefaultDatasetID = d.databaseName
+	q.DryRun = true
+	job, err := q.Run(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// A dry run job is not enqueued, and its statistics are available immediately.
+	status := job.LastStatus()
+	if status == nil || status.Statistics == nil {
+		return 0, errors.New("no statistics in the dry run job")
+	}
+	if err := status.Err(); err != nil {
+		return 0, err
+	}
+	return status.Statistics.TotalBytesProcessed, nil
+}
+
 func getStatementWithResultLimit(stmt string, limit int) string {
 	stmt = strings.TrimRight(stmt, " \n\t;")
 	if !strings.HasPrefix(stmt, "EXPLAIN") {
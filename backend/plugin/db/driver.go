@@ -240,6 +240,8 @@ type QueryContext struct {
 	CurrentDatabase string
 	// ShareDB is for Redshift.
 	ShareDB bool
+	// Args are the values bound to the placeholders in the statement, such as ? for MySQL and $1 for Postgres.
+	Args []any
 }
 
 // DatabaseRoleMessage is the API message for database role.
@@ -115,6 +115,10 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get sequences from database %q", driver.databaseName)
 	}
+	enumTypeMap, err := getEnumTypes(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get enum types from database %q", driver.databaseName)
+	}
 
 	extensions, err := getExtensions(txn)
 	if err != nil {
@@ -132,6 +136,7 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		var materializedViews []*storepb.MaterializedViewMetadata
 		var functions []*storepb.FunctionMetadata
 		var sequences []*storepb.SequenceMetadata
+		var enumTypes []*storepb.EnumTypeMetadata
 		var exists bool
 		if tables, exists = tableMap[schemaName]; !exists {
 			tables = []*storepb.TableMetadata{}
@@ -156,6 +161,9 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		if sequences, exists = sequenceMap[schemaName]; !exists {
 			sequences = []*storepb.SequenceMetadata{}
 		}
+		if enumTypes, exists = enumTypeMap[schemaName]; !exists {
+			enumTypes = []*storepb.EnumTypeMetadata{}
+		}
 		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
 			Name:              schemaName,
 			Tables:            tables,
@@ -164,6 +172,7 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 			Functions:         functions,
 			Sequences:         sequences,
 			MaterializedViews: materializedViews,
+			EnumTypes:         enumTypes,
 		})
 	}
 	databaseMetadata.Extensions = extensions
@@ -384,6 +393,10 @@ func getTables(txn *sql.Tx, isAtLeastPG10 bool) (map[string][]*storepb.TableMeta
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to get foreign keys")
 	}
+	triggerMap, err := getTriggers(txn)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get triggers")
+	}
 	foreignTablesMap, err := getForeignTables(txn, columnMap)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to get foreign tables")
@@ -414,6 +427,7 @@ func getTables(txn *sql.Tx, isAtLeastPG10 bool) (map[string][]*storepb.TableMeta
 		table.Columns = columnMap[key]
 		table.Indexes = indexMap[key]
 		table.ForeignKeys = foreignKeysMap[key]
+		table.Triggers = triggerMap[key]
 
 		tableMap[schemaName] = append(tableMap[schemaName], table)
 	}
@@ -800,6 +814,80 @@ func getSequences(txn *sql.Tx) (map[string][]*storepb.SequenceMetadata, error) {
 	return sequenceMap, nil
 }
 
+var listTriggerQuery = `
+SELECT n.nspname, c.relname, t.tgname, pg_get_triggerdef(t.oid, true)
+FROM pg_trigger t
+	JOIN pg_class c ON c.oid = t.tgrelid
+	JOIN pg_namespace n ON n.oid = c.relnamespace` + fmt.Sprintf(`
+WHERE n.nspname NOT IN (%s)
+	AND NOT t.tgisinternal
+ORDER BY n.nspname, c.relname, t.tgname;`, pgparser.SystemSchemaWhereClause)
+
+// getTriggers gets all triggers of a database.
+func getTriggers(txn *sql.Tx) (map[db.TableKey][]*storepb.TriggerMetadata, error) {
+	triggerMap := make(map[db.TableKey][]*storepb.TriggerMetadata)
+	rows, err := txn.Query(listTriggerQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		trigger := &storepb.TriggerMetadata{}
+		var schemaName, tableName string
+		if err := rows.Scan(&schemaName, &tableName, &trigger.Name, &trigger.Definition); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		triggerMap[key] = append(triggerMap[key], trigger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return triggerMap, nil
+}
+
+var listEnumTypeQuery = `
+SELECT n.nspname, t.typname, e.enumlabel, obj_description(t.oid, 'pg_type')
+FROM pg_type t
+	JOIN pg_namespace n ON n.oid = t.typnamespace
+	JOIN pg_enum e ON e.enumtypid = t.oid` + fmt.Sprintf(`
+WHERE n.nspname NOT IN (%s)
+ORDER BY n.nspname, t.typname, e.enumsortorder;`, pgparser.SystemSchemaWhereClause)
+
+// getEnumTypes gets all enum types of a database.
+func getEnumTypes(txn *sql.Tx) (map[string][]*storepb.EnumTypeMetadata, error) {
+	enumTypeMap := make(map[string][]*storepb.EnumTypeMetadata)
+	rows, err := txn.Query(listEnumTypeQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var schemaName, typeName, label string
+		var comment sql.NullString
+		if err := rows.Scan(&schemaName, &typeName, &label, &comment); err != nil {
+			return nil, err
+		}
+		// Labels of the same type come in a row ordered by their sort order.
+		enumTypes := enumTypeMap[schemaName]
+		if len(enumTypes) == 0 || enumTypes[len(enumTypes)-1].Name != typeName {
+			enumTypes = append(enumTypes, &storepb.EnumTypeMetadata{
+				Name:    typeName,
+				Comment: comment.String,
+			})
+		}
+		enumType := enumTypes[len(enumTypes)-1]
+		enumType.Values = append(enumType.Values, label)
+		enumTypeMap[schemaName] = enumTypes
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return enumTypeMap, nil
+}
+
 var listIndexQuery = `
 SELECT idx.schemaname, idx.tablename, idx.indexname, idx.indexdef, (SELECT 1
 	FROM information_schema.table_constraints
@@ -0,0 +1,146 @@
+package util
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// cursorEngines are the engines supporting the server-side cursor, mapped to whether the cursor runs in a READ ONLY transaction.
+// The cursor runs in the same transaction as the queries of the engine, and the rows are streamed over the dedicated connection.
+// Oracle, MSSQL and Snowflake drivers do not support READ ONLY transactions, so their cursors run in a transaction rolled back on close.
+// The other engines, e.g. MariaDB 5.5 and TiDB, return the query results with the limit as usual.
+var cursorEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:     true,
+	storepb.Engine_POSTGRES:  true,
+	storepb.Engine_ORACLE:    false,
+	storepb.Engine_MSSQL:     false,
+	storepb.Engine_SNOWFLAKE: false,
+}
+
+// SupportCursor returns whether the engine supports fetching the query results page by page with a server-side cursor.
+func SupportCursor(engine storepb.Engine) bool {
+	_, ok := cursorEngines[engine]
+	return ok
+}
+
+// Cursor is a server-side cursor over the rows of a single query.
+// The rows are streamed from the database server as they are fetched, so that a large result set is never loaded at once.
+// A cursor holds the connection and the transaction until it is closed, and it is not safe for concurrent use.
+type Cursor struct {
+	dbType          storepb.Engine
+	statement       string
+	tx              *sql.Tx
+	rows            *sql.Rows
+	columnNames     []string
+	columnTypeNames []string
+	// pending is true if the rows have been advanced to a row not fetched yet.
+	pending bool
+	done    bool
+}
+
+// OpenCursor executes the statement and returns the cursor over its rows.
+// The context is bound to the cursor, canceling it aborts the query and invalidates the cursor.
+func OpenCursor(ctx context.Context, dbType storepb.Engine, conn *sql.Conn, statement string) (*Cursor, error) {
+	statement = strings.TrimLeft(strings.TrimRight(statement, " \n\t;"), " \n\t")
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: cursorEngines[dbType]})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, statement)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, FormatErrorWithQuery(err, statement)
+	}
+	c := &Cursor{
+		dbType:    dbType,
+		statement: statement,
+		tx:        tx,
+		rows:      rows,
+	}
+	if err := c.readColumns(); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Cursor) readColumns() error {
+	columnNames, err := c.rows.Columns()
+	if err != nil {
+		return err
+	}
+	columnTypes, err := c.rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	c.columnNames = columnNames
+	for _, v := range columnTypes {
+		c.columnTypeNames = append(c.columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+	}
+	// The oracle driver will panic if there is no rows such as EXPLAIN PLAN FOR statement.
+	c.done = len(c.columnTypeNames) == 0
+	return nil
+}
+
+// Fetch reads at most limit rows from the cursor.
+// The page ends early if its size exceeds common.MaximumSQLResultSize, and the rest rows are left to the next fetch.
+func (c *Cursor) Fetch(limit int) (*v1pb.QueryResult, error) {
+	startTime := time.Now()
+	result := &v1pb.QueryResult{
+		ColumnNames:     c.columnNames,
+		ColumnTypeNames: c.columnTypeNames,
+		Statement:       c.statement,
+	}
+	for !c.done && len(result.Rows) < limit {
+		if !c.pending && !c.rows.Next() {
+			c.done = true
+			break
+		}
+		c.pending = false
+		row, err := scanRow(c.dbType, c.rows, c.columnTypeNames)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, row)
+		n := len(result.Rows)
+		if (n&(n-1) == 0) && proto.Size(result) > common.MaximumSQLResultSize {
+			break
+		}
+	}
+	// Look ahead so that the caller knows whether there are more rows without fetching an empty page.
+	if !c.done && !c.pending {
+		c.pending = c.rows.Next()
+		c.done = !c.pending
+	}
+	if c.done {
+		if err := c.rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	result.Latency = durationpb.New(time.Since(startTime))
+	return result, nil
+}
+
+// Done returns whether all the rows have been fetched.
+func (c *Cursor) Done() bool {
+	return c.done
+}
+
+// Close closes the rows and rolls back the transaction.
+func (c *Cursor) Close() error {
+	err := c.rows.Close()
+	if rollbackErr := c.tx.Rollback(); rollbackErr != nil && err == nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+		err = rollbackErr
+	}
+	return err
+}
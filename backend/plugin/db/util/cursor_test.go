@@ -0,0 +1,65 @@
+package util
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCursor(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	a.NoError(err)
+	defer sqlDB.Close()
+	conn, err := sqlDB.Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, "CREATE TABLE t(id INTEGER, name TEXT); INSERT INTO t VALUES (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd'), (5, 'e');")
+	a.NoError(err)
+
+	// The sqlite driver ignores the READ ONLY transaction option.
+	cursor, err := OpenCursor(ctx, storepb.Engine_MYSQL, conn, " SELECT id, name FROM t ORDER BY id;\n")
+	a.NoError(err)
+	defer cursor.Close()
+
+	var ids []int64
+	var pages int
+	for !cursor.Done() {
+		result, err := cursor.Fetch(2)
+		a.NoError(err)
+		a.Equal([]string{"id", "name"}, result.ColumnNames)
+		a.Equal("SELECT id, name FROM t ORDER BY id", result.Statement)
+		a.LessOrEqual(len(result.Rows), 2)
+		for _, row := range result.Rows {
+			ids = append(ids, row.Values[0].GetInt64Value())
+		}
+		pages++
+	}
+	a.Equal([]int64{1, 2, 3, 4, 5}, ids)
+	// The last page is not empty because the cursor looks ahead.
+	a.Equal(3, pages)
+
+	result, err := cursor.Fetch(2)
+	a.NoError(err)
+	a.Empty(result.Rows)
+	a.NoError(cursor.Close())
+
+	// The engines without READ ONLY transactions open the cursor in a transaction rolled back on close.
+	a.True(SupportCursor(storepb.Engine_ORACLE))
+	a.True(SupportCursor(storepb.Engine_MSSQL))
+	a.True(SupportCursor(storepb.Engine_SNOWFLAKE))
+	a.False(SupportCursor(storepb.Engine_TIDB))
+	cursor, err = OpenCursor(ctx, storepb.Engine_MSSQL, conn, "SELECT id FROM t ORDER BY id")
+	a.NoError(err)
+	result, err = cursor.Fetch(10)
+	a.NoError(err)
+	a.Len(result.Rows, 5)
+	a.True(cursor.Done())
+	a.NoError(cursor.Close())
+}
@@ -23,6 +23,7 @@ var (
 	affectedRows            = make(map[storepb.Engine]GetAffectedRowsFunc)
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
+	rowFilterAppliers       = make(map[storepb.Engine]ApplyRowFiltersFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, error)
@@ -43,6 +44,10 @@ type TransformDMLToSelectFunc func(statement string, sourceDatabase string, targ
 
 type GenerateRestoreSQLFunc func(statement string, backupDatabase string, backupTable string, originalDatabase string, originalTable string) (string, error)
 
+// ApplyRowFiltersFunc is the interface of rewriting the query so that the filtered tables are replaced by the subqueries with the predicates.
+// It returns the rewritten statement and the filters applied, the predicates are validated to be single expressions.
+type ApplyRowFiltersFunc func(statement string, database string, schema string, filters []*RowFilter, ignoreCaseSensitive bool) (string, []*RowFilter, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -250,3 +255,28 @@ func GenerateRestoreSQL(engine storepb.Engine, statement string, backupDatabase
 	}
 	return f(statement, backupDatabase, backupTable, originalDatabase, originalTable)
 }
+
+// RegisterApplyRowFilters registers the applyRowFilters function for the engine.
+func RegisterApplyRowFilters(engine storepb.Engine, f ApplyRowFiltersFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterAppliers[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterAppliers[engine] = f
+}
+
+// SupportRowFilters returns whether the engine supports the row filters.
+func SupportRowFilters(engine storepb.Engine) bool {
+	_, ok := rowFilterAppliers[engine]
+	return ok
+}
+
+// ApplyRowFilters rewrites the query with the row filters.
+func ApplyRowFilters(engine storepb.Engine, statement string, database string, schema string, filters []*RowFilter, ignoreCaseSensitive bool) (string, []*RowFilter, error) {
+	f, ok := rowFilterAppliers[engine]
+	if !ok {
+		return "", nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, database, schema, filters, ignoreCaseSensitive)
+}
@@ -34,3 +34,12 @@ func (r SchemaResource) Pretty() string {
 	}
 	return strings.Join(list, ".")
 }
+
+// RowFilter is the predicate restricting the rows of a table that can be queried.
+type RowFilter struct {
+	Database string
+	Schema   string
+	Table    string
+	// Predicate is the boolean expression in the dialect of the engine, such as "region = 'EU'".
+	Predicate string
+}
@@ -190,6 +190,49 @@ func (l *AffectedRowsListener) EnterDropTable(ctx *mysql.DropTableContext) {
 	}
 }
 
+// GetAlterTableActionTypes returns the types of the actions that drop or rename the objects in the ALTER TABLE statement,
+// e.g. DROP_COLUMN for `ALTER TABLE t DROP COLUMN c`, whose statement type is ALTER_TABLE.
+func GetAlterTableActionTypes(stmt *ParseResult) []string {
+	l := &alterTableActionListener{}
+	antlr.ParseTreeWalkerDefault.Walk(l, stmt.Tree)
+	return l.types
+}
+
+type alterTableActionListener struct {
+	*mysql.BaseMySQLParserListener
+
+	types []string
+}
+
+// EnterAlterListItem is called when production alterListItem is entered.
+func (l *alterTableActionListener) EnterAlterListItem(ctx *mysql.AlterListItemContext) {
+	switch {
+	case ctx.DROP_SYMBOL() != nil && ctx.ALTER_SYMBOL() == nil:
+		switch {
+		case ctx.KeyOrIndex() != nil:
+			l.types = append(l.types, "DROP_INDEX")
+		case ctx.FOREIGN_SYMBOL() != nil, ctx.PRIMARY_SYMBOL() != nil, ctx.CHECK_SYMBOL() != nil, ctx.CONSTRAINT_SYMBOL() != nil:
+			l.types = append(l.types, "DROP_CONSTRAINT")
+		default:
+			l.types = append(l.types, "DROP_COLUMN")
+		}
+	case ctx.RENAME_SYMBOL() != nil:
+		switch {
+		case ctx.COLUMN_SYMBOL() != nil:
+			l.types = append(l.types, "RENAME_COLUMN")
+		case ctx.KeyOrIndex() != nil:
+			l.types = append(l.types, "RENAME_INDEX")
+		default:
+			l.types = append(l.types, "RENAME_TABLE")
+		}
+	case ctx.CHANGE_SYMBOL() != nil:
+		// CHANGE COLUMN renames the column if the new name differs.
+		if NormalizeMySQLColumnInternalRef(ctx.ColumnInternalRef()) != NormalizeMySQLIdentifier(ctx.Identifier()) {
+			l.types = append(l.types, "RENAME_COLUMN")
+		}
+	}
+}
+
 // GetStatementType return the type of statement.
 func GetStatementType(stmt *ParseResult) string {
 	for _, child := range stmt.Tree.GetChildren() {
@@ -0,0 +1,188 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterApplyRowFilters(storepb.Engine_MYSQL, ApplyRowFilters)
+}
+
+// ApplyRowFilters rewrites the query so that every filtered table is replaced by the subquery with the predicate,
+// for example, `SELECT * FROM t` with the predicate `region = 'EU'` becomes
+// `SELECT * FROM (SELECT * FROM t WHERE (region = 'EU')) AS t`.
+func ApplyRowFilters(statement string, database string, _ string, filters []*base.RowFilter, _ bool) (string, []*base.RowFilter, error) {
+	for _, filter := range filters {
+		if err := validateRowFilterPredicate(filter.Predicate); err != nil {
+			return "", nil, errors.Wrapf(err, "invalid predicate %q of table %s.%s", filter.Predicate, filter.Database, filter.Table)
+		}
+	}
+	if len(filters) == 0 {
+		return statement, nil, nil
+	}
+
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return "", nil, err
+	}
+
+	listener := &rowFilterListener{
+		database: database,
+		filters:  filters,
+		applied:  make(map[*base.RowFilter]bool),
+	}
+	var buf strings.Builder
+	for _, stmt := range list {
+		listener.rewriter = antlr.NewTokenStreamRewriter(stmt.Tokens)
+		antlr.ParseTreeWalkerDefault.Walk(listener, stmt.Tree)
+		if listener.err != nil {
+			return "", nil, listener.err
+		}
+		if _, err := buf.WriteString(listener.rewriter.GetTextDefault()); err != nil {
+			return "", nil, err
+		}
+	}
+
+	var applied []*base.RowFilter
+	for _, filter := range filters {
+		if listener.applied[filter] {
+			applied = append(applied, filter)
+		}
+	}
+	return buf.String(), applied, nil
+}
+
+type rowFilterListener struct {
+	*parser.BaseMySQLParserListener
+
+	rewriter *antlr.TokenStreamRewriter
+	database string
+	filters  []*base.RowFilter
+	applied  map[*base.RowFilter]bool
+	err      error
+}
+
+// EnterCommonTableExpression rejects the common table expressions shadowing the filtered tables,
+// otherwise a reference to the real table could be mistaken for the common table expression.
+func (l *rowFilterListener) EnterCommonTableExpression(ctx *parser.CommonTableExpressionContext) {
+	if l.err != nil {
+		return
+	}
+	name := NormalizeMySQLIdentifier(ctx.Identifier())
+	for _, filter := range l.filters {
+		if strings.EqualFold(filter.Table, name) {
+			l.err = errors.Errorf("common table expression %q shadows the table %q with row filter", name, filter.Table)
+			return
+		}
+	}
+}
+
+func (l *rowFilterListener) EnterSingleTable(ctx *parser.SingleTableContext) {
+	if l.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx.TableRef())
+	if database == "" {
+		database = l.database
+	}
+	var predicates []string
+	for _, filter := range l.filters {
+		if strings.EqualFold(filter.Database, database) && strings.EqualFold(filter.Table, table) {
+			predicates = append(predicates, fmt.Sprintf("(%s)", filter.Predicate))
+			l.applied[filter] = true
+		}
+	}
+	if len(predicates) == 0 {
+		return
+	}
+
+	alias := fmt.Sprintf("`%s`", table)
+	if ctx.TableAlias() != nil {
+		alias = ctx.TableAlias().Identifier().GetText()
+	}
+	var source strings.Builder
+	_, _ = source.WriteString(l.rewriter.GetTokenStream().GetTextFromInterval(ctx.TableRef().GetSourceInterval()))
+	if ctx.UsePartition() != nil {
+		_, _ = source.WriteString(" ")
+		_, _ = source.WriteString(l.rewriter.GetTokenStream().GetTextFromInterval(ctx.UsePartition().GetSourceInterval()))
+	}
+	if ctx.IndexHintList() != nil {
+		_, _ = source.WriteString(" ")
+		_, _ = source.WriteString(l.rewriter.GetTokenStream().GetTextFromInterval(ctx.IndexHintList().GetSourceInterval()))
+	}
+	l.rewriter.ReplaceDefault(
+		ctx.GetStart().GetTokenIndex(),
+		ctx.GetStop().GetTokenIndex(),
+		fmt.Sprintf("(SELECT * FROM %s WHERE %s) AS %s", source.String(), strings.Join(predicates, " OR "), alias),
+	)
+}
+
+// validateRowFilterPredicate validates that the predicate is a single expression,
+// so that it cannot escape from the WHERE clause it's placed in.
+func validateRowFilterPredicate(predicate string) error {
+	if strings.TrimSpace(predicate) == "" {
+		return errors.Errorf("predicate is empty")
+	}
+	list, err := ParseMySQL(fmt.Sprintf("SELECT (%s)", predicate))
+	if err != nil {
+		return err
+	}
+	if len(list) != 1 {
+		return errors.Errorf("predicate must be a single expression")
+	}
+	listener := &selectItemListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, list[0].Tree)
+	item := listener.item
+	if item == nil || item.SelectAlias() != nil || item.Expr() == nil {
+		return errors.Errorf("predicate must be a single expression")
+	}
+	// The parenthesis enclosing the predicate must be closed by the expression end,
+	// otherwise the predicate breaks out of it, e.g. `1) OR (1`.
+	depth := 0
+	for _, token := range list[0].Tokens.GetAllTokens()[item.GetStart().GetTokenIndex() : item.GetStop().GetTokenIndex()+1] {
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		switch token.GetTokenType() {
+		case parser.MySQLParserOPEN_PAR_SYMBOL:
+			depth++
+		case parser.MySQLParserCLOSE_PAR_SYMBOL:
+			depth--
+			if depth == 0 && token.GetTokenIndex() != item.GetStop().GetTokenIndex() {
+				return errors.Errorf("predicate must be a single expression")
+			}
+		default:
+		}
+	}
+	// The expression must end the statement.
+	for _, token := range list[0].Tokens.GetAllTokens()[item.GetStop().GetTokenIndex()+1:] {
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		if token.GetTokenType() != parser.MySQLParserSEMICOLON_SYMBOL && token.GetTokenType() != antlr.TokenEOF {
+			return errors.Errorf("predicate must be a single expression")
+		}
+	}
+	return nil
+}
+
+type selectItemListener struct {
+	*parser.BaseMySQLParserListener
+
+	item *parser.SelectItemContext
+}
+
+func (l *selectItemListener) EnterSelectItem(ctx *parser.SelectItemContext) {
+	if l.item == nil {
+		l.item = ctx
+	}
+}
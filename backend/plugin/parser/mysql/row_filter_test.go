@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestApplyRowFilters(t *testing.T) {
+	orders := &base.RowFilter{Database: "db", Table: "orders", Predicate: "region = 'EU'"}
+	users := &base.RowFilter{Database: "other", Table: "users", Predicate: "tenant_id = 1"}
+	tests := []struct {
+		statement string
+		want      string
+		applied   []*base.RowFilter
+	}{
+		{
+			statement: "SELECT * FROM orders",
+			want:      "SELECT * FROM (SELECT * FROM orders WHERE (region = 'EU')) AS `orders`;",
+			applied:   []*base.RowFilter{orders},
+		},
+		{
+			statement: "SELECT o.id FROM db.orders AS o USE INDEX (idx) JOIN other.users u ON o.uid = u.id WHERE o.id > 1",
+			want:      "SELECT o.id FROM (SELECT * FROM db.orders USE INDEX (idx) WHERE (region = 'EU')) AS o JOIN (SELECT * FROM other.users WHERE (tenant_id = 1)) AS u ON o.uid = u.id WHERE o.id > 1;",
+			applied:   []*base.RowFilter{orders, users},
+		},
+		{
+			statement: "SELECT * FROM t WHERE id IN (SELECT id FROM `orders`)",
+			want:      "SELECT * FROM t WHERE id IN (SELECT id FROM (SELECT * FROM `orders` WHERE (region = 'EU')) AS `orders`);",
+			applied:   []*base.RowFilter{orders},
+		},
+		{
+			statement: "SELECT * FROM other.orders",
+			want:      "SELECT * FROM other.orders;",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, applied, err := ApplyRowFilters(test.statement, "db", "", []*base.RowFilter{orders, users}, false)
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+		a.Equal(test.applied, applied, test.statement)
+	}
+
+	_, _, err := ApplyRowFilters("WITH orders AS (SELECT 1) SELECT * FROM orders", "db", "", []*base.RowFilter{orders}, false)
+	a.Error(err)
+}
+
+func TestValidateRowFilterPredicate(t *testing.T) {
+	tests := []struct {
+		predicate string
+		valid     bool
+	}{
+		{predicate: "region = 'EU'", valid: true},
+		{predicate: "region IN ('EU', 'US') AND deleted_at IS NULL", valid: true},
+		{predicate: "", valid: false},
+		{predicate: "1) OR (1", valid: false},
+		{predicate: "(a = 1) OR (b = 2)", valid: true},
+		{predicate: "1) AS x, (1", valid: false},
+		{predicate: "1) FROM t UNION SELECT (1", valid: false},
+		{predicate: "1); DROP TABLE t; SELECT (1", valid: false},
+		{predicate: "1 -- ", valid: false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		err := validateRowFilterPredicate(test.predicate)
+		if test.valid {
+			a.NoError(err, test.predicate)
+		} else {
+			a.Error(err, test.predicate)
+		}
+	}
+}
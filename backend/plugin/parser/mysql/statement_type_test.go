@@ -59,3 +59,28 @@ func TestGetStatementType(t *testing.T) {
 		a.NoError(err)
 	}
 }
+
+func TestGetAlterTableActionTypes(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{statement: "ALTER TABLE t ADD COLUMN c INT", want: nil},
+		{statement: "ALTER TABLE t DROP COLUMN c", want: []string{"DROP_COLUMN"}},
+		{statement: "ALTER TABLE t DROP c, DROP INDEX idx", want: []string{"DROP_COLUMN", "DROP_INDEX"}},
+		{statement: "ALTER TABLE t DROP PRIMARY KEY, DROP FOREIGN KEY fk", want: []string{"DROP_CONSTRAINT", "DROP_CONSTRAINT"}},
+		{statement: "ALTER TABLE t ALTER COLUMN c DROP DEFAULT", want: nil},
+		{statement: "ALTER TABLE t RENAME COLUMN a TO b, RENAME INDEX i1 TO i2", want: []string{"RENAME_COLUMN", "RENAME_INDEX"}},
+		{statement: "ALTER TABLE t RENAME TO t2", want: []string{"RENAME_TABLE"}},
+		{statement: "ALTER TABLE t CHANGE a b INT, CHANGE c c BIGINT", want: []string{"RENAME_COLUMN"}},
+		{statement: "DROP TABLE t", want: nil},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		stmts, err := ParseMySQL(test.statement)
+		a.NoError(err)
+		a.Len(stmts, 1)
+		a.Equal(test.want, GetAlterTableActionTypes(stmts[0]), test.statement)
+	}
+}
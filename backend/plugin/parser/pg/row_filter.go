@@ -0,0 +1,220 @@
+package pg
+
+import (
+	"fmt"
+
+	pgquery "github.com/pganalyze/pg_query_go/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterApplyRowFilters(storepb.Engine_POSTGRES, ApplyRowFilters)
+}
+
+// ApplyRowFilters rewrites the query so that every filtered table is replaced by the subquery with the predicate,
+// for example, `SELECT * FROM t` with the predicate `region = 'EU'` becomes
+// `SELECT * FROM (SELECT * FROM public.t WHERE region = 'EU') t`.
+// The unqualified tables are resolved in the schema, which is the current schema of the session by the search path,
+// and the filtered tables are qualified with it so that the subquery reads the same table the filter is resolved to.
+func ApplyRowFilters(statement string, database string, schema string, filters []*base.RowFilter, _ bool) (string, []*base.RowFilter, error) {
+	predicates := make(map[*base.RowFilter]*pgquery.Node)
+	for _, filter := range filters {
+		predicate, err := parseRowFilterPredicate(filter.Predicate)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "invalid predicate %q of table %s.%s.%s", filter.Predicate, filter.Database, filter.Schema, filter.Table)
+		}
+		predicates[filter] = predicate
+	}
+	if len(filters) == 0 {
+		return statement, nil, nil
+	}
+	if schema == "" {
+		return "", nil, errors.Errorf("schema is required to resolve the unqualified tables")
+	}
+
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Collect the nodes before rewriting, so that the subqueries we generate are not visited again.
+	var selects []*pgquery.SelectStmt
+	var joins []*pgquery.JoinExpr
+	var ctes []*pgquery.CommonTableExpr
+	walkPGNode(tree.ProtoReflect(), func(m proto.Message) {
+		switch n := m.(type) {
+		case *pgquery.SelectStmt:
+			selects = append(selects, n)
+		case *pgquery.JoinExpr:
+			joins = append(joins, n)
+		case *pgquery.CommonTableExpr:
+			ctes = append(ctes, n)
+		}
+	})
+	for _, cte := range ctes {
+		for _, filter := range filters {
+			if cte.Ctename == filter.Table {
+				return "", nil, errors.Errorf("common table expression %q shadows the table %q with row filter", cte.Ctename, filter.Table)
+			}
+		}
+	}
+
+	applied := make(map[*base.RowFilter]bool)
+	rewrite := func(node *pgquery.Node) *pgquery.Node {
+		rangeVar := node.GetRangeVar()
+		if rangeVar == nil {
+			return node
+		}
+		rangeDatabase, rangeSchema := rangeVar.Catalogname, rangeVar.Schemaname
+		if rangeDatabase == "" {
+			rangeDatabase = database
+		}
+		if rangeSchema == "" {
+			rangeSchema = schema
+		}
+		var where *pgquery.Node
+		for _, filter := range filters {
+			if filter.Database != rangeDatabase || filter.Schema != rangeSchema || filter.Table != rangeVar.Relname {
+				continue
+			}
+			applied[filter] = true
+			predicate := proto.Clone(predicates[filter]).(*pgquery.Node)
+			if where == nil {
+				where = predicate
+			} else {
+				where = pgquery.MakeBoolExprNode(pgquery.BoolExprType_OR_EXPR, []*pgquery.Node{where, predicate}, 0)
+			}
+		}
+		if where == nil {
+			return node
+		}
+
+		alias := rangeVar.Alias
+		if alias == nil {
+			alias = &pgquery.Alias{Aliasname: rangeVar.Relname}
+		}
+		source := proto.Clone(rangeVar).(*pgquery.RangeVar)
+		source.Schemaname = rangeSchema
+		source.Alias = nil
+		return &pgquery.Node{
+			Node: &pgquery.Node_RangeSubselect{
+				RangeSubselect: &pgquery.RangeSubselect{
+					Subquery: &pgquery.Node{
+						Node: &pgquery.Node_SelectStmt{
+							SelectStmt: &pgquery.SelectStmt{
+								TargetList:  []*pgquery.Node{pgquery.MakeResTargetNodeWithVal(pgquery.MakeColumnRefNode([]*pgquery.Node{pgquery.MakeAStarNode()}, 0), 0)},
+								FromClause:  []*pgquery.Node{{Node: &pgquery.Node_RangeVar{RangeVar: source}}},
+								WhereClause: where,
+								LimitOption: pgquery.LimitOption_LIMIT_OPTION_DEFAULT,
+								Op:          pgquery.SetOperation_SETOP_NONE,
+							},
+						},
+					},
+					Alias: alias,
+				},
+			},
+		}
+	}
+	for _, stmt := range selects {
+		for i, node := range stmt.FromClause {
+			stmt.FromClause[i] = rewrite(node)
+		}
+	}
+	for _, join := range joins {
+		join.Larg = rewrite(join.Larg)
+		join.Rarg = rewrite(join.Rarg)
+	}
+
+	if len(applied) == 0 {
+		return statement, nil, nil
+	}
+	result, err := pgquery.Deparse(tree)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to deparse statement")
+	}
+	var appliedFilters []*base.RowFilter
+	for _, filter := range filters {
+		if applied[filter] {
+			appliedFilters = append(appliedFilters, filter)
+		}
+	}
+	return result, appliedFilters, nil
+}
+
+// parseRowFilterPredicate parses the predicate and validates that it's a single expression,
+// so that it cannot escape from the WHERE clause it's placed in.
+func parseRowFilterPredicate(predicate string) (*pgquery.Node, error) {
+	statement := fmt.Sprintf("SELECT (%s)", predicate)
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+	// The parenthesis enclosing the predicate must be closed by the last token,
+	// otherwise the predicate breaks out of it, e.g. `1) OR (1`.
+	scan, err := pgquery.Scan(statement)
+	if err != nil {
+		return nil, err
+	}
+	depth := 0
+	for i, token := range scan.Tokens {
+		switch token.Token {
+		case pgquery.Token_ASCII_40:
+			depth++
+		case pgquery.Token_ASCII_41:
+			depth--
+			if depth == 0 && i != len(scan.Tokens)-1 {
+				return nil, errors.Errorf("predicate must be a single expression")
+			}
+		default:
+		}
+	}
+	if len(tree.Stmts) != 1 {
+		return nil, errors.Errorf("predicate must be a single expression")
+	}
+	stmt := tree.Stmts[0].Stmt.GetSelectStmt()
+	if stmt == nil || len(stmt.TargetList) != 1 {
+		return nil, errors.Errorf("predicate must be a single expression")
+	}
+	target := stmt.TargetList[0].GetResTarget()
+	if target == nil || target.Name != "" || target.Val == nil {
+		return nil, errors.Errorf("predicate must be a single expression")
+	}
+
+	// The statement must contain nothing but the expression, the same as the bare `SELECT`.
+	empty, err := pgquery.Parse("SELECT")
+	if err != nil {
+		return nil, err
+	}
+	stmt = proto.Clone(stmt).(*pgquery.SelectStmt)
+	stmt.TargetList = nil
+	if !proto.Equal(stmt, empty.Stmts[0].Stmt.GetSelectStmt()) {
+		return nil, errors.Errorf("predicate must be a single expression")
+	}
+	return target.Val, nil
+}
+
+// walkPGNode calls fn for every message in the tree in pre-order.
+func walkPGNode(m protoreflect.Message, fn func(proto.Message)) {
+	fn(m.Interface())
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				walkPGNode(list.Get(i).Message(), fn)
+			}
+		case fd.IsMap():
+		default:
+			walkPGNode(v.Message(), fn)
+		}
+		return true
+	})
+}
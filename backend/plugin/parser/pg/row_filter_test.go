@@ -0,0 +1,86 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestApplyRowFilters(t *testing.T) {
+	orders := &base.RowFilter{Database: "db", Schema: "public", Table: "orders", Predicate: "region = 'EU'"}
+	users := &base.RowFilter{Database: "db", Schema: "crm", Table: "users", Predicate: "tenant_id = 1"}
+	tests := []struct {
+		statement string
+		want      string
+		applied   []*base.RowFilter
+	}{
+		{
+			statement: "SELECT * FROM orders",
+			want:      "SELECT * FROM (SELECT * FROM public.orders WHERE region = 'EU') orders",
+			applied:   []*base.RowFilter{orders},
+		},
+		{
+			statement: "SELECT o.id FROM public.orders o JOIN crm.users u ON o.uid = u.id WHERE o.id > 1",
+			want:      "SELECT o.id FROM (SELECT * FROM public.orders WHERE region = 'EU') o JOIN (SELECT * FROM crm.users WHERE tenant_id = 1) u ON o.uid = u.id WHERE o.id > 1",
+			applied:   []*base.RowFilter{orders, users},
+		},
+		{
+			statement: "SELECT * FROM t WHERE id IN (SELECT id FROM orders UNION SELECT id FROM crm.users)",
+			want:      "SELECT * FROM t WHERE id IN (SELECT id FROM (SELECT * FROM public.orders WHERE region = 'EU') orders UNION SELECT id FROM (SELECT * FROM crm.users WHERE tenant_id = 1) users)",
+			applied:   []*base.RowFilter{orders, users},
+		},
+		{
+			statement: "SELECT * FROM crm.orders",
+			want:      "SELECT * FROM crm.orders",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, applied, err := ApplyRowFilters(test.statement, "db", "public", []*base.RowFilter{orders, users}, false)
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+		a.Equal(test.applied, applied, test.statement)
+	}
+
+	_, _, err := ApplyRowFilters("WITH orders AS (SELECT 1) SELECT * FROM orders", "db", "public", []*base.RowFilter{orders}, false)
+	a.Error(err)
+
+	// The unqualified tables are resolved in the current schema of the search path.
+	got, applied, err := ApplyRowFilters("SELECT * FROM orders, users", "db", "crm", []*base.RowFilter{orders, users}, false)
+	a.NoError(err)
+	a.Equal("SELECT * FROM orders, (SELECT * FROM crm.users WHERE tenant_id = 1) users", got)
+	a.Equal([]*base.RowFilter{users}, applied)
+
+	_, _, err = ApplyRowFilters("SELECT * FROM orders", "db", "", []*base.RowFilter{orders}, false)
+	a.Error(err)
+}
+
+func TestParseRowFilterPredicate(t *testing.T) {
+	tests := []struct {
+		predicate string
+		valid     bool
+	}{
+		{predicate: "region = 'EU'", valid: true},
+		{predicate: "region IN ('EU', 'US') AND deleted_at IS NULL", valid: true},
+		{predicate: "", valid: false},
+		{predicate: "1) OR (1", valid: false},
+		{predicate: "(a = 1) OR (b = 2)", valid: true},
+		{predicate: "1) AS x, (1", valid: false},
+		{predicate: "1) FROM t UNION SELECT (1", valid: false},
+		{predicate: "1); DROP TABLE t; SELECT (1", valid: false},
+		{predicate: "1 -- ", valid: false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := parseRowFilterPredicate(test.predicate)
+		if test.valid {
+			a.NoError(err, test.predicate)
+		} else {
+			a.Error(err, test.predicate)
+		}
+	}
+}
@@ -288,20 +288,17 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 
 	values := &url.Values{}
 	values.Set("api-version", "7.0")
+	// Azure DevOps item paths are absolute.
+	if !strings.HasPrefix(filePath, "/") {
+		filePath = "/" + filePath
+	}
 	values.Set("download", "false")
 	values.Set("resolveLfs", "true")
 	values.Set("includeContent", "true")
 	values.Set("path", filePath)
-	var refType string
-	switch refInfo.RefType {
-	case vcs.RefTypeBranch:
-		refType = "branch"
-	case vcs.RefTypeTag:
-		refType = "tag"
-	case vcs.RefTypeCommit:
-		refType = "commit"
-	default:
-		return "", errors.Errorf("invalid ref type %q", refInfo.RefType)
+	refType, err := getVersionType(refInfo)
+	if err != nil {
+		return "", err
 	}
 	values.Set("versionDescriptor.versionType", refType)
 	values.Set("versionDescriptor.version", refInfo.RefName)
@@ -311,6 +308,9 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	if err != nil {
 		return "", errors.Wrapf(err, "GET %s", url)
 	}
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	}
 	if code != http.StatusOK {
 		return "", errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
 	}
@@ -362,6 +362,313 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 	}, nil
 }
 
+// emptyObjectID is the object ID used as the old object ID for creating refs.
+const emptyObjectID = "0000000000000000000000000000000000000000"
+
+// RefUpdate is the API message for Azure DevOps ref update.
+type RefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId,omitempty"`
+}
+
+// RefUpdateResult is the API message for Azure DevOps ref update result.
+type RefUpdateResult struct {
+	Name          string `json:"name"`
+	Success       bool   `json:"success"`
+	UpdateStatus  string `json:"updateStatus"`
+	CustomMessage string `json:"customMessage"`
+}
+
+// CreateBranch creates the branch from the commit in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	refUpdatePayload, err := json.Marshal([]*RefUpdate{
+		{
+			Name:        fmt.Sprintf("refs/heads/%s", branchName),
+			OldObjectID: emptyObjectID,
+			NewObjectID: commitID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/refs?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), refUpdatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusOK {
+		return errors.Errorf("non-200 POST %s status code %d with body %q", url, code, string(body))
+	}
+
+	var results struct {
+		Value []*RefUpdateResult `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &results); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal create branch response body")
+	}
+	for _, result := range results.Value {
+		if !result.Success {
+			return errors.Errorf("failed to create branch %s, status: %s, message: %s", result.Name, result.UpdateStatus, result.CustomMessage)
+		}
+	}
+	return nil
+}
+
+// PushItem is the API message for the item in Azure DevOps push change.
+type PushItem struct {
+	Path string `json:"path"`
+}
+
+// PushNewContent is the API message for the new content in Azure DevOps push change.
+type PushNewContent struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+// PushChange is the API message for Azure DevOps push change.
+type PushChange struct {
+	// ChangeType is "add", "edit" or "delete".
+	ChangeType string          `json:"changeType"`
+	Item       *PushItem       `json:"item"`
+	NewContent *PushNewContent `json:"newContent,omitempty"`
+}
+
+// PushCommit is the API message for Azure DevOps push commit.
+type PushCommit struct {
+	Comment string        `json:"comment"`
+	Changes []*PushChange `json:"changes"`
+}
+
+// Push is the API message for Azure DevOps push.
+type Push struct {
+	RefUpdates []*RefUpdate  `json:"refUpdates"`
+	Commits    []*PushCommit `json:"commits"`
+}
+
+// CreateCommit commits the files to the branch in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreateCommit(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	branch, err := p.GetBranch(ctx, repositoryID, commit.Branch)
+	if err != nil {
+		return err
+	}
+
+	pushCommit := &PushCommit{Comment: commit.Message}
+	for _, file := range commit.Files {
+		// Azure DevOps item paths are absolute.
+		filePath := file.Path
+		if !strings.HasPrefix(filePath, "/") {
+			filePath = "/" + filePath
+		}
+		if file.Delete {
+			pushCommit.Changes = append(pushCommit.Changes, &PushChange{
+				ChangeType: "delete",
+				Item:       &PushItem{Path: filePath},
+			})
+			continue
+		}
+		// Azure DevOps requires different change types for new and existing files.
+		exist, err := p.itemExists(ctx, apiURL, filePath, branch.LastCommitID)
+		if err != nil {
+			return err
+		}
+		changeType := "add"
+		if exist {
+			changeType = "edit"
+		}
+		pushCommit.Changes = append(pushCommit.Changes, &PushChange{
+			ChangeType: changeType,
+			Item:       &PushItem{Path: filePath},
+			NewContent: &PushNewContent{
+				Content:     file.Content,
+				ContentType: "rawtext",
+			},
+		})
+	}
+	pushPayload, err := json.Marshal(Push{
+		RefUpdates: []*RefUpdate{
+			{
+				Name:        fmt.Sprintf("refs/heads/%s", commit.Branch),
+				OldObjectID: branch.LastCommitID,
+			},
+		},
+		Commits: []*PushCommit{pushCommit},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating push")
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pushes?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), pushPayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("non-201 POST %s status code %d with body %q", url, code, string(body))
+	}
+	return nil
+}
+
+// getVersionType returns the version type of the ref in the version descriptor.
+func getVersionType(refInfo vcs.RefInfo) (string, error) {
+	switch refInfo.RefType {
+	case vcs.RefTypeBranch:
+		return "branch", nil
+	case vcs.RefTypeTag:
+		return "tag", nil
+	case vcs.RefTypeCommit:
+		return "commit", nil
+	default:
+		return "", errors.Errorf("invalid ref type %q", refInfo.RefType)
+	}
+}
+
+// Item is the API message for Azure DevOps git item.
+type Item struct {
+	Path     string `json:"path"`
+	IsFolder bool   `json:"isFolder"`
+}
+
+// ListDirectoryFile lists the paths of the files under the directory recursively.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/list?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) ListDirectoryFile(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("scopePath", "/"+strings.Trim(directory, "/"))
+	values.Set("recursionLevel", "full")
+	refType, err := getVersionType(refInfo)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("versionDescriptor.versionType", refType)
+	values.Set("versionDescriptor.version", refInfo.RefName)
+	url := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		// Azure DevOps returns 404 if the directory doesn't exist.
+		return nil, nil
+	}
+	if code != http.StatusOK {
+		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
+	}
+
+	var resp struct {
+		Value []*Item `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal body")
+	}
+	var files []string
+	for _, item := range resp.Value {
+		if item.IsFolder {
+			continue
+		}
+		// The paths are relative to the repository root like the other providers.
+		files = append(files, strings.TrimPrefix(item.Path, "/"))
+	}
+	return files, nil
+}
+
+// itemExists checks whether the file exists at the commit in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/get?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) itemExists(ctx context.Context, apiURL, filePath, commitID string) (bool, error) {
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("path", filePath)
+	values.Set("versionDescriptor.versionType", "commit")
+	values.Set("versionDescriptor.version", commitID)
+	url := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return false, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return false, nil
+	}
+	if code != http.StatusOK {
+		return false, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
+	}
+	return true, nil
+}
+
+// PullRequestCreate is the API message for creating an Azure DevOps pull request.
+type PullRequestCreate struct {
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+// PullRequest is the API message for Azure DevOps pull request.
+type PullRequest struct {
+	PullRequestID int         `json:"pullRequestId"`
+	Repository    *Repository `json:"repository"`
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, pullRequest *vcs.PullRequestCreate) (string, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return "", err
+	}
+
+	pullRequestCreatePayload, err := json.Marshal(PullRequestCreate{
+		SourceRefName: fmt.Sprintf("refs/heads/%s", pullRequest.Head),
+		TargetRefName: fmt.Sprintf("refs/heads/%s", pullRequest.Base),
+		Title:         pullRequest.Title,
+		Description:   pullRequest.Body,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullrequests?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), pullRequestCreatePayload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("non-201 POST %s status code %d with body %q", url, code, string(body))
+	}
+
+	res := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal create pull request response body")
+	}
+	if res.Repository == nil {
+		return "", errors.Errorf("missing repository in create pull request response body %q", string(body))
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", res.Repository.WebURL, res.PullRequestID), nil
+}
+
 // ListPullRequestFile lists the changed files by last merge commit id.
 func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, lastMergeCommitID string) ([]*vcs.PullRequestFile, error) {
 	changeResponse, err := p.getChangesByCommit(ctx, repositoryID, lastMergeCommitID)
@@ -385,8 +692,22 @@ type Comment struct {
 }
 
 type PullRequestThread struct {
-	Comments []*Comment `json:"comments"`
-	Status   string     `json:"status"`
+	Comments      []*Comment     `json:"comments"`
+	Status        string         `json:"status"`
+	ThreadContext *ThreadContext `json:"threadContext,omitempty"`
+}
+
+// ThreadContext is the API message for the file position of a pull request thread.
+type ThreadContext struct {
+	FilePath       string           `json:"filePath"`
+	RightFileStart *CommentPosition `json:"rightFileStart"`
+	RightFileEnd   *CommentPosition `json:"rightFileEnd"`
+}
+
+// CommentPosition is the API message for the position in a file.
+type CommentPosition struct {
+	Line   int `json:"line"`
+	Offset int `json:"offset"`
 }
 
 // CreatePullRequestComment creates a pull request comment.
@@ -427,6 +748,113 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// CreatePullRequestLineComment creates a pull request thread on the line of the file.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create?view=azure-devops-rest-7.1&tabs=HTTP
+func (p *Provider) CreatePullRequestLineComment(ctx context.Context, repositoryID, pullRequestID string, comment *vcs.PullRequestLineComment) error {
+	filePath := comment.Path
+	if !strings.HasPrefix(filePath, "/") {
+		filePath = "/" + filePath
+	}
+	thread := &PullRequestThread{
+		Status: "active",
+		Comments: []*Comment{
+			{
+				Content:     comment.Body,
+				CommentType: "text",
+			},
+		},
+		ThreadContext: &ThreadContext{
+			FilePath:       filePath,
+			RightFileStart: &CommentPosition{Line: comment.Line, Offset: 1},
+			RightFileEnd:   &CommentPosition{Line: comment.Line, Offset: 1},
+		},
+	}
+	threadCreatePayload, err := json.Marshal(thread)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request thread")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/threads?%s", apiURL, pullRequestID, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), threadCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusOK {
+		return errors.Errorf("failed to create thread, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Azure DevOps commit status.
+type CommitStatus struct {
+	State       string               `json:"state"`
+	Description string               `json:"description"`
+	TargetURL   string               `json:"targetUrl,omitempty"`
+	Context     *CommitStatusContext `json:"context"`
+}
+
+// CommitStatusContext is the API message for Azure DevOps commit status context.
+type CommitStatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre"`
+}
+
+// CreateCommitStatus creates a commit status.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/statuses/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	// Azure DevOps doesn't have a warning state.
+	state := "succeeded"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusFailure:
+		state = "failed"
+	}
+	// The context is formatted as "{genre}/{name}", e.g. "bytebase/sql-review".
+	genre, name := "", status.Context
+	if i := strings.LastIndex(status.Context, "/"); i >= 0 {
+		genre, name = status.Context[:i], status.Context[i+1:]
+	}
+	statusCreatePayload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		Description: status.Description,
+		TargetURL:   status.TargetURL,
+		Context: &CommitStatusContext{
+			Name:  name,
+			Genre: genre,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/commits/%s/statuses?%s", apiURL, commitID, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
 // CreateWebhook creates a webhook in the organization, and returns the webhook ID which can be used in PatchWebhook.
 // API Version 7.0 do not specify the OAuth scope for creating webhook explicitly, but it works.
 //
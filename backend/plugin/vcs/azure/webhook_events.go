@@ -24,7 +24,7 @@ type PullRequestResource struct {
 	Repository    *Repository       `json:"repository"`
 	Links         *PullRequestLinks `json:"_links"`
 	PullRequestID int               `json:"pullRequestId"`
-	// The pull request status, could be active, completed, etc. We only care the "completed" status.
+	// The pull request status, could be active, completed, etc. We only care the "active" and "completed" status.
 	Status        string `json:"status"`
 	Title         string `json:"title"`
 	Description   string `json:"description"`
@@ -33,7 +33,9 @@ type PullRequestResource struct {
 	// PR merge status, we only care the "succeeded".
 	MergeStatus     string                           `json:"mergeStatus"`
 	LastMergeCommit *PullRequestEventLastMergeCommit `json:"lastMergeCommit"`
-	CreatedBy       *PullRequestCreatedBy            `json:"createdBy"`
+	// LastMergeSourceCommit is the head commit of the source branch.
+	LastMergeSourceCommit *PullRequestEventLastMergeCommit `json:"lastMergeSourceCommit"`
+	CreatedBy             *PullRequestCreatedBy            `json:"createdBy"`
 }
 
 // PullRequestEvent is the API message for pull request webhook event.
@@ -43,6 +45,7 @@ type PullRequestEvent struct {
 	// ID is the webhook message id.
 	ID string `json:"id"`
 	// EventType should be "git.pullrequest.merged".
+	// It is sent whenever the merge commit is created, including the test merge of the active pull requests upon new pushes.
 	EventType string               `json:"eventType"`
 	Resource  *PullRequestResource `json:"resource"`
 }
@@ -233,6 +233,63 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return body, nil
 }
 
+// directoryListingMaxDepth is the maximum depth of the subdirectories to list.
+const directoryListingMaxDepth = 10
+
+// DirectoryEntry is the API message for an entry in the Bitbucket Cloud directory listing.
+type DirectoryEntry struct {
+	// Type is "commit_file" or "commit_directory".
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+// ListDirectoryFile lists the paths of the files under the directory recursively.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (p *Provider) ListDirectoryFile(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	var directoryPath string
+	if directory := strings.Trim(directory, "/"); directory != "" {
+		segments := strings.Split(directory, "/")
+		for i, segment := range segments {
+			segments[i] = url.PathEscape(segment)
+		}
+		directoryPath = strings.Join(segments, "/") + "/"
+	}
+	var files []string
+	next := fmt.Sprintf("%s/repositories/%s/src/%s/%s?max_depth=%d", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(refInfo.RefName), directoryPath, directoryListingMaxDepth)
+	for next != "" {
+		code, body, err := internal.Get(ctx, next, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", next)
+		}
+		if code == http.StatusNotFound {
+			// Bitbucket Cloud returns 404 if the directory doesn't exist.
+			return nil, nil
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list directory from URL %s, status code: %d, body: %s",
+				next,
+				code,
+				body,
+			)
+		}
+
+		var resp struct {
+			Values []*DirectoryEntry `json:"values"`
+			Next   string            `json:"next"`
+		}
+		if err := json.Unmarshal([]byte(body), &resp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal")
+		}
+		for _, entry := range resp.Values {
+			if entry.Type == "commit_file" {
+				files = append(files, entry.Path)
+			}
+		}
+		next = resp.Next
+	}
+	return files, nil
+}
+
 // Target is the API message for Bitbucket Cloud target.
 type Target struct {
 	Hash string `json:"hash"`
@@ -275,6 +332,139 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 	}, nil
 }
 
+// CreateBranch creates the branch from the commit in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-post
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	branchCreatePayload, err := json.Marshal(Branch{
+		Name:   branchName,
+		Target: Target{Hash: commitID},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/refs/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), branchCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CreateCommit commits the files to the branch in the repository.
+// The files are sent as the form fields with the file path as the key.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (p *Provider) CreateCommit(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	values := url.Values{}
+	values.Set("message", commit.Message)
+	values.Set("branch", commit.Branch)
+	for _, file := range commit.Files {
+		// The files to delete are listed in the "files" field without the content.
+		if file.Delete {
+			values.Add("files", file.Path)
+			continue
+		}
+		values.Set(file.Path, file.Content)
+	}
+	url := fmt.Sprintf("%s/repositories/%s/src", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.PostWithHeader(ctx, url, p.getAuthorization(),
+		map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		},
+		[]byte(values.Encode()),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestBranch is the API message for the branch of Bitbucket Cloud pull request.
+type PullRequestBranch struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+}
+
+// PullRequestCreate is the API message for creating a Bitbucket Cloud pull request.
+type PullRequestCreate struct {
+	Title             string            `json:"title"`
+	Description       string            `json:"description"`
+	Source            PullRequestBranch `json:"source"`
+	Destination       PullRequestBranch `json:"destination"`
+	CloseSourceBranch bool              `json:"close_source_branch"`
+}
+
+// PullRequest is the API message for Bitbucket Cloud pull request.
+type PullRequest struct {
+	Links Links `json:"links"`
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-post
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, pullRequest *vcs.PullRequestCreate) (string, error) {
+	pullRequestCreate := PullRequestCreate{
+		Title:             pullRequest.Title,
+		Description:       pullRequest.Body,
+		CloseSourceBranch: true,
+	}
+	pullRequestCreate.Source.Branch.Name = pullRequest.Head
+	pullRequestCreate.Destination.Branch.Name = pullRequest.Base
+	pullRequestCreatePayload, err := json.Marshal(pullRequestCreate)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), pullRequestCreatePayload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return res.Links.HTML.Href, nil
+}
+
 // ListPullRequestFile lists the changed files in the pull request.
 //
 // Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-diffstat-get
@@ -317,6 +507,13 @@ func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRe
 
 type Comment struct {
 	Content CommentContent `json:"content"`
+	Inline  *CommentInline `json:"inline,omitempty"`
+}
+
+// CommentInline is the API message for the file line of an inline comment.
+type CommentInline struct {
+	Path string `json:"path"`
+	To   int    `json:"to"`
 }
 
 type CommentContent struct {
@@ -351,6 +548,92 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// CreatePullRequestLineComment creates an inline comment on the line of the pull request file.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-post
+func (p *Provider) CreatePullRequestLineComment(ctx context.Context, repositoryID, pullRequestID string, comment *vcs.PullRequestLineComment) error {
+	commentCreatePayload, err := json.Marshal(Comment{
+		Content: CommentContent{Raw: comment.Body},
+		Inline: &CommentInline{
+			Path: comment.Path,
+			To:   comment.Line,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request inline comment")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request inline comment through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request inline comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Bitbucket commit build status.
+type CommitStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+// CreateCommitStatus creates a build status for the commit.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commit-statuses/#api-repositories-workspace-repo-slug-commit-commit-statuses-build-post
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	// Bitbucket doesn't have a warning state.
+	state := "SUCCESSFUL"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "INPROGRESS"
+	case vcs.CommitStatusFailure:
+		state = "FAILED"
+	}
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		Key:         status.Context,
+		State:       state,
+		Name:        status.Context,
+		URL:         status.TargetURL,
+		Description: status.Description,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/commit/%s/statuses/build", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+
+	// Bitbucket returns 200 if the status with the same key is updated.
+	if code != http.StatusCreated && code != http.StatusOK {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 // Link is the API message for link.
 type Link struct {
 	Href string `json:"href"`
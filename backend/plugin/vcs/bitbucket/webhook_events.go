@@ -2,6 +2,13 @@ package bitbucket
 
 // Header X-Event-Key: pullrequest:created, pullrequest:updated, pullrequest:fulfilled.
 
+const (
+	// PullRequestStateOpen is the state of the open pull requests.
+	PullRequestStateOpen = "OPEN"
+	// PullRequestStateMerged is the state of the merged pull requests.
+	PullRequestStateMerged = "MERGED"
+)
+
 // PullRequestPushEvent is the json message for pull request push event.
 type PullRequestPushEvent struct {
 	// Actor: does not include email.
@@ -12,6 +19,7 @@ type EventPullRequest struct {
 	ID          int         `json:"id"`
 	Title       string      `json:"title"`
 	Description string      `json:"description"`
+	State       string      `json:"state"`
 	Destination EventBranch `json:"destination"`
 	Source      EventBranch `json:"source"`
 	Links       EventLinks  `json:"links"`
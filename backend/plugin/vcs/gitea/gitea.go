@@ -0,0 +1,782 @@
+// Package gitea is the plugin for Gitea and Forgejo.
+package gitea
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// apiPageSize is the default page size when making API requests.
+	// Gitea caps the page size with the MAX_RESPONSE_ITEMS setting which defaults to 50.
+	apiPageSize = 50
+)
+
+func init() {
+	vcs.Register(storepb.VCSType_GITEA, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gitea VCS provider.
+// Forgejo is a fork of Gitea that keeps the API compatible, so it's served by the same provider.
+type Provider struct {
+	client      *http.Client
+	instanceURL string
+	authToken   string
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	return &Provider{
+		client:      &http.Client{},
+		instanceURL: config.InstanceURL,
+		authToken:   config.AuthToken,
+	}
+}
+
+// APIURL returns the API URL path of Gitea.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", strings.TrimSuffix(instanceURL, "/"))
+}
+
+// Repository represents a Gitea API response for a repository.
+type Repository struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// WebhookInfo represents a Gitea API response for the webhook information.
+type WebhookInfo struct {
+	ID int64 `json:"id"`
+}
+
+// WebhookConfig represents the Gitea API message for webhook configuration.
+type WebhookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// ContentType is the media type used to serialize the payloads. Supported
+	// values include "json" and "form".
+	ContentType string `json:"content_type"`
+	// Secret is the secret will be used as the key to generate the HMAC hex digest
+	// value for delivery signature headers.
+	Secret string `json:"secret"`
+}
+
+// WebhookCreate represents a Gitea API request for creating a webhook.
+type WebhookCreate struct {
+	// Type is the type of the webhook, "gitea" for the Gitea native payloads.
+	// Forgejo accepts "forgejo" and "gitea" interchangeably.
+	Type string `json:"type"`
+	// Config contains settings for the webhook.
+	Config WebhookConfig `json:"config"`
+	// Events determines what events the hook is triggered for.
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+// FetchRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// The repository ID is the full name "{owner}/{repo}" because the Gitea API
+// addresses repositories by the owner and the name.
+//
+// Docs: https://gitea.com/api/swagger#/user/userCurrentListRepos
+func (p *Provider) FetchRepositoryList(ctx context.Context, listAll bool) ([]*vcs.Repository, error) {
+	var giteaRepos []Repository
+	page := 1
+	for {
+		repos, hasNextPage, err := p.fetchPaginatedRepositoryList(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		giteaRepos = append(giteaRepos, repos...)
+
+		if !hasNextPage || !listAll {
+			break
+		}
+		page++
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range giteaRepos {
+		if !r.Permissions.Admin {
+			continue
+		}
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				ID:       r.FullName,
+				Name:     r.Name,
+				FullPath: r.FullName,
+				WebURL:   r.HTMLURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// fetchPaginatedRepositoryList fetches repositories where the authenticated
+// user has access to in given page. It returns the paginated results along
+// with a boolean indicating whether the next page exists.
+func (p *Provider) fetchPaginatedRepositoryList(ctx context.Context, page int) (repos []Repository, hasNextPage bool, err error) {
+	url := fmt.Sprintf("%s/user/repos?page=%d&limit=%d", p.APIURL(p.instanceURL), page, apiPageSize)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, false, common.Errorf(common.NotFound, "failed to fetch repository list from URL %s", url)
+	} else if code >= 300 {
+		return nil, false,
+			errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	if err := json.Unmarshal([]byte(body), &repos); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal")
+	}
+	return repos, len(repos) >= apiPageSize, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetRawFile
+func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/raw/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, escapeFilePath(filePath), url.QueryEscape(refInfo.RefName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	} else if code >= 300 {
+		return "",
+			errors.Errorf("failed to read file content from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+	return body, nil
+}
+
+// PullRequest is the API message for Gitea pull request.
+type PullRequest struct {
+	Number  int               `json:"number"`
+	HTMLURL string            `json:"html_url"`
+	Head    PullRequestBranch `json:"head"`
+}
+
+// PullRequestBranch is the API message for the base or head branch of Gitea pull request.
+type PullRequestBranch struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// PullRequestFile is the API message for files in Gitea pull request.
+type PullRequestFile struct {
+	FileName string `json:"filename"`
+	// The file status in Gitea PR.
+	// Available values: "added", "deleted", "renamed", "copied", "changed", "unchanged"
+	Status string `json:"status"`
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequestFiles
+func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	pullRequest, err := p.getPullRequest(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pull request")
+	}
+
+	var allPRFiles []PullRequestFile
+	page := 1
+	for {
+		fileList, err := p.listPaginatedPullRequestFile(ctx, repositoryID, pullRequestID, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to list pull request file")
+		}
+
+		if len(fileList) == 0 {
+			break
+		}
+		allPRFiles = append(allPRFiles, fileList...)
+		if len(fileList) < apiPageSize {
+			break
+		}
+		page++
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, file := range allPRFiles {
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.FileName,
+			LastCommitID: pullRequest.Head.SHA,
+			IsDeleted:    file.Status == "deleted",
+		})
+	}
+	return res, nil
+}
+
+// getPullRequest gets the pull request.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequest
+func (p *Provider) getPullRequest(ctx context.Context, repositoryID, pullRequestID string) (*PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pullRequest := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pullRequest); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return pullRequest, nil
+}
+
+// listPaginatedPullRequestFile lists the changed files in the pull request with pagination.
+func (p *Provider) listPaginatedPullRequestFile(ctx context.Context, repositoryID, pullRequestID string, page int) ([]PullRequestFile, error) {
+	requestURL := fmt.Sprintf("%s/repos/%s/pulls/%s/files?limit=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, apiPageSize, page)
+	code, body, err := internal.Get(ctx, requestURL, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", requestURL)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", requestURL)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s",
+			requestURL,
+			code,
+			body,
+		)
+	}
+
+	var prFiles []PullRequestFile
+	if err := json.Unmarshal([]byte(body), &prFiles); err != nil {
+		return nil, err
+	}
+	return prFiles, nil
+}
+
+// Comment is the API message for Gitea issue comment.
+type Comment struct {
+	Body string `json:"body"`
+}
+
+// CreatePullRequestComment creates a comment on the pull request.
+//
+// Pull requests are issues in Gitea, so the comment is created through the issue comment API.
+// Docs: https://gitea.com/api/swagger#/issue/issueCreateComment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error {
+	commentCreatePayload, err := json.Marshal(Comment{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullReview is the API message for creating Gitea pull request review.
+type PullReview struct {
+	CommitID string               `json:"commit_id"`
+	Event    string               `json:"event"`
+	Body     string               `json:"body"`
+	Comments []*PullReviewComment `json:"comments"`
+}
+
+// PullReviewComment is the API message for Gitea pull request review comment.
+type PullReviewComment struct {
+	Path string `json:"path"`
+	Body string `json:"body"`
+	// NewPosition is the line number in the new version of the file.
+	NewPosition int `json:"new_position"`
+}
+
+// CreatePullRequestLineComment creates a review comment on the line of the pull request file.
+//
+// Gitea doesn't have the API to create a single review comment, so a review with the comment is created.
+// Docs: https://gitea.com/api/swagger#/repository/repoCreatePullReview
+func (p *Provider) CreatePullRequestLineComment(ctx context.Context, repositoryID, pullRequestID string, comment *vcs.PullRequestLineComment) error {
+	reviewCreatePayload, err := json.Marshal(PullReview{
+		CommitID: comment.CommitID,
+		Event:    "COMMENT",
+		Comments: []*PullReviewComment{
+			{
+				Path:        comment.Path,
+				Body:        comment.Body,
+				NewPosition: comment.Line,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request review")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/reviews", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), reviewCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request review through URL %s", url)
+	}
+
+	if code != http.StatusOK {
+		return errors.Errorf("failed to create pull request review through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Gitea commit status.
+type CommitStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+// CreateCommitStatus creates a commit status.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateStatus
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	state := "success"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusWarning:
+		state = "warning"
+	case vcs.CommitStatusFailure:
+		state = "failure"
+	}
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		State:       state,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// Branch is the API message for Gitea branch.
+type Branch struct {
+	Name   string       `json:"name"`
+	Commit BranchCommit `json:"commit"`
+}
+
+// BranchCommit is the latest commit of the Gitea branch.
+type BranchCommit struct {
+	ID string `json:"id"`
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetBranch
+func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/branches/%s", p.APIURL(p.instanceURL), repositoryID, escapeFilePath(branchName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	res := new(Branch)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return nil, err
+	}
+
+	return &vcs.BranchInfo{
+		Name:         res.Name,
+		LastCommitID: res.Commit.ID,
+	}, nil
+}
+
+// BranchCreate is the API message for creating a Gitea branch.
+type BranchCreate struct {
+	NewBranchName string `json:"new_branch_name"`
+	// OldRefName is the branch, tag or commit the new branch is created from.
+	OldRefName string `json:"old_ref_name"`
+}
+
+// CreateBranch creates the branch from the commit in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateBranch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	branchCreatePayload, err := json.Marshal(BranchCreate{
+		NewBranchName: branchName,
+		OldRefName:    commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), branchCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// ContentsResponse is the API message for Gitea file contents.
+type ContentsResponse struct {
+	SHA string `json:"sha"`
+}
+
+// GitTree is the API message for a Gitea git tree.
+type GitTree struct {
+	Tree []struct {
+		Path string `json:"path"`
+		Type string `json:"type"`
+	} `json:"tree"`
+	Truncated bool `json:"truncated"`
+}
+
+// ListDirectoryFile lists the paths of the files under the directory recursively.
+//
+// Docs: https://gitea.com/api/swagger#/repository/GetTree
+func (p *Provider) ListDirectoryFile(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	// The paths in the tree are relative to the repository root.
+	prefix := strings.Trim(directory, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	var files []string
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/git/trees/%s?recursive=true&page=%d&per_page=%d", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(refInfo.RefName), page, apiPageSize)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to get tree from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to get tree from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+
+		tree := new(GitTree)
+		if err := json.Unmarshal([]byte(body), tree); err != nil {
+			return nil, errors.Wrap(err, "unmarshal")
+		}
+		for _, entry := range tree.Tree {
+			if entry.Type == "blob" && strings.HasPrefix(entry.Path, prefix) {
+				files = append(files, entry.Path)
+			}
+		}
+		// Gitea paginates the recursive tree and marks the pages except the last one as truncated.
+		if !tree.Truncated {
+			return files, nil
+		}
+	}
+}
+
+// ChangeFileOperation is the API message for a file operation in the Gitea commit.
+type ChangeFileOperation struct {
+	// Operation is "create", "update" or "delete".
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	// Content is the base64 encoded file content.
+	Content string `json:"content,omitempty"`
+	// SHA is the blob SHA of the file to update or delete.
+	SHA string `json:"sha,omitempty"`
+}
+
+// ChangeFilesOptions is the API message for committing files in Gitea.
+type ChangeFilesOptions struct {
+	Branch  string                 `json:"branch"`
+	Message string                 `json:"message"`
+	Files   []*ChangeFileOperation `json:"files"`
+}
+
+// CreateCommit commits the files to the branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoChangeFiles
+func (p *Provider) CreateCommit(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	changeFiles := ChangeFilesOptions{
+		Branch:  commit.Branch,
+		Message: commit.Message,
+	}
+	for _, file := range commit.Files {
+		// Gitea requires the blob SHA to update the existing file.
+		sha, err := p.getFileSHA(ctx, repositoryID, file.Path, commit.Branch)
+		if err != nil {
+			return err
+		}
+		if file.Delete {
+			if sha == "" {
+				continue
+			}
+			changeFiles.Files = append(changeFiles.Files, &ChangeFileOperation{
+				Operation: "delete",
+				Path:      file.Path,
+				SHA:       sha,
+			})
+			continue
+		}
+		operation := "create"
+		if sha != "" {
+			operation = "update"
+		}
+		changeFiles.Files = append(changeFiles.Files, &ChangeFileOperation{
+			Operation: operation,
+			Path:      file.Path,
+			Content:   base64.StdEncoding.EncodeToString([]byte(file.Content)),
+			SHA:       sha,
+		})
+	}
+	changeFilesPayload, err := json.Marshal(changeFiles)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit")
+	}
+	url := fmt.Sprintf("%s/repos/%s/contents", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), changeFilesPayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getFileSHA gets the blob SHA of the file in the branch. Returns empty string if the file doesn't exist.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetContents
+func (p *Provider) getFileSHA(ctx context.Context, repositoryID, filePath, branch string) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, escapeFilePath(filePath), url.QueryEscape(branch))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", nil
+	} else if code >= 300 {
+		return "", errors.Errorf("failed to get file contents from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	res := new(ContentsResponse)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return res.SHA, nil
+}
+
+// PullRequestCreate is the API message for creating a Gitea pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreatePullRequest
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, pullRequest *vcs.PullRequestCreate) (string, error) {
+	pullRequestCreatePayload, err := json.Marshal(PullRequestCreate{
+		Title: pullRequest.Title,
+		Body:  pullRequest.Body,
+		Head:  pullRequest.Head,
+		Base:  pullRequest.Base,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), pullRequestCreatePayload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return res.HTMLURL, nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateHook
+func (p *Provider) CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/hooks", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var webhookInfo WebhookInfo
+	if err = json.Unmarshal([]byte(body), &webhookInfo); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return strconv.FormatInt(webhookInfo.ID, 10), nil
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoDeleteHook
+func (p *Provider) DeleteWebhook(ctx context.Context, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(p.instanceURL), repositoryID, webhookID)
+	code, body, err := internal.Delete(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil // It is OK if the webhook has already gone
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+func (p *Provider) getAuthorization() string {
+	return fmt.Sprintf("token %s", p.authToken)
+}
+
+// escapeFilePath escapes each segment of the file path and keeps the path separators.
+func escapeFilePath(filePath string) string {
+	segments := strings.Split(strings.TrimPrefix(filePath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,31 @@
+package gitea
+
+// PullRequestEvent is the json message for pull request event.
+type PullRequestEvent struct {
+	// opened, edited, closed, reopened, synchronized.
+	// PR merge will send webhook event with "closed" action, so we need to check the "merged" field.
+	Action      string           `json:"action"`
+	Number      int              `json:"number"`
+	PullRequest EventPullRequest `json:"pull_request"`
+}
+
+type EventPullRequest struct {
+	HTMLURL string      `json:"html_url"`
+	Title   string      `json:"title"`
+	Body    string      `json:"body"`
+	Base    EventBranch `json:"base"`
+	Head    EventBranch `json:"head"`
+	Merged  bool        `json:"merged"`
+	User    EventUser   `json:"user"`
+}
+
+type EventBranch struct {
+	// The branch name, e.g. main.
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+type EventUser struct {
+	// Email is empty if the user hides the email.
+	Email string `json:"email"`
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -366,6 +367,98 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// ReviewComment is the API message for GitHub pull request review comment.
+type ReviewComment struct {
+	Body     string `json:"body"`
+	CommitID string `json:"commit_id"`
+	Path     string `json:"path"`
+	Line     int    `json:"line"`
+	Side     string `json:"side"`
+}
+
+// CreatePullRequestLineComment creates a review comment on the line of the pull request file.
+//
+// Docs: https://docs.github.com/en/rest/pulls/comments?apiVersion=2022-11-28#create-a-review-comment-for-a-pull-request
+func (p *Provider) CreatePullRequestLineComment(ctx context.Context, repositoryID, pullRequestID string, comment *vcs.PullRequestLineComment) error {
+	commentCreatePayload, err := json.Marshal(ReviewComment{
+		Body:     comment.Body,
+		CommitID: comment.CommitID,
+		Path:     comment.Path,
+		Line:     comment.Line,
+		Side:     "RIGHT",
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request review comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request review comment through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request review comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for GitHub commit status.
+type CommitStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+// CreateCommitStatus creates a commit status.
+//
+// Docs: https://docs.github.com/en/rest/commits/statuses?apiVersion=2022-11-28#create-a-commit-status
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	// GitHub doesn't have a warning state.
+	state := "success"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusFailure:
+		state = "failure"
+	}
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		State:       state,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 // Branch is the API message for GitHub branch.
 type Branch struct {
 	Ref    string          `json:"ref"`
@@ -418,6 +511,302 @@ type PullRequest struct {
 	HTMLURL string `json:"html_url"`
 }
 
+// ReferenceCreate is the API message for creating a GitHub reference.
+type ReferenceCreate struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// ReferenceUpdate is the API message for updating a GitHub reference.
+type ReferenceUpdate struct {
+	SHA string `json:"sha"`
+}
+
+// CreateBranch creates the branch from the commit in the repository.
+//
+// Docs: https://docs.github.com/en/rest/git/refs#create-a-reference
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	referenceCreatePayload, err := json.Marshal(ReferenceCreate{
+		Ref: fmt.Sprintf("refs/heads/%s", branchName),
+		SHA: commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/git/refs", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), referenceCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// GitObject is the API message for a GitHub git object.
+type GitObject struct {
+	SHA string `json:"sha"`
+}
+
+// GitCommit is the API message for a GitHub git commit.
+type GitCommit struct {
+	SHA  string    `json:"sha"`
+	Tree GitObject `json:"tree"`
+}
+
+// GitCommitCreate is the API message for creating a GitHub git commit.
+type GitCommitCreate struct {
+	Message string   `json:"message"`
+	Tree    string   `json:"tree"`
+	Parents []string `json:"parents"`
+}
+
+// GitTreeEntry is the API message for a GitHub git tree entry.
+type GitTreeEntry struct {
+	Path    string `json:"path"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// GitTreeDeleteEntry is the API message for a GitHub git tree entry deleting the file.
+type GitTreeDeleteEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	// SHA must be null to delete the file.
+	SHA *string `json:"sha"`
+}
+
+// GitTreeCreate is the API message for creating a GitHub git tree.
+type GitTreeCreate struct {
+	BaseTree string `json:"base_tree"`
+	// Tree is the list of *GitTreeEntry and *GitTreeDeleteEntry.
+	Tree []any `json:"tree"`
+}
+
+// GitTree is the API message for a GitHub git tree.
+type GitTree struct {
+	Tree []struct {
+		Path string `json:"path"`
+		Type string `json:"type"`
+	} `json:"tree"`
+	Truncated bool `json:"truncated"`
+}
+
+// ListDirectoryFile lists the paths of the files under the directory recursively.
+//
+// Docs: https://docs.github.com/en/rest/git/trees#get-a-tree
+func (p *Provider) ListDirectoryFile(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/trees/%s?recursive=1", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(refInfo.RefName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get tree from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get tree from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	tree := new(GitTree)
+	if err := json.Unmarshal([]byte(body), tree); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	// The partial tree may miss the files in the directory.
+	if tree.Truncated {
+		return nil, errors.Errorf("the tree of %q in repository %q is too large to list", refInfo.RefName, repositoryID)
+	}
+	// The paths in the tree are relative to the repository root.
+	prefix := strings.Trim(directory, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	var files []string
+	for _, entry := range tree.Tree {
+		if entry.Type == "blob" && strings.HasPrefix(entry.Path, prefix) {
+			files = append(files, entry.Path)
+		}
+	}
+	return files, nil
+}
+
+// CreateCommit commits the files to the branch in the repository.
+// GitHub contents API commits one file at a time, so we create the tree and the commit with the git database API.
+//
+// Docs: https://docs.github.com/en/rest/git/trees#create-a-tree
+// Docs: https://docs.github.com/en/rest/git/commits#create-a-commit
+// Docs: https://docs.github.com/en/rest/git/refs#update-a-reference
+func (p *Provider) CreateCommit(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	branch, err := p.GetBranch(ctx, repositoryID, commit.Branch)
+	if err != nil {
+		return err
+	}
+	parent, err := p.getGitCommit(ctx, repositoryID, branch.LastCommitID)
+	if err != nil {
+		return err
+	}
+
+	treeCreate := GitTreeCreate{BaseTree: parent.Tree.SHA}
+	for _, file := range commit.Files {
+		if file.Delete {
+			treeCreate.Tree = append(treeCreate.Tree, &GitTreeDeleteEntry{
+				Path: file.Path,
+				Mode: "100644",
+				Type: "blob",
+			})
+			continue
+		}
+		treeCreate.Tree = append(treeCreate.Tree, &GitTreeEntry{
+			Path:    file.Path,
+			Mode:    "100644",
+			Type:    "blob",
+			Content: file.Content,
+		})
+	}
+	tree := new(GitObject)
+	if err := p.postGitObject(ctx, fmt.Sprintf("%s/repos/%s/git/trees", p.APIURL(p.instanceURL), repositoryID), treeCreate, tree); err != nil {
+		return err
+	}
+
+	newCommit := new(GitObject)
+	if err := p.postGitObject(ctx, fmt.Sprintf("%s/repos/%s/git/commits", p.APIURL(p.instanceURL), repositoryID), GitCommitCreate{
+		Message: commit.Message,
+		Tree:    tree.SHA,
+		Parents: []string{parent.SHA},
+	}, newCommit); err != nil {
+		return err
+	}
+
+	referenceUpdatePayload, err := json.Marshal(ReferenceUpdate{SHA: newCommit.SHA})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/git/refs/heads/%s", p.APIURL(p.instanceURL), repositoryID, commit.Branch)
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), referenceUpdatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to update branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getGitCommit gets the git commit in the repository.
+//
+// Docs: https://docs.github.com/en/rest/git/commits#get-a-commit-object
+func (p *Provider) getGitCommit(ctx context.Context, repositoryID, commitID string) (*GitCommit, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/commits/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get commit from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get commit from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	commit := new(GitCommit)
+	if err := json.Unmarshal([]byte(body), commit); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return commit, nil
+}
+
+// postGitObject creates the git object with the payload and unmarshals the created object to result.
+func (p *Provider) postGitObject(ctx context.Context, url string, payload, result any) error {
+	buf, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal request body for %s", url)
+	}
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), buf)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create git object through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	if err := json.Unmarshal([]byte(body), result); err != nil {
+		return errors.Wrap(err, "unmarshal body")
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating a GitHub pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// CreatePullRequest creates the pull request in the repository.
+//
+// Docs: https://docs.github.com/en/rest/pulls/pulls#create-a-pull-request
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, pullRequest *vcs.PullRequestCreate) (string, error) {
+	pullRequestCreatePayload, err := json.Marshal(PullRequestCreate{
+		Title: pullRequest.Title,
+		Body:  pullRequest.Body,
+		Head:  pullRequest.Head,
+		Base:  pullRequest.Base,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), pullRequestCreatePayload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res PullRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return res.HTMLURL, nil
+}
+
 // CreateWebhook creates a webhook in the repository with given payload.
 //
 // Docs: https://docs.github.com/en/rest/webhooks/repos#create-a-repository-webhook
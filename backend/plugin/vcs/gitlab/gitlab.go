@@ -238,6 +238,43 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return file.Content, nil
 }
 
+// ListDirectoryFile lists the paths of the files under the directory recursively.
+//
+// Docs: https://docs.gitlab.com/ee/api/repositories.html#list-repository-tree
+func (p *Provider) ListDirectoryFile(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	var files []string
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/projects/%s/repository/tree?path=%s&ref=%s&recursive=true&page=%d&per_page=%d", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(directory), url.QueryEscape(refInfo.RefName), page, apiPageSize)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			// GitLab returns 404 if the directory doesn't exist.
+			return nil, nil
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list repository tree from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+
+		var nodes []RepositoryTreeNode
+		if err := json.Unmarshal([]byte(body), &nodes); err != nil {
+			return nil, errors.Wrap(err, "unmarshal")
+		}
+		for _, node := range nodes {
+			if node.Type == "blob" {
+				files = append(files, node.Path)
+			}
+		}
+		if len(nodes) < apiPageSize {
+			return files, nil
+		}
+	}
+}
+
 // MergeRequestChange is the API message for GitLab merge request changes.
 type MergeRequestChange struct {
 	SHA     string             `json:"sha"`
@@ -312,6 +349,143 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// DiscussionPosition is the API message for the position of GitLab merge request discussion.
+type DiscussionPosition struct {
+	PositionType string `json:"position_type"`
+	BaseSHA      string `json:"base_sha"`
+	HeadSHA      string `json:"head_sha"`
+	StartSHA     string `json:"start_sha"`
+	NewPath      string `json:"new_path"`
+	NewLine      int    `json:"new_line"`
+}
+
+// DiscussionCreate is the API message to create GitLab merge request discussion.
+type DiscussionCreate struct {
+	Body     string              `json:"body"`
+	Position *DiscussionPosition `json:"position"`
+}
+
+// CreatePullRequestLineComment creates a merge request discussion on the line of the file.
+//
+// Docs: https://docs.gitlab.com/ee/api/discussions.html#create-a-new-thread-in-the-merge-request-diff
+func (p *Provider) CreatePullRequestLineComment(ctx context.Context, repositoryID, pullRequestID string, comment *vcs.PullRequestLineComment) error {
+	mr, err := p.getMergeRequest(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return err
+	}
+	headSHA := comment.CommitID
+	if headSHA == "" {
+		headSHA = mr.DiffRefs.HeadSHA
+	}
+	discussionCreatePayload, err := json.Marshal(DiscussionCreate{
+		Body: comment.Body,
+		Position: &DiscussionPosition{
+			PositionType: "text",
+			BaseSHA:      mr.DiffRefs.BaseSHA,
+			HeadSHA:      headSHA,
+			StartSHA:     mr.DiffRefs.StartSHA,
+			NewPath:      comment.Path,
+			NewLine:      comment.Line,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating merge request discussion")
+	}
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/discussions", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), discussionCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create merge request discussion through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create merge request discussion through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for GitLab commit status.
+type CommitStatus struct {
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description"`
+}
+
+// CreateCommitStatus creates a commit status.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
+func (p *Provider) CreateCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	// GitLab doesn't have a warning state.
+	state := "success"
+	switch status.State {
+	case vcs.CommitStatusPending:
+		state = "pending"
+	case vcs.CommitStatusFailure:
+		state = "failed"
+	}
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		State:       state,
+		Name:        status.Context,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/projects/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getMergeRequest gets the merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+func (p *Provider) getMergeRequest(ctx context.Context, repositoryID, pullRequestID string) (*MergeRequest, error) {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get merge request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get merge request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	mr := new(MergeRequest)
+	if err := json.Unmarshal([]byte(body), mr); err != nil {
+		return nil, err
+	}
+	return mr, nil
+}
+
 // Branch is the API message for GitLab branch.
 type Branch struct {
 	Name   string `json:"name"`
@@ -364,9 +538,156 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 	}, nil
 }
 
+// CreateBranch creates the branch from the commit in the repository.
+//
+// Docs: https://docs.gitlab.com/ee/api/branches.html#create-repository-branch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	branchCreatePayload, err := json.Marshal(BranchCreate{
+		Branch: branchName,
+		Ref:    commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), branchCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitAction is the API message for an action in the GitLab commit.
+type CommitAction struct {
+	// Action is "create", "update" or "delete".
+	Action   string `json:"action"`
+	FilePath string `json:"file_path"`
+	Content  string `json:"content,omitempty"`
+}
+
+// CommitCreate is the API message for creating a GitLab commit.
+type CommitCreate struct {
+	Branch        string          `json:"branch"`
+	CommitMessage string          `json:"commit_message"`
+	Actions       []*CommitAction `json:"actions"`
+}
+
+// CreateCommit commits the files to the branch in the repository.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
+func (p *Provider) CreateCommit(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	commitCreate := CommitCreate{
+		Branch:        commit.Branch,
+		CommitMessage: commit.Message,
+	}
+	for _, file := range commit.Files {
+		if file.Delete {
+			commitCreate.Actions = append(commitCreate.Actions, &CommitAction{
+				Action:   "delete",
+				FilePath: file.Path,
+			})
+			continue
+		}
+		// GitLab requires different actions for new and existing files.
+		action := "update"
+		if _, err := p.readFile(ctx, repositoryID, file.Path, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: commit.Branch}); err != nil {
+			if common.ErrorCode(err) != common.NotFound {
+				return err
+			}
+			action = "create"
+		}
+		commitCreate.Actions = append(commitCreate.Actions, &CommitAction{
+			Action:   action,
+			FilePath: file.Path,
+			Content:  file.Content,
+		})
+	}
+	commitCreatePayload, err := json.Marshal(commitCreate)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/commits", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commitCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CreatePullRequest creates the merge request in the repository.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, pullRequest *vcs.PullRequestCreate) (string, error) {
+	mergeRequestCreatePayload, err := json.Marshal(MergeRequestCreate{
+		Title:              pullRequest.Title,
+		Description:        pullRequest.Body,
+		SourceBranch:       pullRequest.Head,
+		TargetBranch:       pullRequest.Base,
+		RemoveSourceBranch: true,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating merge request")
+	}
+	url := fmt.Sprintf("%s/projects/%s/merge_requests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), mergeRequestCreatePayload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create merge request through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create merge request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var res MergeRequest
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return res.WebURL, nil
+}
+
 // MergeRequest is the API message for GitLab merge request.
 type MergeRequest struct {
-	WebURL string `json:"web_url"`
+	WebURL   string   `json:"web_url"`
+	DiffRefs DiffRefs `json:"diff_refs"`
+}
+
+// DiffRefs is the API message for the SHAs of GitLab merge request diff.
+type DiffRefs struct {
+	BaseSHA  string `json:"base_sha"`
+	HeadSHA  string `json:"head_sha"`
+	StartSHA string `json:"start_sha"`
 }
 
 // CreateWebhook creates a webhook in the repository with given payload.
@@ -15,6 +15,16 @@ func Post(ctx context.Context, url string, authorization string, body []byte) (c
 	return request(ctx, http.MethodPost, url, authorization, nil, bytes.NewReader(body))
 }
 
+// PostWithHeader makes a HTTP POST request to the given URL with additional header.
+func PostWithHeader(ctx context.Context, url string, authorization string, header map[string]string, body []byte) (code int, respBody string, err error) {
+	return request(ctx, http.MethodPost, url, authorization, header, bytes.NewReader(body))
+}
+
+// Patch makes a HTTP PATCH request to the given URL.
+func Patch(ctx context.Context, url string, authorization string, body []byte) (code int, respBody string, err error) {
+	return request(ctx, http.MethodPatch, url, authorization, nil, bytes.NewReader(body))
+}
+
 // Get makes a HTTP GET request to the given URL.
 func Get(ctx context.Context, url string, authorization string) (code int, respBody string, err error) {
 	return request(ctx, http.MethodGet, url, authorization, nil, bytes.NewReader(nil))
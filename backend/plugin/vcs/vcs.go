@@ -68,6 +68,66 @@ type BranchInfo struct {
 	LastCommitID string
 }
 
+// CommitStatusState is the state of a commit status.
+type CommitStatusState string
+
+const (
+	// CommitStatusPending is the commit status state for pending checks.
+	CommitStatusPending CommitStatusState = "pending"
+	// CommitStatusSuccess is the commit status state for passed checks.
+	CommitStatusSuccess CommitStatusState = "success"
+	// CommitStatusWarning is the commit status state for passed checks with warnings.
+	// Providers without a warning state report it as success.
+	CommitStatusWarning CommitStatusState = "warning"
+	// CommitStatusFailure is the commit status state for failed checks.
+	CommitStatusFailure CommitStatusState = "failure"
+)
+
+// CommitStatus is the API message for commit status.
+type CommitStatus struct {
+	State CommitStatusState
+	// Context identifies the status among the statuses of the commit, e.g. "bytebase/sql-review".
+	Context     string
+	Description string
+	TargetURL   string
+}
+
+// PullRequestLineComment is the API message for pull request comment on a file line.
+type PullRequestLineComment struct {
+	// CommitID is the commit the comment is made on.
+	CommitID string
+	Path     string
+	// Line is the 1-based line number in the new version of the file.
+	Line int
+	Body string
+}
+
+// CommitFile is the API message for a file in the commit.
+type CommitFile struct {
+	Path    string
+	Content string
+	// Delete is true if the file is deleted by the commit, the content is ignored.
+	Delete bool
+}
+
+// FileCommitCreate is the API message for committing files to a branch.
+// The files are created if not exist, otherwise overwritten or deleted.
+type FileCommitCreate struct {
+	Branch  string
+	Message string
+	Files   []*CommitFile
+}
+
+// PullRequestCreate is the API message for creating a pull request.
+type PullRequestCreate struct {
+	Title string
+	Body  string
+	// Head is the branch the changes are implemented in.
+	Head string
+	// Base is the branch the changes are merged into.
+	Base string
+}
+
 // Provider is the interface for VCS provider.
 type Provider interface {
 	// Returns the API URL for a given VCS instance URL
@@ -79,15 +139,34 @@ type Provider interface {
 	// Reads the file content
 	ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo RefInfo) (string, error)
 
+	// ListDirectoryFile lists the paths of the files under the directory recursively.
+	// Returns an empty list if the directory doesn't exist.
+	ListDirectoryFile(ctx context.Context, repositoryID, directory string, refInfo RefInfo) ([]string, error)
+
 	// GetBranch gets the given branch in the repository.
 	GetBranch(ctx context.Context, repositoryID, branchName string) (*BranchInfo, error)
 
-	// CreatePullRequest creates the pull request in the repository.
+	// CreateBranch creates the branch from the commit in the repository.
+	CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error
+
+	// CreateCommit commits the files to the branch in the repository.
+	CreateCommit(ctx context.Context, repositoryID string, commit *FileCommitCreate) error
+
+	// CreatePullRequest creates the pull request in the repository. Returns the web URL of the created pull request.
+	CreatePullRequest(ctx context.Context, repositoryID string, pullRequest *PullRequestCreate) (string, error)
+
+	// ListPullRequestFile lists the changed files in the pull request.
 	ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*PullRequestFile, error)
 
 	// CreatePullRequestComment creates a pull request comment.
 	CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error
 
+	// CreatePullRequestLineComment creates a pull request comment on a file line.
+	CreatePullRequestLineComment(ctx context.Context, repositoryID, pullRequestID string, comment *PullRequestLineComment) error
+
+	// CreateCommitStatus creates or updates the status of the commit.
+	CreateCommitStatus(ctx context.Context, repositoryID, commitID string, status *CommitStatus) error
+
 	// Creates a webhook. Returns the created webhook ID on success.
 	CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error)
 
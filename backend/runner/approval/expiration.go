@@ -0,0 +1,117 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// rejectExpiredApprovals rejects the pending approval steps of the open issues that stay pending longer than the step timeout.
+func (r *Runner) rejectExpiredApprovals(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("Approval expiration PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		StatusList:             []api.IssueStatus{api.IssueOpen},
+		HasApprovalStepTimeout: true,
+	})
+	if err != nil {
+		slog.Error("failed to list issues to reject expired approvals", log.BBError(err))
+		return
+	}
+	now := time.Now()
+	for _, issue := range issues {
+		step := getExpiredApprovalStep(issue.Payload.GetApproval(), now)
+		if step == nil {
+			continue
+		}
+		if err := r.rejectExpiredApproval(ctx, issue, step); err != nil {
+			slog.Error("failed to reject expired approval", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+}
+
+// getExpiredApprovalStep returns the pending approval step if it stays pending longer than its timeout.
+func getExpiredApprovalStep(approval *storepb.IssuePayloadApproval, now time.Time) *storepb.ApprovalStep {
+	if approval == nil || !approval.ApprovalFindingDone || approval.ApprovalFindingError != "" {
+		return nil
+	}
+	if len(approval.ApprovalTemplates) != 1 || approval.StepPendingTime == nil {
+		return nil
+	}
+	template := approval.ApprovalTemplates[0]
+	if utils.FindRejectedStep(template, approval.Approvers) != nil {
+		return nil
+	}
+	step := utils.FindNextPendingStep(template, approval.Approvers)
+	if step == nil || step.Timeout == nil || step.Timeout.AsDuration() <= 0 {
+		return nil
+	}
+	// The external approval node is resolved by the external approval service.
+	for _, node := range step.Nodes {
+		if _, ok := node.Payload.(*storepb.ApprovalNode_ExternalNodeId); ok {
+			return nil
+		}
+	}
+	if now.Sub(approval.StepPendingTime.AsTime()) < step.Timeout.AsDuration() {
+		return nil
+	}
+	return step
+}
+
+func (r *Runner) rejectExpiredApproval(ctx context.Context, issue *store.IssueMessage, step *storepb.ApprovalStep) error {
+	approval := issue.Payload.Approval
+	approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
+		PrincipalId: api.SystemBotID,
+	})
+	updatedIssue, err := r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.IssuePayload{
+			Approval: approval,
+		},
+	}, api.SystemBotID)
+	if err != nil {
+		return errors.Wrap(err, "failed to update issue payload")
+	}
+
+	comment := fmt.Sprintf("The approval is rejected automatically because it has been pending for more than %v.", step.Timeout.AsDuration())
+	if _, err := r.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+		IssueUID: issue.UID,
+		Payload: &storepb.IssueCommentPayload{
+			Comment: comment,
+			Event: &storepb.IssueCommentPayload_Approval_{
+				Approval: &storepb.IssueCommentPayload_Approval{
+					Status: storepb.IssueCommentPayload_Approval_REJECTED,
+				},
+			},
+		},
+	}, api.SystemBotID); err != nil {
+		slog.Warn("failed to create issue comment", log.BBError(err))
+	}
+
+	r.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   store.SystemBotUser,
+		Type:    webhook.EventTypeIssueApprovalReject,
+		Comment: comment,
+		Issue:   webhook.NewIssue(updatedIssue),
+		Project: webhook.NewProject(updatedIssue.Project),
+	})
+	return nil
+}
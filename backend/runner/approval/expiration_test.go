@@ -0,0 +1,46 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetExpiredApprovalStep(t *testing.T) {
+	a := require.New(t)
+	now := time.Now()
+	first := &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Timeout: durationpb.New(time.Hour)}
+	second := &storepb.ApprovalStep{Type: storepb.ApprovalStep_ANY, Quorum: 2}
+	newApproval := func(stepPendingTime time.Time, approvers ...*storepb.IssuePayloadApproval_Approver) *storepb.IssuePayloadApproval {
+		return &storepb.IssuePayloadApproval{
+			ApprovalFindingDone: true,
+			ApprovalTemplates: []*storepb.ApprovalTemplate{
+				{Flow: &storepb.ApprovalFlow{Steps: []*storepb.ApprovalStep{first, second}}},
+			},
+			Approvers:       approvers,
+			StepPendingTime: timestamppb.New(stepPendingTime),
+		}
+	}
+	approved := &storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 101}
+	rejected := &storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_REJECTED, PrincipalId: 101}
+
+	a.Nil(getExpiredApprovalStep(nil, now))
+	a.Nil(getExpiredApprovalStep(newApproval(now.Add(-time.Minute)), now))
+	a.Same(first, getExpiredApprovalStep(newApproval(now.Add(-time.Hour)), now))
+	// The rejected step does not expire again.
+	a.Nil(getExpiredApprovalStep(newApproval(now.Add(-2*time.Hour), rejected), now))
+	// The step without a timeout never expires.
+	a.Nil(getExpiredApprovalStep(newApproval(now.Add(-24*time.Hour), approved), now))
+	// The approvals pending for the approval finding do not expire.
+	approval := newApproval(now.Add(-2 * time.Hour))
+	approval.ApprovalFindingDone = false
+	a.Nil(getExpiredApprovalStep(approval, now))
+	// The external approval node is resolved by the external approval service.
+	first.Nodes = []*storepb.ApprovalNode{{Payload: &storepb.ApprovalNode_ExternalNodeId{ExternalNodeId: "external"}}}
+	a.Nil(getExpiredApprovalStep(newApproval(now.Add(-2*time.Hour)), now))
+}
@@ -0,0 +1,184 @@
+package approval
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// getCreatorRiskFactors returns the workspace and project roles and the groups of the issue creator.
+func getCreatorRiskFactors(ctx context.Context, s *store.Store, issue *store.IssueMessage) ([]string, []string, error) {
+	roles := []string{}
+	for _, role := range issue.Creator.Roles {
+		roles = append(roles, common.FormatRole(role.String()))
+	}
+	policy, err := s.GetProjectIamPolicy(ctx, issue.Project.UID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get iam policy of project %q", issue.Project.ResourceID)
+	}
+	for _, binding := range utils.GetUserIAMPolicyBindings(ctx, s, issue.Creator, policy) {
+		roles = append(roles, binding.Role)
+	}
+	slices.Sort(roles)
+	roles = slices.Compact(roles)
+
+	groups := []string{}
+	userGroups, err := s.ListUserGroups(ctx, &store.FindUserGroupMessage{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list user groups")
+	}
+	creator := common.FormatUserUID(issue.Creator.ID)
+	for _, group := range userGroups {
+		for _, member := range group.Payload.GetMembers() {
+			if member.Member == creator {
+				groups = append(groups, common.FormatGroupEmail(group.Email))
+				break
+			}
+		}
+	}
+	slices.Sort(groups)
+	return roles, groups, nil
+}
+
+// getScheduleRiskFactors returns the hour of day and the day of week in UTC when the task is scheduled to run.
+// The task runs right away if there is no earliest allowed time.
+func getScheduleRiskFactors(earliestAllowedTs int64) (int64, int64) {
+	runTime := time.Now()
+	if earliestAllowedTs > 0 {
+		runTime = time.Unix(earliestAllowedTs, 0)
+	}
+	runTime = runTime.UTC()
+	return int64(runTime.Hour()), int64(runTime.Weekday())
+}
+
+// getStatementTypeRiskFactors returns whether the statements drop or rename any database object.
+func getStatementTypeRiskFactors(statementTypes []string) (bool, bool) {
+	var dropsObject, renamesObject bool
+	for _, statementType := range statementTypes {
+		switch {
+		// Dropping the column default or the not null constraint does not drop any object.
+		case statementType == "DROP_DEFAULT", statementType == "DROP_NOT_NULL":
+		case strings.HasPrefix(statementType, "DROP"):
+			dropsObject = true
+		case strings.HasPrefix(statementType, "RENAME"):
+			renamesObject = true
+		}
+	}
+	return dropsObject, renamesObject
+}
+
+// getChangedResourceRiskFactors returns the qualified names of the changed tables, the classification levels of the changed tables,
+// and the classification levels of the columns of the changed tables.
+// The changed resources are table-level without the column names, so all the columns of a changed table are regarded as touched.
+// The classification of the tables outside the database of the task is unknown.
+func getChangedResourceRiskFactors(changedResources *storepb.ChangedResources, databaseName string, dbSchema *model.DBSchema, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) ([]string, []string, []string) {
+	tableNames := []string{}
+	classificationLevels := []string{}
+	columnClassificationLevels := []string{}
+	for _, database := range changedResources.GetDatabases() {
+		for _, schema := range database.GetSchemas() {
+			for _, table := range schema.GetTables() {
+				tableNames = append(tableNames, getQualifiedTableName(database.GetName(), schema.GetName(), table.GetName()))
+				if database.GetName() != databaseName {
+					continue
+				}
+				tableConfig := getTableConfig(dbSchema, schema.GetName(), table.GetName())
+				if tableConfig == nil {
+					continue
+				}
+				if level := getClassificationLevel(tableConfig.ClassificationId, classificationConfig); level != "" {
+					classificationLevels = append(classificationLevels, level)
+				}
+				for _, columnConfig := range tableConfig.ColumnConfigs {
+					if level := getClassificationLevel(columnConfig.ClassificationId, classificationConfig); level != "" {
+						columnClassificationLevels = append(columnClassificationLevels, level)
+					}
+				}
+			}
+		}
+	}
+	slices.Sort(tableNames)
+	slices.Sort(classificationLevels)
+	slices.Sort(columnClassificationLevels)
+	return slices.Compact(tableNames), slices.Compact(classificationLevels), slices.Compact(columnClassificationLevels)
+}
+
+// getQualifiedTableName returns the table name qualified by the database and the schema if any, e.g. db.table or db.schema.table,
+// so that the tables of the same name in different databases or schemas are distinguished.
+func getQualifiedTableName(databaseName, schemaName, tableName string) string {
+	var parts []string
+	for _, part := range []string{databaseName, schemaName, tableName} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func getTableConfig(dbSchema *model.DBSchema, schemaName, tableName string) *storepb.TableConfig {
+	if dbSchema == nil {
+		return nil
+	}
+	for _, schemaConfig := range dbSchema.GetConfig().GetSchemaConfigs() {
+		if schemaConfig.Name != schemaName {
+			continue
+		}
+		for _, tableConfig := range schemaConfig.TableConfigs {
+			if tableConfig.Name == tableName {
+				return tableConfig
+			}
+		}
+	}
+	return nil
+}
+
+func getClassificationLevel(classificationID string, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) string {
+	if classificationID == "" || classificationConfig == nil {
+		return ""
+	}
+	classification, ok := classificationConfig.Classification[classificationID]
+	if !ok {
+		return ""
+	}
+	return classification.GetLevelId()
+}
+
+// getDataClassificationConfig returns the data classification config of the project.
+func getDataClassificationConfig(ctx context.Context, s *store.Store, project *store.ProjectMessage) (*storepb.DataClassificationSetting_DataClassificationConfig, error) {
+	if project.DataClassificationConfigID == "" {
+		return nil, nil
+	}
+	setting, err := s.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get data classification setting")
+	}
+	for _, config := range setting.GetConfigs() {
+		if config.Id == project.DataClassificationConfigID {
+			return config, nil
+		}
+	}
+	return nil, nil
+}
+
+// getSQLReviewErrorCount returns the count of the SQL review errors in the plan check run.
+func getSQLReviewErrorCount(run *store.PlanCheckRunMessage) int64 {
+	if run == nil {
+		return 0
+	}
+	var count int64
+	for _, result := range run.Result.GetResults() {
+		if result.GetStatus() == storepb.PlanCheckRunResult_Result_ERROR {
+			count++
+		}
+	}
+	return count
+}
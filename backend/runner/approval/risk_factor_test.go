@@ -0,0 +1,178 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetScheduleRiskFactors(t *testing.T) {
+	a := require.New(t)
+	// 2024-01-06 is a Saturday.
+	tests := []struct {
+		earliestAllowedTs int64
+		hourOfDay         int64
+		dayOfWeek         int64
+	}{
+		{earliestAllowedTs: time.Date(2024, 1, 6, 23, 30, 0, 0, time.UTC).Unix(), hourOfDay: 23, dayOfWeek: 6},
+		{earliestAllowedTs: time.Date(2024, 1, 7, 8, 0, 0, 0, time.FixedZone("UTC+8", 8*3600)).Unix(), hourOfDay: 0, dayOfWeek: 0},
+	}
+	for _, test := range tests {
+		hourOfDay, dayOfWeek := getScheduleRiskFactors(test.earliestAllowedTs)
+		a.Equal(test.hourOfDay, hourOfDay)
+		a.Equal(test.dayOfWeek, dayOfWeek)
+	}
+
+	// The task runs right away without the earliest allowed time.
+	hourOfDay, dayOfWeek := getScheduleRiskFactors(0)
+	a.GreaterOrEqual(hourOfDay, int64(0))
+	a.Less(hourOfDay, int64(24))
+	a.GreaterOrEqual(dayOfWeek, int64(0))
+	a.Less(dayOfWeek, int64(7))
+}
+
+func TestGetStatementTypeRiskFactors(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		statementTypes []string
+		dropsObject    bool
+		renamesObject  bool
+	}{
+		{statementTypes: nil},
+		{statementTypes: []string{"CREATE_TABLE", "INSERT", "ALTER_TABLE"}},
+		{statementTypes: []string{"DROP_DEFAULT", "DROP_NOT_NULL"}},
+		{statementTypes: []string{"ALTER_TABLE", "DROP_COLUMN"}, dropsObject: true},
+		{statementTypes: []string{"DROP_TABLE", "RENAME"}, dropsObject: true, renamesObject: true},
+		{statementTypes: []string{"ALTER_TABLE", "RENAME_COLUMN"}, renamesObject: true},
+	}
+	for _, test := range tests {
+		dropsObject, renamesObject := getStatementTypeRiskFactors(test.statementTypes)
+		a.Equal(test.dropsObject, dropsObject, test.statementTypes)
+		a.Equal(test.renamesObject, renamesObject, test.statementTypes)
+	}
+}
+
+func TestGetChangedResourceRiskFactors(t *testing.T) {
+	a := require.New(t)
+	l1, l2 := "L1", "L2"
+	classificationConfig := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1":   {Id: "1", LevelId: &l1},
+			"1-1": {Id: "1-1", LevelId: &l2},
+		},
+	}
+	dbSchema := model.NewDBSchema(&storepb.DatabaseSchemaMetadata{Name: "db"}, nil, &storepb.DatabaseConfig{
+		Name: "db",
+		SchemaConfigs: []*storepb.SchemaConfig{
+			{
+				Name: "public",
+				TableConfigs: []*storepb.TableConfig{
+					{
+						Name:             "users",
+						ClassificationId: "1",
+						ColumnConfigs:    []*storepb.ColumnConfig{{Name: "email", ClassificationId: "1-1"}, {Name: "id"}},
+					},
+					{Name: "orders", ColumnConfigs: []*storepb.ColumnConfig{{Name: "address", ClassificationId: "1-1"}}},
+				},
+			},
+		},
+	})
+	changedResources := func(databaseName, schemaName string, tableNames ...string) *storepb.ChangedResources {
+		schema := &storepb.ChangedResourceSchema{Name: schemaName}
+		for _, tableName := range tableNames {
+			schema.Tables = append(schema.Tables, &storepb.ChangedResourceTable{Name: tableName})
+		}
+		return &storepb.ChangedResources{
+			Databases: []*storepb.ChangedResourceDatabase{{Name: databaseName, Schemas: []*storepb.ChangedResourceSchema{schema}}},
+		}
+	}
+
+	tests := []struct {
+		changedResources           *storepb.ChangedResources
+		tableNames                 []string
+		classificationLevels       []string
+		columnClassificationLevels []string
+	}{
+		{
+			changedResources:           nil,
+			tableNames:                 []string{},
+			classificationLevels:       []string{},
+			columnClassificationLevels: []string{},
+		},
+		{
+			changedResources:           changedResources("db", "public", "users", "orders"),
+			tableNames:                 []string{"db.public.orders", "db.public.users"},
+			classificationLevels:       []string{"L1"},
+			columnClassificationLevels: []string{"L2"},
+		},
+		{
+			changedResources:           changedResources("db", "public", "orders"),
+			tableNames:                 []string{"db.public.orders"},
+			classificationLevels:       []string{},
+			columnClassificationLevels: []string{"L2"},
+		},
+		{
+			// The tables of the same name in other schemas or databases are not classified.
+			changedResources:           changedResources("db", "audit", "users"),
+			tableNames:                 []string{"db.audit.users"},
+			classificationLevels:       []string{},
+			columnClassificationLevels: []string{},
+		},
+		{
+			changedResources:           changedResources("otherdb", "public", "users"),
+			tableNames:                 []string{"otherdb.public.users"},
+			classificationLevels:       []string{},
+			columnClassificationLevels: []string{},
+		},
+		{
+			// MySQL has no schema.
+			changedResources:           changedResources("db", "", "users"),
+			tableNames:                 []string{"db.users"},
+			classificationLevels:       []string{},
+			columnClassificationLevels: []string{},
+		},
+	}
+	for _, test := range tests {
+		tableNames, classificationLevels, columnClassificationLevels := getChangedResourceRiskFactors(test.changedResources, "db", dbSchema, classificationConfig)
+		a.Equal(test.tableNames, tableNames)
+		a.Equal(test.classificationLevels, classificationLevels)
+		a.Equal(test.columnClassificationLevels, columnClassificationLevels)
+	}
+
+	// The classification levels are unknown without the data classification config.
+	_, classificationLevels, columnClassificationLevels := getChangedResourceRiskFactors(changedResources("db", "public", "users"), "db", dbSchema, nil)
+	a.Empty(classificationLevels)
+	a.Empty(columnClassificationLevels)
+}
+
+func TestGetSQLReviewErrorCount(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		run  *store.PlanCheckRunMessage
+		want int64
+	}{
+		{run: nil, want: 0},
+		{run: &store.PlanCheckRunMessage{}, want: 0},
+		{
+			run: &store.PlanCheckRunMessage{
+				Result: &storepb.PlanCheckRunResult{
+					Results: []*storepb.PlanCheckRunResult_Result{
+						{Status: storepb.PlanCheckRunResult_Result_ERROR},
+						{Status: storepb.PlanCheckRunResult_Result_WARNING},
+						{Status: storepb.PlanCheckRunResult_Result_SUCCESS},
+						{Status: storepb.PlanCheckRunResult_Result_ERROR},
+					},
+				},
+			},
+			want: 2,
+		},
+	}
+	for _, test := range tests {
+		a.Equal(test.want, getSQLReviewErrorCount(test.run))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	celtypes "github.com/google/cel-go/common/types"
 
@@ -28,6 +29,7 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/runner/relay"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -57,12 +59,18 @@ func NewRunner(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfac
 	}
 }
 
-const approvalRunnerInterval = 1 * time.Second
+const (
+	approvalRunnerInterval = 1 * time.Second
+	// approvalExpirationInterval is the interval to reject the expired pending approval steps.
+	approvalExpirationInterval = 1 * time.Minute
+)
 
 // Run runs the runner.
 func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	ticker := time.NewTicker(approvalRunnerInterval)
 	defer ticker.Stop()
+	expirationTicker := time.NewTicker(approvalExpirationInterval)
+	defer expirationTicker.Stop()
 	defer wg.Done()
 	slog.Debug(fmt.Sprintf("Approval runner started and will run every %v", approvalRunnerInterval))
 	r.retryFindApprovalTemplate(ctx)
@@ -70,6 +78,8 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 		select {
 		case <-ticker.C:
 			r.runOnce(ctx)
+		case <-expirationTicker.C:
+			r.rejectExpiredApprovals(ctx)
 		case <-ctx.Done():
 			return
 		}
@@ -183,6 +193,10 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 		return false, nil
 	}
 
+	// The approval template without any step approves the grant request automatically.
+	if issue.Type == api.IssueGrantRequest && len(approvalTemplate.GetFlow().GetSteps()) == 0 {
+		approvalTemplate = nil
+	}
 	// Grant privilege and close issue similar to actions on issue approval.
 	if issue.Type == api.IssueGrantRequest && approvalTemplate == nil {
 		if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, r.store, issue, payload.GrantRequest); err != nil {
@@ -198,6 +212,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 		RiskLevel:           riskLevel,
 		ApprovalTemplates:   nil,
 		Approvers:           nil,
+		StepPendingTime:     timestamppb.Now(),
 	}
 	if approvalTemplate != nil {
 		payload.Approval.ApprovalTemplates = []*storepb.ApprovalTemplate{approvalTemplate}
@@ -374,7 +389,7 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 
 	planCheckRuns, err := s.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
 		PlanUID: &plan.UID,
-		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport},
+		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport, store.PlanCheckDatabaseStatementAdvise},
 	})
 	if err != nil {
 		return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to list plan check runs for plan %v", plan.UID)
@@ -382,12 +397,14 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 	type Key struct {
 		InstanceUID  int
 		DatabaseName string
+		Type         store.PlanCheckRunType
 	}
 	latestPlanCheckRun := map[Key]*store.PlanCheckRunMessage{}
 	for _, run := range planCheckRuns {
 		key := Key{
 			InstanceUID:  int(run.Config.InstanceUid),
 			DatabaseName: run.Config.DatabaseName,
+			Type:         run.Type,
 		}
 		oldValue, ok := latestPlanCheckRun[key]
 		if !ok || oldValue.UID < run.UID {
@@ -416,7 +433,10 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 
 	// If any plan check run is skipped because of large SQL,
 	// return the max risk level in the risks of the same risk source.
-	for _, run := range latestPlanCheckRun {
+	for key, run := range latestPlanCheckRun {
+		if key.Type != store.PlanCheckDatabaseStatementSummaryReport {
+			continue
+		}
 		for _, result := range run.Result.GetResults() {
 			if result.GetCode() == common.SizeExceeded.Int32() {
 				// risks is sorted by level DESC, so we just need to return the 1st matched risk.
@@ -439,6 +459,15 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 		return 0, store.RiskSourceUnknown, false, err
 	}
 
+	creatorRoles, creatorGroups, err := getCreatorRiskFactors(ctx, s, issue)
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, err
+	}
+	classificationConfig, err := getDataClassificationConfig(ctx, s, issue.Project)
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, err
+	}
+
 	var maxRiskLevel int32
 	for _, stage := range pipelineCreate.Stages {
 		for _, task := range stage.TaskList {
@@ -454,6 +483,7 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 
 			environmentID := instance.EnvironmentID
 			var databaseName string
+			var dbSchema *model.DBSchema
 			if task.Type == api.TaskDatabaseCreate {
 				payload := &api.TaskDatabaseCreatePayload{}
 				if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
@@ -472,7 +502,17 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 				}
 				databaseName = database.DatabaseName
 				environmentID = database.EffectiveEnvironmentID
+				dbSchema, err = s.GetDBSchema(ctx, database.UID)
+				if err != nil {
+					return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to get schema of database %q", databaseName)
+				}
 			}
+			hourOfDay, dayOfWeek := getScheduleRiskFactors(task.EarliestAllowedTs)
+			sqlReviewErrorCount := getSQLReviewErrorCount(latestPlanCheckRun[Key{
+				InstanceUID:  instance.UID,
+				DatabaseName: databaseName,
+				Type:         store.PlanCheckDatabaseStatementAdvise,
+			}])
 
 			risk, err := func() (int32, error) {
 				for _, risk := range risks {
@@ -498,7 +538,12 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 						"project_id":     issue.Project.ResourceID,
 						"database_name":  databaseName,
 						// convert to string type otherwise cel-go will complain that storepb.Engine is not string type.
-						"db_engine": instance.Engine.String(),
+						"db_engine":              instance.Engine.String(),
+						"creator_roles":          creatorRoles,
+						"creator_groups":         creatorGroups,
+						"hour_of_day":            hourOfDay,
+						"day_of_week":            dayOfWeek,
+						"sql_review_error_count": sqlReviewErrorCount,
 					}
 
 					vars, err := e.PartialVars(args)
@@ -516,6 +561,7 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 					if run, ok := latestPlanCheckRun[Key{
 						InstanceUID:  instance.UID,
 						DatabaseName: databaseName,
+						Type:         store.PlanCheckDatabaseStatementSummaryReport,
 					}]; ok {
 						for _, result := range run.Result.Results {
 							report := result.GetSqlSummaryReport()
@@ -530,8 +576,15 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 									}
 								}
 							}
+							tableNames, classificationLevels, columnClassificationLevels := getChangedResourceRiskFactors(report.GetChangedResources(), databaseName, dbSchema, classificationConfig)
+							dropsObject, renamesObject := getStatementTypeRiskFactors(report.StatementTypes)
 							args["affected_rows"] = report.AffectedRows
 							args["table_rows"] = tableRows
+							args["table_names"] = tableNames
+							args["classification_levels"] = classificationLevels
+							args["column_classification_levels"] = columnClassificationLevels
+							args["drops_object"] = dropsObject
+							args["renames_object"] = renamesObject
 							for _, statementType := range report.StatementTypes {
 								args["sql_type"] = statementType
 								out, _, err := prg.Eval(args)
@@ -0,0 +1,238 @@
+// Package grantexpiration is the runner that revokes the expired roles from the project IAM policies.
+package grantexpiration
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	grantExpirationInterval = 10 * time.Minute
+	// grantExpiringNotifyBefore is the duration before the granted role expires to notify the members.
+	grantExpiringNotifyBefore = time.Hour
+)
+
+// NewRunner creates a new grant expiration runner.
+func NewRunner(store *store.Store, webhookManager *webhook.Manager) *Runner {
+	return &Runner{
+		store:          store,
+		webhookManager: webhookManager,
+		notified:       make(map[string]bool),
+	}
+}
+
+// Runner is the grant expiration runner.
+type Runner struct {
+	store          *store.Store
+	webhookManager *webhook.Manager
+	// notified is the set of the expiring bindings whose members have been notified.
+	// It is only accessed by the runner goroutine.
+	notified map[string]bool
+}
+
+// Run runs the grant expiration runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(grantExpirationInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Grant expiration runner started and will run every %v", grantExpirationInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.revokeExpiredGrants(ctx)
+		case <-ctx.Done():
+			slog.Debug("Grant expiration runner received context cancellation")
+			return
+		}
+	}
+}
+
+func (r *Runner) revokeExpiredGrants(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("grant expiration runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("failed to list projects", log.BBError(err))
+		return
+	}
+	seen := make(map[string]bool)
+	for _, project := range projects {
+		if err := r.revokeProjectExpiredGrants(ctx, project, seen); err != nil {
+			slog.Error("failed to revoke expired grants", slog.String("project", project.ResourceID), log.BBError(err))
+		}
+	}
+	for key := range r.notified {
+		if !seen[key] {
+			delete(r.notified, key)
+		}
+	}
+}
+
+func (r *Runner) revokeProjectExpiredGrants(ctx context.Context, project *store.ProjectMessage, seen map[string]bool) error {
+	policy, err := r.store.GetProjectIamPolicy(ctx, project.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project iam policy")
+	}
+
+	now := time.Now()
+	hasExpired := false
+	for _, binding := range policy.Bindings {
+		expireTime, err := getBindingExpireTime(binding)
+		if err != nil {
+			slog.Warn("failed to get binding expire time", slog.String("project", project.ResourceID), slog.String("role", binding.Role), log.BBError(err))
+			continue
+		}
+		if expireTime == nil {
+			continue
+		}
+		if !expireTime.After(now) {
+			hasExpired = true
+			continue
+		}
+		if expireTime.Sub(now) <= grantExpiringNotifyBefore {
+			key := getBindingKey(project, binding, *expireTime)
+			seen[key] = true
+			if !r.notified[key] {
+				r.notified[key] = true
+				r.notifyExpiringGrant(ctx, project, binding, *expireTime)
+			}
+		}
+	}
+	if !hasExpired {
+		return nil
+	}
+
+	// The policy is read again while being updated, so that the bindings changed since the read above are kept.
+	var expiredBindings []*storepb.Binding
+	if err := r.store.UpdateProjectIamPolicy(ctx, project.UID, func(policy *storepb.ProjectIamPolicy) bool {
+		expiredBindings = revokeExpiredBindings(policy, now)
+		return len(expiredBindings) > 0
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to update project iam policy")
+	}
+
+	for _, binding := range expiredBindings {
+		expireTime, _ := getBindingExpireTime(binding)
+		slog.Info("revoked expired role",
+			slog.String("project", project.ResourceID),
+			slog.String("role", binding.Role),
+			slog.String("members", strings.Join(binding.Members, ",")),
+			slog.Time("expireTime", *expireTime))
+		r.recordRevocation(ctx, project, binding, *expireTime)
+	}
+	return nil
+}
+
+// getBindingExpireTime returns the expire time of the binding, or nil if the binding never expires.
+// The project owner bindings never expire, because the project must have at least one owner binding.
+func getBindingExpireTime(binding *storepb.Binding) (*time.Time, error) {
+	if binding.Role == common.FormatRole(api.ProjectOwner.String()) {
+		return nil, nil
+	}
+	return common.GetBindingExpireTime(binding.GetCondition().GetExpression())
+}
+
+// revokeExpiredBindings removes the bindings expired at now from the policy, and returns the removed ones.
+// The bindings whose expire time cannot be determined are kept.
+func revokeExpiredBindings(policy *storepb.ProjectIamPolicy, now time.Time) []*storepb.Binding {
+	var bindings, expiredBindings []*storepb.Binding
+	for _, binding := range policy.Bindings {
+		expireTime, err := getBindingExpireTime(binding)
+		if err == nil && expireTime != nil && !expireTime.After(now) {
+			expiredBindings = append(expiredBindings, binding)
+			continue
+		}
+		bindings = append(bindings, binding)
+	}
+	policy.Bindings = bindings
+	return expiredBindings
+}
+
+// recordRevocation comments on the grant request issue of the binding.
+func (r *Runner) recordRevocation(ctx context.Context, project *store.ProjectMessage, binding *storepb.Binding, expireTime time.Time) {
+	issue := r.getGrantIssue(ctx, project, binding)
+	if issue == nil {
+		return
+	}
+	if _, err := r.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+		IssueUID: issue.UID,
+		Payload: &storepb.IssueCommentPayload{
+			Comment: fmt.Sprintf("The role %s granted to %s expired at %s and has been revoked.", binding.Role, strings.Join(binding.Members, ", "), expireTime.UTC().Format(time.RFC3339)),
+		},
+	}, api.SystemBotID); err != nil {
+		slog.Warn("failed to create issue comment for the revoked role", slog.Int("issue", issue.UID), log.BBError(err))
+	}
+}
+
+// notifyExpiringGrant notifies the members before the role granted by the grant request issue expires.
+func (r *Runner) notifyExpiringGrant(ctx context.Context, project *store.ProjectMessage, binding *storepb.Binding, expireTime time.Time) {
+	issue := r.getGrantIssue(ctx, project, binding)
+	if issue == nil {
+		return
+	}
+	var members []*store.UserMessage
+	for _, member := range binding.Members {
+		members = append(members, utils.GetUsersByMember(ctx, r.store, member)...)
+	}
+	r.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   store.SystemBotUser,
+		Type:    webhook.EventTypeIssueGrantExpiring,
+		Comment: fmt.Sprintf("The role %s granted to %s expires at %s.", binding.Role, strings.Join(binding.Members, ", "), expireTime.UTC().Format(time.RFC3339)),
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(project),
+		IssueGrantExpiring: &webhook.EventIssueGrantExpiring{
+			Members: members,
+		},
+	})
+}
+
+// getGrantIssue returns the grant request issue of the binding, the binding condition description is "#{issueUID}".
+func (r *Runner) getGrantIssue(ctx context.Context, project *store.ProjectMessage, binding *storepb.Binding) *store.IssueMessage {
+	uid, ok := strings.CutPrefix(binding.GetCondition().GetDescription(), "#")
+	if !ok {
+		return nil
+	}
+	issueUID, err := strconv.Atoi(uid)
+	if err != nil {
+		return nil
+	}
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		slog.Warn("failed to get issue", slog.Int("issue", issueUID), log.BBError(err))
+		return nil
+	}
+	if issue == nil || issue.Type != api.IssueGrantRequest || issue.Project.UID != project.UID {
+		return nil
+	}
+	return issue
+}
+
+func getBindingKey(project *store.ProjectMessage, binding *storepb.Binding, expireTime time.Time) string {
+	members := append([]string{}, binding.Members...)
+	sort.Strings(members)
+	return fmt.Sprintf("%d/%s/%d/%s", project.UID, binding.Role, expireTime.Unix(), strings.Join(members, ","))
+}
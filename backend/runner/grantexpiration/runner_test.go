@@ -0,0 +1,33 @@
+package grantexpiration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestRevokeExpiredBindings(t *testing.T) {
+	a := require.New(t)
+	now := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	expired := &expr.Expr{Expression: `request.time < timestamp("2024-01-01T00:00:00Z")`}
+	owner := &storepb.Binding{Role: common.FormatRole(api.ProjectOwner.String()), Members: []string{"users/1"}, Condition: expired}
+	expiredDeveloper := &storepb.Binding{Role: common.FormatRole(api.ProjectDeveloper.String()), Members: []string{"users/2"}, Condition: expired}
+	developer := &storepb.Binding{Role: common.FormatRole(api.ProjectDeveloper.String()), Members: []string{"users/3"}, Condition: &expr.Expr{Expression: `request.time < timestamp("2024-03-01T00:00:00Z")`}}
+	querier := &storepb.Binding{Role: "roles/projectQuerier", Members: []string{"users/4"}}
+	invalid := &storepb.Binding{Role: "roles/projectExporter", Members: []string{"users/5"}, Condition: &expr.Expr{Expression: "request.time <"}}
+
+	policy := &storepb.ProjectIamPolicy{Bindings: []*storepb.Binding{owner, expiredDeveloper, developer, querier, invalid}}
+	a.Equal([]*storepb.Binding{expiredDeveloper}, revokeExpiredBindings(policy, now))
+	// The project owner bindings are kept even if expired, and the bindings with invalid conditions are kept.
+	a.Equal([]*storepb.Binding{owner, developer, querier, invalid}, policy.Bindings)
+
+	// Nothing is revoked once the expired bindings are removed.
+	a.Empty(revokeExpiredBindings(policy, now))
+	a.Len(policy.Bindings, 4)
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/tracing"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -23,11 +26,12 @@ const (
 )
 
 // NewScheduler creates a new plan check scheduler.
-func NewScheduler(s *store.Store, licenseService enterprise.LicenseService, stateCfg *state.State) *Scheduler {
+func NewScheduler(s *store.Store, licenseService enterprise.LicenseService, stateCfg *state.State, dbFactory *dbfactory.DBFactory) *Scheduler {
 	return &Scheduler{
 		store:          s,
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
+		dbFactory:      dbFactory,
 		executors:      make(map[store.PlanCheckRunType]Executor),
 	}
 }
@@ -37,6 +41,7 @@ type Scheduler struct {
 	store          *store.Store
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
+	dbFactory      *dbfactory.DBFactory
 	executors      map[store.PlanCheckRunType]Executor
 }
 
@@ -70,6 +75,8 @@ func (s *Scheduler) Register(planCheckRunType store.PlanCheckRunType, executor E
 }
 
 func (s *Scheduler) runOnce(ctx context.Context) {
+	ctx, span := tracing.Start(ctx, "plancheck.Scheduler.runOnce")
+	defer span.End()
 	defer func() {
 		if r := recover(); r != nil {
 			err, ok := r.(error)
@@ -112,31 +119,32 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 		return
 	}
 
-	s.stateCfg.Lock()
-	maximumConnections := int(instance.Options.GetMaximumConnections())
-	if maximumConnections == 0 {
-		maximumConnections = state.DefaultInstanceMaximumConnections
-	}
-	if s.stateCfg.InstanceOutstandingConnections[instanceUID] >= maximumConnections {
-		s.stateCfg.Unlock()
+	if !s.dbFactory.TryAcquireInstanceConnection(instance) {
 		return
 	}
-	s.stateCfg.InstanceOutstandingConnections[instanceUID]++
-	s.stateCfg.Unlock()
 
 	s.stateCfg.RunningPlanChecks.Store(planCheckRun.UID, true)
 	go func() {
 		defer func() {
 			s.stateCfg.RunningPlanChecks.Delete(planCheckRun.UID)
-			s.stateCfg.Lock()
-			s.stateCfg.InstanceOutstandingConnections[instanceUID]--
-			s.stateCfg.Unlock()
+			s.dbFactory.ReleaseInstanceConnection(instance)
 		}()
+		// The drivers of the plan check run share the connection reserved for it.
+		ctx := dbfactory.WithReservedInstanceConnection(ctx, instance)
+		ctx, span := tracing.Start(ctx, "plancheck.Scheduler.runPlanCheckRun",
+			tracing.Engine(instance.Engine),
+			tracing.Instance(instance.ResourceID),
+			tracing.PlanCheckRunTypeKey.String(string(planCheckRun.Type)),
+		)
+		start := time.Now()
 		results, err := runExecutorOnce(ctx, executor, planCheckRun.Config)
+		tracing.End(span, err)
 		if err != nil {
+			metrics.ObservePlanCheckRun(string(planCheckRun.Type), instance.Engine, metrics.OutcomeFailed, time.Since(start))
 			s.markPlanCheckRunFailed(ctx, planCheckRun, err.Error())
 			return
 		}
+		metrics.ObservePlanCheckRun(string(planCheckRun.Type), instance.Engine, metrics.OutcomeDone, time.Since(start))
 		s.markPlanCheckRunDone(ctx, planCheckRun, results)
 	}()
 }
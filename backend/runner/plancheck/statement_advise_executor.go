@@ -55,8 +55,6 @@ func (e *StatementAdviseExecutor) Run(ctx context.Context, config *storepb.PlanC
 }
 
 func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
-	changeType := config.ChangeDatabaseType
-
 	instanceUID := int(config.InstanceUid)
 	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
 	if err != nil {
@@ -66,6 +64,53 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 		return nil, errors.Errorf("instance not found UID %v", instanceUID)
 	}
 
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+
+	return e.runForStatement(ctx, instance, database, config.ChangeDatabaseType, statement, config.PreUpdateBackupDetail)
+}
+
+// AdviseStatement runs the SQL review of the statement against the database,
+// e.g. for the pull requests reviewed before their plans are created.
+func AdviseStatement(
+	ctx context.Context,
+	store *store.Store,
+	sheetManager *sheet.Manager,
+	dbFactory *dbfactory.DBFactory,
+	licenseService enterprise.LicenseService,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	changeType storepb.PlanCheckRunConfig_ChangeDatabaseType,
+	statement string,
+) ([]*storepb.PlanCheckRunResult_Result, error) {
+	e := &StatementAdviseExecutor{
+		store:          store,
+		sheetManager:   sheetManager,
+		dbFactory:      dbFactory,
+		licenseService: licenseService,
+	}
+	return e.runForStatement(ctx, instance, database, changeType, statement, nil /* preUpdateBackupDetail */)
+}
+
+func (e *StatementAdviseExecutor) runForStatement(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, changeType storepb.PlanCheckRunConfig_ChangeDatabaseType, statement string, preUpdateBackupDetail *storepb.PlanCheckRunConfig_PreUpdateBackupDetail) ([]*storepb.PlanCheckRunResult_Result, error) {
 	if !common.StatementAdviseEngines[instance.Engine] {
 		return []*storepb.PlanCheckRunResult_Result{
 			{
@@ -77,14 +122,6 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 		}, nil
 	}
 
-	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
-	}
-	if database == nil {
-		return nil, errors.Errorf("database not found %q", config.DatabaseName)
-	}
-
 	environment, err := e.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
 	if err != nil {
 		return nil, err
@@ -117,15 +154,7 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 		return nil, err
 	}
 
-	sheetUID := int(config.SheetUid)
-	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
-	}
-	if sheet == nil {
-		return nil, errors.Errorf("sheet %d not found", sheetUID)
-	}
-	if sheet.Size > common.MaxSheetCheckSize {
+	if len(statement) > common.MaxSheetCheckSize {
 		return []*storepb.PlanCheckRunResult_Result{
 			{
 				Status:  storepb.PlanCheckRunResult_Result_WARNING,
@@ -135,10 +164,6 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 			},
 		}, nil
 	}
-	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
-	}
 
 	policy, err := e.store.GetSQLReviewPolicy(ctx, environment.UID)
 	if err != nil {
@@ -175,7 +200,7 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 		Catalog:               catalog,
 		Driver:                connection,
 		Context:               ctx,
-		PreUpdateBackupDetail: config.PreUpdateBackupDetail,
+		PreUpdateBackupDetail: preUpdateBackupDetail,
 	})
 	if err != nil {
 		return nil, err
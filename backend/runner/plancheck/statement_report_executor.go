@@ -375,6 +375,10 @@ func reportForMySQL(ctx context.Context, sm *sheet.Manager, sqlDB *sql.DB, engin
 	for _, node := range nodes {
 		sqlType := mysqlparser.GetStatementType(node)
 		sqlTypeSet[sqlType] = struct{}{}
+		// Report the dropped and renamed objects of ALTER TABLE, the same as PostgreSQL does.
+		for _, actionType := range mysqlparser.GetAlterTableActionTypes(node) {
+			sqlTypeSet[actionType] = struct{}{}
+		}
 		resources, err := base.ExtractChangedResources(storepb.Engine_MYSQL, databaseName, "" /* currentSchema */, node)
 		if err != nil {
 			slog.Error("failed to extract changed resources", slog.String("statement", statement), log.BBError(err))
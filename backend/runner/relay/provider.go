@@ -0,0 +1,76 @@
+package relay
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/jira"
+	relayplugin "github.com/bytebase/bytebase/backend/plugin/app/relay"
+	"github.com/bytebase/bytebase/backend/plugin/app/servicenow"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	defaultServiceNowApprovedStates = []string{"approved"}
+	defaultServiceNowRejectedStates = []string{"rejected"}
+)
+
+// getExternalApprovalStatus returns the status of the external approval from its provider.
+// It returns an empty status if the external approval is still pending.
+func (r *Runner) getExternalApprovalStatus(ctx context.Context, node *storepb.ExternalApprovalSetting_Node, id string) (relayplugin.Status, error) {
+	switch node.Type {
+	case storepb.ExternalApprovalSetting_Node_JIRA:
+		status, err := jira.NewClient(node.JiraConfig).GetIssueStatus(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return mapExternalApprovalStatus(status, node.JiraConfig.GetApprovedStatuses(), node.JiraConfig.GetRejectedStatuses()), nil
+	case storepb.ExternalApprovalSetting_Node_SERVICE_NOW:
+		approval, err := servicenow.NewClient(node.ServiceNowConfig).GetChangeRequestApproval(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		approvedStates := node.ServiceNowConfig.GetApprovedStates()
+		if len(approvedStates) == 0 {
+			approvedStates = defaultServiceNowApprovedStates
+		}
+		rejectedStates := node.ServiceNowConfig.GetRejectedStates()
+		if len(rejectedStates) == 0 {
+			rejectedStates = defaultServiceNowRejectedStates
+		}
+		return mapExternalApprovalStatus(approval, approvedStates, rejectedStates), nil
+	default:
+		resp, err := r.Client.GetApproval(node.Endpoint, id)
+		if err != nil {
+			return "", err
+		}
+		return resp.Status, nil
+	}
+}
+
+// commentExternalApproval adds the comment to the Jira issue or the ServiceNow change request.
+// The relay service has no comment support.
+func commentExternalApproval(ctx context.Context, node *storepb.ExternalApprovalSetting_Node, id string, comment string) error {
+	switch node.Type {
+	case storepb.ExternalApprovalSetting_Node_JIRA:
+		return jira.NewClient(node.JiraConfig).AddComment(ctx, id, comment)
+	case storepb.ExternalApprovalSetting_Node_SERVICE_NOW:
+		return servicenow.NewClient(node.ServiceNowConfig).AddWorkNote(ctx, id, comment)
+	default:
+		return nil
+	}
+}
+
+func mapExternalApprovalStatus(status string, approvedStatuses, rejectedStatuses []string) relayplugin.Status {
+	match := func(s string) bool {
+		return strings.EqualFold(s, status)
+	}
+	if slices.ContainsFunc(approvedStatuses, match) {
+		return relayplugin.StatusApproved
+	}
+	if slices.ContainsFunc(rejectedStatuses, match) {
+		return relayplugin.StatusRejected
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package relay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	relayplugin "github.com/bytebase/bytebase/backend/plugin/app/relay"
+)
+
+func TestMapExternalApprovalStatus(t *testing.T) {
+	approved := []string{"Approved", "Implementing"}
+	rejected := []string{"Declined"}
+	tests := []struct {
+		status string
+		want   relayplugin.Status
+	}{
+		{status: "Approved", want: relayplugin.StatusApproved},
+		{status: "implementing", want: relayplugin.StatusApproved},
+		{status: "DECLINED", want: relayplugin.StatusRejected},
+		{status: "Waiting for approval", want: ""},
+		{status: "", want: ""},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, mapExternalApprovalStatus(test.status, approved, rejected), test.status)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/state"
@@ -66,6 +67,8 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	go r.listenIssueExternalApprovalRelayCancelChan(ctx, wg)
 	wg.Add(1)
 	go r.listenCheckExternalApprovalChan(ctx, wg)
+	wg.Add(1)
+	go r.listenIssueExternalApprovalRolloutChan(ctx, wg)
 
 	for {
 		select {
@@ -124,12 +127,15 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 	if err != nil {
 		return errors.Wrapf(err, "failed to get external approval node %s", payload.ExternalApprovalNodeID)
 	}
+	if node == nil {
+		return errors.Errorf("external approval node %s not found", payload.ExternalApprovalNodeID)
+	}
 	id := payload.ID
-	resp, err := r.Client.GetApproval(node.Endpoint, id)
+	approvalStatus, err := r.getExternalApprovalStatus(ctx, node, id)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get external approval status, id: %v, endpoint: %s, id: %s", node.Id, node.Endpoint, id)
 	}
-	if resp.Status == relayplugin.StatusApproved {
+	if approvalStatus == relayplugin.StatusApproved {
 		if err := r.ApproveExternalApprovalNode(ctx, approval.IssueUID); err != nil {
 			return err
 		}
@@ -139,7 +145,7 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 		}); err != nil {
 			return err
 		}
-	} else if resp.Status == relayplugin.StatusRejected {
+	} else if approvalStatus == relayplugin.StatusRejected {
 		if err := r.RejectExternalApprovalNode(ctx, approval.IssueUID); err != nil {
 			return err
 		}
@@ -201,6 +207,12 @@ func (r *Runner) cancelExternalApproval(ctx context.Context, issueUID int) {
 			continue
 		}
 		go func() {
+			if node.Type != storepb.ExternalApprovalSetting_Node_TYPE_UNSPECIFIED {
+				if err := commentExternalApproval(context.Background(), node, payload.ID, fmt.Sprintf("Bytebase issue #%d is canceled.", issueUID)); err != nil {
+					slog.Error("failed to comment on external approval", slog.String("node", node.Id), slog.String("id", payload.ID), log.BBError(err))
+				}
+				return
+			}
 			if err := r.Client.UpdateApproval(node.Endpoint, payload.ID, &relayplugin.UpdatePayload{}); err != nil {
 				slog.Error("failed to update external approval status", slog.String("endpoint", node.Endpoint), slog.String("id", payload.ID), log.BBError(err))
 			}
@@ -264,6 +276,8 @@ func (r *Runner) ApproveExternalApprovalNode(ctx context.Context, issueUID int)
 		return errors.Wrapf(err, "failed to handle incoming approval steps")
 	}
 	payload.Approval.Approvers = append(payload.Approval.Approvers, newApprovers...)
+	// The external approval node always takes one approval, so the flow moves on to the next step.
+	payload.Approval.StepPendingTime = timestamppb.Now()
 
 	issue, err = r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
@@ -424,3 +438,51 @@ func (r *Runner) listenIssueExternalApprovalRelayCancelChan(ctx context.Context,
 		}
 	}
 }
+
+func (r *Runner) listenIssueExternalApprovalRolloutChan(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case msg := <-r.stateCfg.IssueExternalApprovalRolloutChan:
+			if err := r.commentExternalApprovalRollout(ctx, msg); err != nil {
+				slog.Error("failed to comment rollout outcome on external approvals", slog.Int("issue", msg.IssueUID), log.BBError(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// commentExternalApprovalRollout comments the rollout outcome on the Jira issues and the ServiceNow change requests of the issue.
+func (r *Runner) commentExternalApprovalRollout(ctx context.Context, msg *state.IssueExternalApprovalRolloutMessage) error {
+	externalApprovalType := api.ExternalApprovalTypeRelay
+	approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		IssueUID:     &msg.IssueUID,
+		Type:         &externalApprovalType,
+		ShowArchived: true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list external approvals")
+	}
+	var errs error
+	for _, approval := range approvals {
+		payload := &api.ExternalApprovalPayloadRelay{}
+		if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "failed to unmarshal external approval payload"))
+			continue
+		}
+		node, err := getExternalApprovalByID(ctx, r.store, payload.ExternalApprovalNodeID)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		// The node may have been removed after the approval.
+		if node == nil {
+			continue
+		}
+		if err := commentExternalApproval(ctx, node, payload.ID, msg.Comment); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
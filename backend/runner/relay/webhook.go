@@ -0,0 +1,70 @@
+package relay
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// RegisterWebhookRoutes registers the routes receiving the status change webhooks from the external approval providers.
+// The Jira automation rule or the ServiceNow business rule posts to "/hook/external-approval/{nodeID}?token={webhookSecret}"
+// whenever the ticket status changes, then the runner checks the external approvals of the node right away instead of waiting for the next poll.
+func (r *Runner) RegisterWebhookRoutes(g *echo.Group) {
+	g.POST("/external-approval/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		nodeID := c.Param("id")
+		node, err := getExternalApprovalByID(ctx, r.store, nodeID)
+		if err != nil {
+			return c.String(http.StatusOK, fmt.Sprintf("failed to get external approval node %q, error %v", nodeID, err))
+		}
+		if node == nil {
+			return c.String(http.StatusOK, fmt.Sprintf("external approval node %q not found", nodeID))
+		}
+		token := c.QueryParam("token")
+		if token == "" {
+			token = c.Request().Header.Get("X-Bytebase-Token")
+		}
+		if node.WebhookSecret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(node.WebhookSecret)) != 1 {
+			return c.String(http.StatusOK, fmt.Sprintf("invalid webhook secret token %q", token))
+		}
+
+		externalApprovalType := api.ExternalApprovalTypeRelay
+		approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+			Type: &externalApprovalType,
+		})
+		if err != nil {
+			return c.String(http.StatusOK, fmt.Sprintf("failed to list external approvals, error %v", err))
+		}
+		var errs error
+		for _, approval := range approvals {
+			payload := &api.ExternalApprovalPayloadRelay{}
+			if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
+				errs = multierr.Append(errs, errors.Wrapf(err, "failed to unmarshal external approval payload"))
+				continue
+			}
+			if payload.ExternalApprovalNodeID != node.Id {
+				continue
+			}
+			msg := CheckExternalApprovalChanMessage{
+				ExternalApproval: approval,
+				ErrChan:          make(chan error, 1),
+			}
+			r.CheckExternalApprovalChan <- msg
+			if err := <-msg.ErrChan; err != nil {
+				errs = multierr.Append(errs, errors.Wrapf(err, "failed to check external approval status, issueUID %d", approval.IssueUID))
+			}
+		}
+		if errs != nil {
+			return c.String(http.StatusOK, errs.Error())
+		}
+		return c.String(http.StatusOK, "OK")
+	})
+}
@@ -0,0 +1,77 @@
+// Package retention is the runner that deletes the expired query histories by the workspace retention policy.
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const retentionRunnerInterval = time.Hour
+
+// NewRunner creates a new retention runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the retention runner.
+type Runner struct {
+	store *store.Store
+}
+
+// Run runs the retention runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(retentionRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Retention runner started and will run every %v", retentionRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.deleteExpiredQueryHistories(ctx)
+		case <-ctx.Done():
+			slog.Debug("Retention runner received context cancellation")
+			return
+		}
+	}
+}
+
+func (r *Runner) deleteExpiredQueryHistories(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("retention runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace general setting", log.BBError(err))
+		return
+	}
+	// The query histories are kept forever if the retention is not set.
+	if setting.QueryHistoryRetention == nil || setting.QueryHistoryRetention.AsDuration() <= 0 {
+		return
+	}
+	before := time.Now().Add(-setting.QueryHistoryRetention.AsDuration()).Unix()
+	count, err := r.store.DeleteQueryHistoriesBefore(ctx, before)
+	if err != nil {
+		slog.Error("failed to delete expired query histories", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Info("deleted expired query histories", slog.Int64("count", count))
+	}
+}
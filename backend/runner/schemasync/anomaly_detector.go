@@ -0,0 +1,121 @@
+package schemasync
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// InstanceAnomalyDetector detects an instance level anomaly during the instance sync.
+type InstanceAnomalyDetector interface {
+	// Detect returns the anomaly payload, or nil if the instance does not have the anomaly.
+	Detect(ctx context.Context, instance *store.InstanceMessage) (any, error)
+}
+
+// DatabaseAnomalyDetector detects a database level anomaly during the database schema sync.
+type DatabaseAnomalyDetector interface {
+	// Detect returns the anomaly payload, or nil if the database does not have the anomaly.
+	// The driver is connected to the database with the admin data source, and the metadata is the freshly synced one.
+	Detect(ctx context.Context, driver db.Driver, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) (any, error)
+}
+
+// RegisterInstanceDetector registers an instance anomaly detector.
+func (s *Syncer) RegisterInstanceDetector(anomalyType api.AnomalyType, detector InstanceAnomalyDetector) {
+	if detector == nil {
+		panic("schema syncer: RegisterInstanceDetector detector is nil for anomaly type: " + anomalyType)
+	}
+	if _, dup := s.instanceDetectors[anomalyType]; dup {
+		panic("schema syncer: RegisterInstanceDetector called twice for anomaly type: " + anomalyType)
+	}
+	s.instanceDetectors[anomalyType] = detector
+}
+
+// RegisterDatabaseDetector registers a database anomaly detector.
+func (s *Syncer) RegisterDatabaseDetector(anomalyType api.AnomalyType, detector DatabaseAnomalyDetector) {
+	if detector == nil {
+		panic("schema syncer: RegisterDatabaseDetector detector is nil for anomaly type: " + anomalyType)
+	}
+	if _, dup := s.databaseDetectors[anomalyType]; dup {
+		panic("schema syncer: RegisterDatabaseDetector called twice for anomaly type: " + anomalyType)
+	}
+	s.databaseDetectors[anomalyType] = detector
+}
+
+func (s *Syncer) detectInstanceAnomalies(ctx context.Context, instance *store.InstanceMessage) {
+	for anomalyType, detector := range s.instanceDetectors {
+		payload, err := detector.Detect(ctx, instance)
+		if err != nil {
+			// Keep the anomaly as it is if we fail to detect it.
+			slog.Warn("Failed to detect anomaly",
+				slog.String("instance", instance.ResourceID),
+				slog.String("type", string(anomalyType)),
+				log.BBError(err))
+			continue
+		}
+		s.upsertOrArchiveAnomaly(ctx, instance, nil /* database */, anomalyType, payload)
+	}
+}
+
+func (s *Syncer) detectDatabaseAnomalies(ctx context.Context, driver db.Driver, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) {
+	for anomalyType, detector := range s.databaseDetectors {
+		payload, err := detector.Detect(ctx, driver, database, metadata)
+		if err != nil {
+			// Keep the anomaly as it is if we fail to detect it.
+			slog.Warn("Failed to detect anomaly",
+				slog.String("instance", instance.ResourceID),
+				slog.String("database", database.DatabaseName),
+				slog.String("type", string(anomalyType)),
+				log.BBError(err))
+			continue
+		}
+		s.upsertOrArchiveAnomaly(ctx, instance, database, anomalyType, payload)
+	}
+}
+
+// upsertOrArchiveAnomaly upserts the active anomaly with the payload, or archives it if the payload is nil.
+func (s *Syncer) upsertOrArchiveAnomaly(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, anomalyType api.AnomalyType, payload any) {
+	attrs := []any{
+		slog.String("instance", instance.ResourceID),
+		slog.String("type", string(anomalyType)),
+	}
+	var databaseUID *int
+	if database != nil {
+		databaseUID = &database.UID
+		attrs = append(attrs, slog.String("database", database.DatabaseName))
+	}
+
+	if payload != nil {
+		bytes, err := json.Marshal(payload)
+		if err != nil {
+			slog.Error("Failed to marshal anomaly payload", append(attrs, log.BBError(err))...)
+			return
+		}
+		if _, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+			InstanceID:  instance.ResourceID,
+			DatabaseUID: databaseUID,
+			Type:        anomalyType,
+			Payload:     string(bytes),
+		}); err != nil {
+			slog.Error("Failed to create anomaly", append(attrs, log.BBError(err))...)
+		}
+		return
+	}
+
+	archive := &store.ArchiveAnomalyMessage{
+		DatabaseUID: databaseUID,
+		Type:        anomalyType,
+	}
+	if databaseUID == nil {
+		archive.InstanceID = &instance.ResourceID
+	}
+	if err := s.store.ArchiveAnomalyV2(ctx, archive); err != nil && common.ErrorCode(err) != common.NotFound {
+		slog.Error("Failed to close anomaly", append(attrs, log.BBError(err))...)
+	}
+}
@@ -0,0 +1,141 @@
+package schemasync
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestFindTablesWithoutPrimaryKey(t *testing.T) {
+	a := require.New(t)
+
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:    "users",
+						Indexes: []*storepb.IndexMetadata{{Name: "users_pkey", Expressions: []string{"id"}, Primary: true, Unique: true}},
+					},
+					{
+						Name:    "events",
+						Indexes: []*storepb.IndexMetadata{{Name: "events_user_id_idx", Expressions: []string{"user_id"}}},
+					},
+					{
+						Name: "logs",
+					},
+				},
+			},
+		},
+	}
+	a.Equal([]*api.AnomalyTable{
+		{Schema: "public", Table: "events"},
+		{Schema: "public", Table: "logs"},
+	}, findTablesWithoutPrimaryKey(metadata))
+}
+
+func TestFindDuplicateIndexes(t *testing.T) {
+	a := require.New(t)
+
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "orders",
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Type: "BTREE", Primary: true, Unique: true},
+							{Name: "uk_id", Expressions: []string{"id"}, Type: "BTREE", Unique: true},
+							{Name: "idx_user", Expressions: []string{"user_id"}, Type: "BTREE"},
+							{Name: "idx_user_created", Expressions: []string{"user_id", "created_at"}, Type: "BTREE"},
+							{Name: "idx_created_a", Expressions: []string{"created_at"}, Type: "BTREE"},
+							{Name: "idx_created_b", Expressions: []string{"created_at"}, Type: "BTREE"},
+							{Name: "uk_user_status", Expressions: []string{"user_id", "status"}, Type: "BTREE", Unique: true},
+							{Name: "idx_user_desc", Expressions: []string{"user_id"}, Type: "BTREE", Descending: []bool{true}},
+							{Name: "idx_note", Expressions: []string{"note"}, Type: "FULLTEXT"},
+						},
+					},
+				},
+			},
+		},
+	}
+	a.Equal([]*api.AnomalyIndex{
+		{Table: "orders", Index: "uk_id", CoveringIndex: "PRIMARY"},
+		{Table: "orders", Index: "idx_user", CoveringIndex: "idx_user_created"},
+		{Table: "orders", Index: "idx_created_b", CoveringIndex: "idx_created_a"},
+	}, findDuplicateIndexes(metadata))
+}
+
+func TestGetMySQLIntegerMaximum(t *testing.T) {
+	a := require.New(t)
+
+	tests := []struct {
+		columnType string
+		want       uint64
+		ok         bool
+	}{
+		{columnType: "tinyint(4)", want: 127, ok: true},
+		{columnType: "smallint unsigned", want: 65535, ok: true},
+		{columnType: "mediumint(9)", want: 8388607, ok: true},
+		{columnType: "int(11)", want: 2147483647, ok: true},
+		{columnType: "int(10) unsigned", want: 4294967295, ok: true},
+		{columnType: "bigint", want: 9223372036854775807, ok: true},
+		{columnType: "bigint(20) unsigned", want: 18446744073709551615, ok: true},
+		{columnType: "decimal(10,0)", ok: false},
+	}
+	for _, test := range tests {
+		got, ok := getMySQLIntegerMaximum(test.columnType)
+		a.Equal(test.ok, ok, test.columnType)
+		a.Equal(test.want, got, test.columnType)
+	}
+
+	a.True(isAutoIncrementExhausted(1800000000, 2147483647))
+	a.False(isAutoIncrementExhausted(1000, 2147483647))
+	a.False(isAutoIncrementExhausted(0, 0))
+}
+
+func TestParseMySQLReplicationLag(t *testing.T) {
+	a := require.New(t)
+
+	valid := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	tests := []struct {
+		row  map[string]sql.NullString
+		want time.Duration
+	}{
+		{
+			row: map[string]sql.NullString{
+				"Replica_IO_Running":    valid("Yes"),
+				"Replica_SQL_Running":   valid("Yes"),
+				"Seconds_Behind_Source": valid("120"),
+			},
+			want: 120 * time.Second,
+		},
+		{
+			row: map[string]sql.NullString{
+				"Slave_IO_Running":      valid("Yes"),
+				"Slave_SQL_Running":     valid("No"),
+				"Seconds_Behind_Master": {},
+			},
+			want: -1,
+		},
+		{
+			row: map[string]sql.NullString{
+				"Slave_IO_Running":      valid("Yes"),
+				"Slave_SQL_Running":     valid("Yes"),
+				"Seconds_Behind_Master": {},
+			},
+			want: -1,
+		},
+	}
+	for _, test := range tests {
+		a.Equal(test.want, *parseMySQLReplicationLag(test.row))
+	}
+}
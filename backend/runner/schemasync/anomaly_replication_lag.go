@@ -0,0 +1,171 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// replicationLagThreshold is the replication lag above which the read-only data source is reported.
+const replicationLagThreshold = 1 * time.Minute
+
+// ReplicationLagDetector detects the replication lag of the replicas behind read-only data sources.
+type ReplicationLagDetector struct {
+	dbFactory *dbfactory.DBFactory
+}
+
+// NewReplicationLagDetector creates a replication lag detector.
+func NewReplicationLagDetector(dbFactory *dbfactory.DBFactory) *ReplicationLagDetector {
+	return &ReplicationLagDetector{dbFactory: dbFactory}
+}
+
+// Detect implements InstanceAnomalyDetector.
+func (d *ReplicationLagDetector) Detect(ctx context.Context, instance *store.InstanceMessage) (any, error) {
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+	default:
+		return nil, nil
+	}
+
+	var lags []*api.AnomalyDataSourceLag
+	for _, dataSource := range instance.DataSources {
+		if dataSource.Type != api.RO {
+			continue
+		}
+		lag, err := d.getReplicationLag(ctx, instance, dataSource)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get replication lag of data source %q", dataSource.ID)
+		}
+		if lag == nil {
+			// The data source is not connected to a replica.
+			continue
+		}
+		if *lag < 0 || *lag >= replicationLagThreshold {
+			lagSeconds := int64(-1)
+			if *lag >= 0 {
+				lagSeconds = int64(lag.Seconds())
+			}
+			lags = append(lags, &api.AnomalyDataSourceLag{
+				DataSourceID: dataSource.ID,
+				LagSeconds:   lagSeconds,
+			})
+		}
+	}
+	if len(lags) == 0 {
+		return nil, nil
+	}
+	return &api.AnomalyInstanceReplicationLagPayload{DataSources: lags}, nil
+}
+
+// getReplicationLag returns the replication lag of the data source, nil if it's not a replica, or a negative duration if the replication is not running.
+func (d *ReplicationLagDetector) getReplicationLag(ctx context.Context, instance *store.InstanceMessage, dataSource *store.DataSourceMessage) (*time.Duration, error) {
+	driver, err := d.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, "", false /* datashare */, true /* readOnly */, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	if instance.Engine == storepb.Engine_POSTGRES {
+		return getPostgresReplicationLag(ctx, driver.GetDB())
+	}
+	return getMySQLReplicationLag(ctx, driver.GetDB())
+}
+
+func getPostgresReplicationLag(ctx context.Context, sqlDB *sql.DB) (*time.Duration, error) {
+	// The replay timestamp stops moving if the primary is idle, so we treat the replica as caught up if all received WAL has been replayed.
+	var lagSeconds float64
+	if err := sqlDB.QueryRowContext(ctx, `
+		SELECT
+			CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+			END
+		WHERE pg_is_in_recovery()`,
+	).Scan(&lagSeconds); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	lag := time.Duration(lagSeconds * float64(time.Second))
+	return &lag, nil
+}
+
+func getMySQLReplicationLag(ctx context.Context, sqlDB *sql.DB) (*time.Duration, error) {
+	// SHOW REPLICA STATUS is introduced in MySQL 8.0.22, fall back to SHOW SLAVE STATUS for older versions and MariaDB.
+	row, err := queryReplicaStatus(ctx, sqlDB, "SHOW REPLICA STATUS")
+	if err != nil {
+		row, err = queryReplicaStatus(ctx, sqlDB, "SHOW SLAVE STATUS")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if row == nil {
+		return nil, nil
+	}
+	return parseMySQLReplicationLag(row), nil
+}
+
+// queryReplicaStatus returns the replica status keyed by the column name, or nil if the server is not a replica.
+func queryReplicaStatus(ctx context.Context, sqlDB *sql.DB, statement string) (map[string]sql.NullString, error) {
+	rows, err := sqlDB.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	result := make(map[string]sql.NullString)
+	for i, column := range columns {
+		result[column] = values[i]
+	}
+	return result, rows.Err()
+}
+
+func parseMySQLReplicationLag(row map[string]sql.NullString) *time.Duration {
+	notRunning := time.Duration(-1)
+	for _, column := range []string{"Replica_IO_Running", "Slave_IO_Running", "Replica_SQL_Running", "Slave_SQL_Running"} {
+		if v, ok := row[column]; ok && !strings.EqualFold(v.String, "Yes") {
+			return &notRunning
+		}
+	}
+	for _, column := range []string{"Seconds_Behind_Source", "Seconds_Behind_Master"} {
+		v, ok := row[column]
+		if !ok {
+			continue
+		}
+		if !v.Valid {
+			return &notRunning
+		}
+		seconds, err := strconv.ParseInt(v.String, 10, 64)
+		if err != nil {
+			return &notRunning
+		}
+		lag := time.Duration(seconds) * time.Second
+		return &lag
+	}
+	return &notRunning
+}
@@ -0,0 +1,150 @@
+package schemasync
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// TableWithoutPrimaryKeyDetector detects the tables without a primary key from the synced metadata.
+type TableWithoutPrimaryKeyDetector struct{}
+
+// NewTableWithoutPrimaryKeyDetector creates a table without primary key detector.
+func NewTableWithoutPrimaryKeyDetector() *TableWithoutPrimaryKeyDetector {
+	return &TableWithoutPrimaryKeyDetector{}
+}
+
+// Detect implements DatabaseAnomalyDetector.
+func (*TableWithoutPrimaryKeyDetector) Detect(_ context.Context, driver db.Driver, _ *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) (any, error) {
+	if !supportIndexAnomaly(driver.GetType()) {
+		return nil, nil
+	}
+	tables := findTablesWithoutPrimaryKey(metadata)
+	if len(tables) == 0 {
+		return nil, nil
+	}
+	return &api.AnomalyDatabaseTableWithoutPrimaryKeyPayload{Tables: tables}, nil
+}
+
+// DuplicateIndexDetector detects the indexes covered by another index on the same table from the synced metadata.
+type DuplicateIndexDetector struct{}
+
+// NewDuplicateIndexDetector creates a duplicate index detector.
+func NewDuplicateIndexDetector() *DuplicateIndexDetector {
+	return &DuplicateIndexDetector{}
+}
+
+// Detect implements DatabaseAnomalyDetector.
+func (*DuplicateIndexDetector) Detect(_ context.Context, driver db.Driver, _ *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) (any, error) {
+	if !supportIndexAnomaly(driver.GetType()) {
+		return nil, nil
+	}
+	indexes := findDuplicateIndexes(metadata)
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+	return &api.AnomalyDatabaseIndexPayload{Indexes: indexes}, nil
+}
+
+// supportIndexAnomaly returns whether the engine syncs the primary key and indexes in the table metadata.
+func supportIndexAnomaly(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_POSTGRES, storepb.Engine_MSSQL:
+		return true
+	default:
+		return false
+	}
+}
+
+func findTablesWithoutPrimaryKey(metadata *storepb.DatabaseSchemaMetadata) []*api.AnomalyTable {
+	var tables []*api.AnomalyTable
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			hasPrimaryKey := false
+			for _, index := range table.GetIndexes() {
+				if index.GetPrimary() {
+					hasPrimaryKey = true
+					break
+				}
+			}
+			if !hasPrimaryKey {
+				tables = append(tables, &api.AnomalyTable{Schema: schema.GetName(), Table: table.GetName()})
+			}
+		}
+	}
+	return tables
+}
+
+func findDuplicateIndexes(metadata *storepb.DatabaseSchemaMetadata) []*api.AnomalyIndex {
+	var indexes []*api.AnomalyIndex
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			for _, index := range table.GetIndexes() {
+				for _, other := range table.GetIndexes() {
+					if index == other || !coversIndex(other, index) {
+						continue
+					}
+					// Two identical indexes cover each other, only report the one with the greater name.
+					if coversIndex(index, other) && index.GetName() < other.GetName() {
+						continue
+					}
+					indexes = append(indexes, &api.AnomalyIndex{
+						Schema:        schema.GetName(),
+						Table:         table.GetName(),
+						Index:         index.GetName(),
+						CoveringIndex: other.GetName(),
+					})
+					break
+				}
+			}
+		}
+	}
+	return indexes
+}
+
+// coversIndex returns whether the index y makes the index x redundant.
+func coversIndex(y, x *storepb.IndexMetadata) bool {
+	if x.GetPrimary() || !strings.EqualFold(x.GetType(), y.GetType()) {
+		return false
+	}
+	if len(x.GetExpressions()) == 0 || len(x.GetExpressions()) > len(y.GetExpressions()) {
+		return false
+	}
+	for i := range x.GetExpressions() {
+		if x.GetExpressions()[i] != y.GetExpressions()[i] ||
+			getKeyLength(x, i) != getKeyLength(y, i) ||
+			getDescending(x, i) != getDescending(y, i) {
+			return false
+		}
+	}
+	if len(x.GetExpressions()) == len(y.GetExpressions()) {
+		// A unique index is only covered by another unique index on the same keys.
+		return !x.GetUnique() || y.GetUnique() || y.GetPrimary()
+	}
+	// Only a non-unique B-tree index is covered by the left prefix of another index.
+	return !x.GetUnique() && isBTreeIndex(x)
+}
+
+func getKeyLength(index *storepb.IndexMetadata, i int) int64 {
+	if i < len(index.GetKeyLength()) {
+		return index.GetKeyLength()[i]
+	}
+	return -1
+}
+
+func getDescending(index *storepb.IndexMetadata, i int) bool {
+	if i < len(index.GetDescending()) {
+		return index.GetDescending()[i]
+	}
+	return false
+}
+
+func isBTreeIndex(index *storepb.IndexMetadata) bool {
+	// MySQL reports BTREE, PostgreSQL reports btree, and TiDB may leave the type empty.
+	tp := strings.ToLower(index.GetType())
+	return tp == "" || tp == "btree"
+}
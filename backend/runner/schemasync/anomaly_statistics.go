@@ -0,0 +1,207 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// autoIncrementExhaustionRatio is the ratio of the current value to the maximum value above which the column is reported.
+const autoIncrementExhaustionRatio = 0.8
+
+// UnusedIndexDetector detects the indexes that have never been scanned since the statistics were reset,
+// using pg_stat_user_indexes for PostgreSQL and the sys schema for MySQL.
+type UnusedIndexDetector struct{}
+
+// NewUnusedIndexDetector creates an unused index detector.
+func NewUnusedIndexDetector() *UnusedIndexDetector {
+	return &UnusedIndexDetector{}
+}
+
+// Detect implements DatabaseAnomalyDetector.
+func (*UnusedIndexDetector) Detect(ctx context.Context, driver db.Driver, database *store.DatabaseMessage, _ *storepb.DatabaseSchemaMetadata) (any, error) {
+	var query string
+	var args []any
+	switch driver.GetType() {
+	case storepb.Engine_POSTGRES:
+		// Unique indexes are used to enforce the constraints even if they are never scanned.
+		query = `
+			SELECT s.schemaname, s.relname, s.indexrelname
+			FROM pg_stat_user_indexes s
+			JOIN pg_index i ON s.indexrelid = i.indexrelid
+			WHERE s.idx_scan = 0 AND NOT i.indisunique AND NOT i.indisprimary
+			ORDER BY s.schemaname, s.relname, s.indexrelname`
+	case storepb.Engine_MYSQL:
+		// sys.schema_unused_indexes requires the performance schema, and it excludes the primary keys and unique indexes.
+		query = `
+			SELECT '', object_name, index_name
+			FROM sys.schema_unused_indexes
+			WHERE object_schema = ?
+			ORDER BY object_name, index_name`
+		args = append(args, database.DatabaseName)
+	default:
+		return nil, nil
+	}
+
+	rows, err := driver.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query unused indexes")
+	}
+	defer rows.Close()
+	var indexes []*api.AnomalyIndex
+	for rows.Next() {
+		index := &api.AnomalyIndex{}
+		if err := rows.Scan(&index.Schema, &index.Table, &index.Index); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+	return &api.AnomalyDatabaseIndexPayload{Indexes: indexes}, nil
+}
+
+// AutoIncrementExhaustionDetector detects the auto-increment columns and sequences nearing their maximum value.
+type AutoIncrementExhaustionDetector struct{}
+
+// NewAutoIncrementExhaustionDetector creates an auto-increment exhaustion detector.
+func NewAutoIncrementExhaustionDetector() *AutoIncrementExhaustionDetector {
+	return &AutoIncrementExhaustionDetector{}
+}
+
+// Detect implements DatabaseAnomalyDetector.
+func (*AutoIncrementExhaustionDetector) Detect(ctx context.Context, driver db.Driver, database *store.DatabaseMessage, _ *storepb.DatabaseSchemaMetadata) (any, error) {
+	var columns []*api.AnomalyAutoIncrementColumn
+	var err error
+	switch driver.GetType() {
+	case storepb.Engine_POSTGRES:
+		columns, err = listPostgresSequences(ctx, driver.GetDB())
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		columns, err = listMySQLAutoIncrementColumns(ctx, driver.GetDB(), database.DatabaseName)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var exhausted []*api.AnomalyAutoIncrementColumn
+	for _, column := range columns {
+		if isAutoIncrementExhausted(column.CurrentValue, column.MaximumValue) {
+			exhausted = append(exhausted, column)
+		}
+	}
+	if len(exhausted) == 0 {
+		return nil, nil
+	}
+	return &api.AnomalyDatabaseAutoIncrementExhaustionPayload{Columns: exhausted}, nil
+}
+
+func listPostgresSequences(ctx context.Context, sqlDB *sql.DB) ([]*api.AnomalyAutoIncrementColumn, error) {
+	// Only ascending sequences could be exhausted by the max_value.
+	rows, err := sqlDB.QueryContext(ctx, `
+		SELECT schemaname, sequencename, last_value, max_value
+		FROM pg_sequences
+		WHERE last_value IS NOT NULL AND increment_by > 0 AND last_value > 0
+		ORDER BY schemaname, sequencename`)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query sequences")
+	}
+	defer rows.Close()
+	var columns []*api.AnomalyAutoIncrementColumn
+	for rows.Next() {
+		column := &api.AnomalyAutoIncrementColumn{}
+		if err := rows.Scan(&column.Schema, &column.Sequence, &column.CurrentValue, &column.MaximumValue); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+func listMySQLAutoIncrementColumns(ctx context.Context, sqlDB *sql.DB, databaseName string) ([]*api.AnomalyAutoIncrementColumn, error) {
+	rows, err := sqlDB.QueryContext(ctx, `
+		SELECT t.TABLE_NAME, c.COLUMN_NAME, c.COLUMN_TYPE, t.AUTO_INCREMENT
+		FROM information_schema.TABLES t
+		JOIN information_schema.COLUMNS c ON t.TABLE_SCHEMA = c.TABLE_SCHEMA AND t.TABLE_NAME = c.TABLE_NAME
+		WHERE t.TABLE_SCHEMA = ? AND t.AUTO_INCREMENT IS NOT NULL AND c.EXTRA LIKE '%auto_increment%'
+		ORDER BY t.TABLE_NAME`,
+		databaseName,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query auto-increment columns")
+	}
+	defer rows.Close()
+	var columns []*api.AnomalyAutoIncrementColumn
+	for rows.Next() {
+		column := &api.AnomalyAutoIncrementColumn{}
+		var columnType string
+		if err := rows.Scan(&column.Table, &column.Column, &columnType, &column.CurrentValue); err != nil {
+			return nil, err
+		}
+		maximum, ok := getMySQLIntegerMaximum(columnType)
+		if !ok {
+			continue
+		}
+		column.MaximumValue = maximum
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+var mysqlIntegerTypeRegexp = regexp.MustCompile(`^(tinyint|smallint|mediumint|int|integer|bigint)\b`)
+
+// getMySQLIntegerMaximum returns the maximum value of the MySQL integer column type, e.g. "int(11) unsigned".
+func getMySQLIntegerMaximum(columnType string) (uint64, bool) {
+	columnType = strings.ToLower(strings.TrimSpace(columnType))
+	match := mysqlIntegerTypeRegexp.FindStringSubmatch(columnType)
+	if match == nil {
+		return 0, false
+	}
+	unsigned := strings.Contains(columnType, "unsigned")
+	var bits uint
+	switch match[1] {
+	case "tinyint":
+		bits = 8
+	case "smallint":
+		bits = 16
+	case "mediumint":
+		bits = 24
+	case "int", "integer":
+		bits = 32
+	case "bigint":
+		bits = 64
+	}
+	if unsigned {
+		if bits == 64 {
+			return ^uint64(0), true
+		}
+		return 1<<bits - 1, true
+	}
+	return 1<<(bits-1) - 1, true
+}
+
+func isAutoIncrementExhausted(current, maximum uint64) bool {
+	if maximum == 0 {
+		return false
+	}
+	return float64(current) >= float64(maximum)*autoIncrementExhaustionRatio
+}
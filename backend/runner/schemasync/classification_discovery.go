@@ -0,0 +1,218 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/classifier"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// suggestClassifications suggests the classifications of the unclassified columns by the discovery rules of the project's data classification config.
+// The suggestions are kept in the column configs until they are approved or rejected.
+func (s *Syncer) suggestClassifications(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata, dbModelConfig *model.DatabaseConfig) {
+	// The classifications from the comments are overwritten on every sync, so there is nothing to approve.
+	if !dbModelConfig.ClassificationFromConfig {
+		return
+	}
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) != nil {
+		return
+	}
+	if err := s.doSuggestClassifications(ctx, instance, database, metadata, dbModelConfig); err != nil {
+		slog.Error("Failed to suggest classifications",
+			slog.String("instance", instance.ResourceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
+	}
+}
+
+func (s *Syncer) doSuggestClassifications(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata, dbModelConfig *model.DatabaseConfig) error {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil || project.DataClassificationConfigID == "" {
+		return nil
+	}
+	setting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get data classification setting")
+	}
+	var config *storepb.DataClassificationSetting_DataClassificationConfig
+	for _, c := range setting.Configs {
+		if c.Id == project.DataClassificationConfigID {
+			config = c
+			break
+		}
+	}
+	if config == nil {
+		return nil
+	}
+	c, err := classifier.New(config)
+	if err != nil {
+		return errors.Wrapf(err, "invalid data classification config %q", config.Id)
+	}
+	if c.IsEmpty() {
+		return nil
+	}
+
+	// The driver is opened lazily, only if any table needs to be sampled.
+	var driver db.Driver
+	defer func() {
+		if driver != nil {
+			driver.Close(ctx)
+		}
+	}()
+	for _, schema := range metadata.Schemas {
+		schemaConfig := dbModelConfig.CreateOrGetSchemaConfig(schema.Name)
+		for _, table := range schema.Tables {
+			tableConfig := schemaConfig.CreateOrGetTableConfig(table.Name)
+
+			var pending []*storepb.ColumnMetadata
+			needSample := false
+			for _, column := range table.Columns {
+				columnConfig := tableConfig.CreateOrGetColumnConfig(column.Name)
+				if columnConfig.ClassificationId == "" && columnConfig.ClassificationSuggestion == nil {
+					pending = append(pending, column)
+					needSample = needSample || c.NeedSample(column.Name, column.Type)
+				}
+			}
+
+			var samples map[string][]string
+			if needSample && supportClassificationSampling(instance.Engine) {
+				if driver == nil {
+					driver, err = s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "")
+					if err != nil {
+						return errors.Wrapf(err, "failed to get read-only database driver")
+					}
+				}
+				samples, err = sampleTable(ctx, driver, instance.Engine, database.DatabaseName, schema.Name, table.Name, c.SampleSize())
+				if err != nil {
+					slog.Warn("Failed to sample table for classification discovery",
+						slog.String("instance", instance.ResourceID),
+						slog.String("database", database.DatabaseName),
+						slog.String("schema", schema.Name),
+						slog.String("table", table.Name),
+						log.BBError(err))
+				}
+			}
+			for _, column := range pending {
+				var values []string
+				if samples != nil {
+					values = samples[column.Name]
+				}
+				if suggestion := c.Suggest(column.Name, column.Type, values); suggestion != nil {
+					tableConfig.CreateOrGetColumnConfig(column.Name).ClassificationSuggestion = suggestion
+				}
+			}
+
+			for _, column := range table.Columns {
+				if isEmptyColumnConfig(tableConfig.CreateOrGetColumnConfig(column.Name)) {
+					tableConfig.RemoveColumnConfig(column.Name)
+				}
+			}
+			if tableConfig.IsEmpty() {
+				schemaConfig.RemoveTableConfig(table.Name)
+			}
+		}
+		if schemaConfig.IsEmpty() {
+			dbModelConfig.RemoveSchemaConfig(schema.Name)
+		}
+	}
+	return nil
+}
+
+func supportClassificationSampling(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_MSSQL:
+		return true
+	default:
+		return false
+	}
+}
+
+// sampleTable returns the string values of the sampled rows by the column names.
+func sampleTable(ctx context.Context, driver db.Driver, engine storepb.Engine, databaseName, schema, table string, limit int) (map[string][]string, error) {
+	quote := func(identifier string) string {
+		switch engine {
+		case storepb.Engine_MSSQL:
+			return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+		case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT:
+			return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+		default:
+			return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+		}
+	}
+	name := quote(table)
+	if schema != "" {
+		name = fmt.Sprintf("%s.%s", quote(schema), name)
+	}
+
+	var conn *sql.Conn
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		var err error
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get connection")
+		}
+		defer conn.Close()
+	}
+	results, err := driver.QueryConn(ctx, conn, fmt.Sprintf("SELECT * FROM %s", name), &db.QueryContext{
+		Limit:           limit,
+		ReadOnly:        true,
+		CurrentDatabase: databaseName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expect 1 result but got %d", len(results))
+	}
+	if results[0].Error != "" {
+		return nil, errors.New(results[0].Error)
+	}
+
+	samples := make(map[string][]string)
+	for _, row := range results[0].Rows {
+		for i, value := range row.Values {
+			if i >= len(results[0].ColumnNames) {
+				break
+			}
+			name := results[0].ColumnNames[i]
+			samples[name] = append(samples[name], rowValueToString(value))
+		}
+	}
+	return samples, nil
+}
+
+func rowValueToString(value *v1pb.RowValue) string {
+	switch v := value.GetKind().(type) {
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(v.Int32Value), 10)
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(v.Int64Value, 10)
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(v.Uint32Value), 10)
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(v.Uint64Value, 10)
+	case *v1pb.RowValue_BytesValue:
+		return string(v.BytesValue)
+	default:
+		// The null, bool and float values are never detected.
+		return ""
+	}
+}
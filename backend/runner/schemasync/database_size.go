@@ -0,0 +1,63 @@
+package schemasync
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// databaseSizeRollupAge is the age after which the database size samples are rolled up to one sample per day.
+	databaseSizeRollupAge = 7 * 24 * time.Hour
+	// databaseSizeRetention is the maximum age of the database size samples.
+	databaseSizeRetention = 365 * 24 * time.Hour
+)
+
+// recordDatabaseSize samples the size statistics of the database for capacity planning,
+// and prunes the old samples of the database.
+func (s *Syncer) recordDatabaseSize(ctx context.Context, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) {
+	if err := s.store.CreateDatabaseSizeHistory(ctx, &store.DatabaseSizeHistoryMessage{
+		DatabaseUID: database.UID,
+		Payload:     buildDatabaseSizeSnapshot(metadata),
+	}); err != nil {
+		slog.Error("Failed to record database size",
+			slog.String("instance", database.InstanceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
+		return
+	}
+	now := time.Now()
+	if err := s.store.PruneDatabaseSizeHistory(ctx, &store.PruneDatabaseSizeHistoryMessage{
+		DatabaseUID:    database.UID,
+		RollupBeforeTs: now.Add(-databaseSizeRollupAge).Unix(),
+		DeleteBeforeTs: now.Add(-databaseSizeRetention).Unix(),
+	}); err != nil {
+		slog.Error("Failed to prune database size history",
+			slog.String("instance", database.InstanceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
+	}
+}
+
+func buildDatabaseSizeSnapshot(metadata *storepb.DatabaseSchemaMetadata) *storepb.DatabaseSizeSnapshot {
+	snapshot := &storepb.DatabaseSizeSnapshot{}
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			snapshot.RowCount += table.RowCount
+			snapshot.DataSize += table.DataSize
+			snapshot.IndexSize += table.IndexSize
+			snapshot.Tables = append(snapshot.Tables, &storepb.TableSizeSnapshot{
+				Schema:    schema.Name,
+				Name:      table.Name,
+				RowCount:  table.RowCount,
+				DataSize:  table.DataSize,
+				IndexSize: table.IndexSize,
+			})
+		}
+	}
+	return snapshot
+}
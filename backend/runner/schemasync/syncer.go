@@ -21,7 +21,9 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/tracing"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -44,6 +46,9 @@ func NewSyncer(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *sta
 		stateCfg:       stateCfg,
 		profile:        profile,
 		licenseService: licenseService,
+
+		instanceDetectors: make(map[api.AnomalyType]InstanceAnomalyDetector),
+		databaseDetectors: make(map[api.AnomalyType]DatabaseAnomalyDetector),
 	}
 }
 
@@ -54,6 +59,9 @@ type Syncer struct {
 	stateCfg       *state.State
 	profile        config.Profile
 	licenseService enterprise.LicenseService
+
+	instanceDetectors map[api.AnomalyType]InstanceAnomalyDetector
+	databaseDetectors map[api.AnomalyType]DatabaseAnomalyDetector
 }
 
 // Run will run the schema syncer once.
@@ -87,6 +95,8 @@ func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
 }
 
 func (s *Syncer) trySyncAll(ctx context.Context) {
+	ctx, span := tracing.Start(ctx, "schemasync.Syncer.trySyncAll")
+	defer span.End()
 	defer func() {
 		if r := recover(); r != nil {
 			err, ok := r.(error)
@@ -160,6 +170,23 @@ func (s *Syncer) trySyncAll(ctx context.Context) {
 				log.BBError(err))
 		}
 	}
+
+	s.reportActiveAnomalies(ctx)
+}
+
+// reportActiveAnomalies exports the number of active anomalies by type to the operational metrics.
+func (s *Syncer) reportActiveAnomalies(ctx context.Context) {
+	status := api.Normal
+	anomalies, err := s.store.ListAnomalyV2(ctx, &store.ListAnomalyMessage{RowStatus: &status})
+	if err != nil {
+		slog.Error("Failed to list active anomalies", log.BBError(err))
+		return
+	}
+	counts := map[string]int{}
+	for _, anomaly := range anomalies {
+		counts[string(anomaly.Type)]++
+	}
+	metrics.SetActiveAnomalies(counts)
 }
 
 func (s *Syncer) syncAllDatabases(ctx context.Context, instance *store.InstanceMessage) {
@@ -222,12 +249,14 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
 	if err != nil {
 		s.upsertInstanceConnectionAnomaly(ctx, instance, err)
+		metrics.ObserveInstanceSync(instance.ResourceID, instance.Engine, err)
 		return nil, err
 	}
 	defer driver.Close(ctx)
 	s.upsertInstanceConnectionAnomaly(ctx, instance, nil)
 
 	instanceMeta, err := driver.SyncInstance(ctx)
+	metrics.ObserveInstanceSync(instance.ResourceID, instance.Engine, err)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to sync instance: %s", instance.ResourceID)
 	}
@@ -262,6 +291,8 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 		return updatedInstance, err
 	}
 
+	s.detectInstanceAnomalies(ctx, instance)
+
 	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID})
 	if err != nil {
 		return updatedInstance, errors.Wrapf(err, "failed to sync database for instance: %s. Failed to find database list", instance.ResourceID)
@@ -390,6 +421,9 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 			rawDump = schemaBuf.Bytes()
 		}
 
+		// Only the changed schema is scanned, since the sampling is expensive for the large databases.
+		s.suggestClassifications(ctx, instance, database, databaseMetadata, dbModelConfig)
+
 		if err := s.store.UpsertDBSchema(ctx,
 			database.UID,
 			model.NewDBSchema(databaseMetadata, rawDump, dbModelConfig.BuildDatabaseConfig()),
@@ -404,6 +438,9 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		}
 	}
 
+	s.recordDatabaseSize(ctx, database, databaseMetadata)
+	s.detectDatabaseAnomalies(ctx, driver, instance, database, databaseMetadata)
+
 	// Check schema drift
 	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSchemaDrift, instance) == nil {
 		// Redis and MongoDB are schemaless.
@@ -596,7 +633,7 @@ func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchema
 }
 
 func isEmptyColumnConfig(config *storepb.ColumnConfig) bool {
-	return len(config.Labels) == 0 && config.ClassificationId == "" && config.SemanticTypeId == ""
+	return len(config.Labels) == 0 && config.ClassificationId == "" && config.SemanticTypeId == "" && config.ClassificationSuggestion == nil
 }
 
 func setUserCommentFromComment(dbSchema *storepb.DatabaseSchemaMetadata) {
@@ -134,3 +134,25 @@ func TestEqualDatabaseMetadata(t *testing.T) {
 		assert.Equal(t, test.want, got)
 	}
 }
+
+func TestBuildDatabaseSizeSnapshot(t *testing.T) {
+	a := assert.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{Name: "t1", RowCount: 10, DataSize: 100, IndexSize: 20},
+					{Name: "t2", RowCount: 5, DataSize: 50, IndexSize: 10},
+				},
+			},
+		},
+	}
+	snapshot := buildDatabaseSizeSnapshot(metadata)
+	a.Equal(int64(15), snapshot.RowCount)
+	a.Equal(int64(150), snapshot.DataSize)
+	a.Equal(int64(30), snapshot.IndexSize)
+	a.Len(snapshot.Tables, 2)
+	a.Equal("public", snapshot.Tables[1].Schema)
+	a.Equal("t2", snapshot.Tables[1].Name)
+}
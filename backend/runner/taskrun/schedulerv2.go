@@ -13,7 +13,10 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/tracing"
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -31,15 +34,17 @@ type SchedulerV2 struct {
 	store          *store.Store
 	stateCfg       *state.State
 	webhookManager *webhook.Manager
+	dbFactory      *dbfactory.DBFactory
 	executorMap    map[api.TaskType]Executor
 }
 
 // NewSchedulerV2 will create a new scheduler.
-func NewSchedulerV2(store *store.Store, stateCfg *state.State, webhookManager *webhook.Manager) *SchedulerV2 {
+func NewSchedulerV2(store *store.Store, stateCfg *state.State, webhookManager *webhook.Manager, dbFactory *dbfactory.DBFactory) *SchedulerV2 {
 	return &SchedulerV2{
 		store:          store,
 		stateCfg:       stateCfg,
 		webhookManager: webhookManager,
+		dbFactory:      dbFactory,
 		executorMap:    map[api.TaskType]Executor{},
 	}
 }
@@ -76,6 +81,8 @@ func (s *SchedulerV2) Run(ctx context.Context, wg *sync.WaitGroup) {
 }
 
 func (s *SchedulerV2) runOnce(ctx context.Context) {
+	ctx, span := tracing.Start(ctx, "taskrun.SchedulerV2.runOnce")
+	defer span.End()
 	defer func() {
 		if r := recover(); r != nil {
 			err, ok := r.(error)
@@ -364,26 +371,27 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 			)
 			continue
 		}
-		maximumConnections := int(instance.Options.GetMaximumConnections())
-		if maximumConnections == 0 {
-			maximumConnections = state.DefaultInstanceMaximumConnections
-		}
-		s.stateCfg.Lock()
-		if s.stateCfg.InstanceOutstandingConnections[task.InstanceID] >= maximumConnections {
-			s.stateCfg.Unlock()
+		if !s.dbFactory.TryAcquireInstanceConnection(instance) {
 			continue
 		}
-		s.stateCfg.InstanceOutstandingConnections[task.InstanceID]++
-		s.stateCfg.Unlock()
 
 		s.stateCfg.RunningTaskRuns.Store(taskRun.ID, true)
-		go s.runTaskRunOnce(ctx, taskRun, task, executor)
+		// The drivers of the task run share the connection reserved for it.
+		go s.runTaskRunOnce(dbfactory.WithReservedInstanceConnection(ctx, instance), taskRun, task, instance, executor)
 	}
 
 	return nil
 }
 
-func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage, executor Executor) {
+func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage, instance *store.InstanceMessage, executor Executor) {
+	ctx, span := tracing.Start(ctx, "taskrun.SchedulerV2.runTaskRunOnce",
+		tracing.Engine(instance.Engine),
+		tracing.Instance(instance.ResourceID),
+		tracing.TaskTypeKey.String(string(task.Type)),
+		tracing.TaskRunKey.Int(taskRun.ID),
+	)
+	defer span.End()
+	start := time.Now()
 	defer func() {
 		s.stateCfg.TaskRunExecutionStatuses.Delete(taskRun.ID)
 		// We don't need to do s.stateCfg.RunningTaskRuns.Delete(taskRun.ID) to avoid race condition.
@@ -391,9 +399,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		if task.DatabaseID != nil {
 			s.stateCfg.RunningDatabaseMigration.Delete(*task.DatabaseID)
 		}
-		s.stateCfg.Lock()
-		s.stateCfg.InstanceOutstandingConnections[task.InstanceID]--
-		s.stateCfg.Unlock()
+		s.dbFactory.ReleaseInstanceConnection(instance)
 	}()
 
 	driverCtx, cancel := context.WithCancel(ctx)
@@ -404,6 +410,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 	}
 
 	done, result, err := RunExecutorOnce(ctx, driverCtx, executor, task, taskRun.ID)
+	tracing.SetError(span, err)
 
 	if !done && err != nil {
 		slog.Debug("Encountered transient error running task, will retry",
@@ -416,6 +423,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 	}
 
 	if done && err != nil && errors.Is(err, context.Canceled) {
+		metrics.ObserveTaskRun(string(task.Type), instance.Engine, metrics.OutcomeCanceled, time.Since(start))
 		slog.Warn("task run is canceled",
 			slog.Int("id", task.ID),
 			slog.String("name", task.Name),
@@ -457,6 +465,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 	}
 
 	if done && err != nil {
+		metrics.ObserveTaskRun(string(task.Type), instance.Engine, metrics.OutcomeFailed, time.Since(start))
 		slog.Warn("task run failed",
 			slog.Int("id", task.ID),
 			slog.String("name", task.Name),
@@ -525,6 +534,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 	}
 
 	if done && err == nil {
+		metrics.ObserveTaskRun(string(task.Type), instance.Engine, metrics.OutcomeDone, time.Since(start))
 		resultBytes, marshalErr := protojson.Marshal(result)
 		if marshalErr != nil {
 			slog.Error("Failed to marshal task run result",
@@ -749,6 +759,8 @@ func (s *SchedulerV2) ListenTaskSkippedOrDone(ctx context.Context) {
 					}(); err != nil {
 						slog.Error("failed to update issue status", log.BBError(err))
 					}
+					// The write-back calls the VCS APIs, run it in the background not to block handling the other tasks.
+					go s.writeBackSchemaToVCS(ctx, issue)
 				}
 				return nil
 			}(); err != nil {
@@ -783,6 +795,18 @@ func (s *SchedulerV2) createActivityForTaskRunStatusUpdate(ctx context.Context,
 				Detail: errDetail,
 			},
 		})
+		if newStatus == api.TaskRunDone || newStatus == api.TaskRunFailed {
+			comment := fmt.Sprintf("Bytebase task %q of issue #%d finished with status %s.", task.Name, issue.UID, newStatus.String())
+			if errDetail != "" {
+				comment = fmt.Sprintf("%s\n%s", comment, errDetail)
+			}
+			// Don't block the scheduler if the relay runner is busy.
+			select {
+			case s.stateCfg.IssueExternalApprovalRolloutChan <- &state.IssueExternalApprovalRolloutMessage{IssueUID: issue.UID, Comment: comment}:
+			default:
+				slog.Warn("failed to send the rollout outcome to external approvals", slog.Int("issue", issue.UID))
+			}
+		}
 		return nil
 	}(); err != nil {
 		slog.Error("failed to create activity for task run status update", log.BBError(err))
@@ -0,0 +1,351 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// schemaWriteBackBranchPrefix is the prefix of the branches created for the schema write-back pull requests.
+	schemaWriteBackBranchPrefix = "bytebase/schema-write-back"
+)
+
+// writeBackSchemaToVCS writes the latest schema of the databases changed by the issue back to the repository
+// of the VCS connector the issue is created from, so that the drift between the repository and the databases
+// becomes visible in code review.
+func (s *SchedulerV2) writeBackSchemaToVCS(ctx context.Context, issue *store.IssueMessage) {
+	if err := s.writeBackSchemaToVCSImpl(ctx, issue); err != nil {
+		slog.Error("failed to write back schema to VCS", slog.Int("issue_uid", issue.UID), log.BBError(err))
+	}
+}
+
+func (s *SchedulerV2) writeBackSchemaToVCSImpl(ctx context.Context, issue *store.IssueMessage) error {
+	if issue.PlanUID == nil || issue.PipelineUID == nil {
+		return nil
+	}
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil {
+		return nil
+	}
+	vcsSource := plan.Config.GetVcsSource()
+	if vcsSource.GetVcsConnector() == "" {
+		return nil
+	}
+
+	projectID, vcsConnectorID, err := common.GetProjectVCSConnectorID(vcsSource.GetVcsConnector())
+	if err != nil {
+		return err
+	}
+	vcsConnector, err := s.store.GetVCSConnector(ctx, &store.FindVCSConnectorMessage{ProjectID: &projectID, ResourceID: &vcsConnectorID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS connector %q", vcsSource.GetVcsConnector())
+	}
+	if vcsConnector == nil {
+		return nil
+	}
+	schemaWriteBack := vcsConnector.Payload.GetSchemaWriteBack()
+	if schemaWriteBack.GetMode() == storepb.VCSConnector_SchemaWriteBack_MODE_UNSPECIFIED {
+		return nil
+	}
+	vcsProvider, err := s.store.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS provider %q", vcsConnector.VCSResourceID)
+	}
+	if vcsProvider == nil {
+		return nil
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	repositoryID := vcsConnector.Payload.GetExternalId()
+	branch := vcsConnector.Payload.GetBranch()
+
+	tasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: issue.PipelineUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list tasks")
+	}
+	var files []*vcs.CommitFile
+	databaseWritten := map[int]bool{}
+	for _, task := range tasks {
+		if task.DatabaseID == nil || databaseWritten[*task.DatabaseID] {
+			continue
+		}
+		if task.LatestTaskRunStatus != api.TaskRunDone || !isSchemaChangeTask(task.Type) {
+			continue
+		}
+		databaseWritten[*task.DatabaseID] = true
+		databaseFiles, err := s.getSchemaWriteBackFiles(ctx, provider, repositoryID, branch, task, schemaWriteBack)
+		if err != nil {
+			return err
+		}
+		files = append(files, databaseFiles...)
+	}
+
+	files, err = filterUnchangedFiles(ctx, provider, repositoryID, branch, files)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	title := fmt.Sprintf("[Bytebase] Update schema after %q", issue.Title)
+	switch schemaWriteBack.GetMode() {
+	case storepb.VCSConnector_SchemaWriteBack_COMMIT:
+		return provider.CreateCommit(ctx, repositoryID, &vcs.FileCommitCreate{
+			Branch:  branch,
+			Message: title,
+			Files:   files,
+		})
+	case storepb.VCSConnector_SchemaWriteBack_PULL_REQUEST:
+		branchInfo, err := provider.GetBranch(ctx, repositoryID, branch)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get branch %q", branch)
+		}
+		headBranch := fmt.Sprintf("%s-%d", schemaWriteBackBranchPrefix, issue.UID)
+		if err := provider.CreateBranch(ctx, repositoryID, headBranch, branchInfo.LastCommitID); err != nil {
+			return errors.Wrapf(err, "failed to create branch %q", headBranch)
+		}
+		if err := provider.CreateCommit(ctx, repositoryID, &vcs.FileCommitCreate{
+			Branch:  headBranch,
+			Message: title,
+			Files:   files,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to commit to branch %q", headBranch)
+		}
+		pullRequestURL, err := provider.CreatePullRequest(ctx, repositoryID, &vcs.PullRequestCreate{
+			Title: title,
+			Body:  s.getSchemaWriteBackPullRequestBody(ctx, issue),
+			Head:  headBranch,
+			Base:  branch,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create pull request")
+		}
+		slog.Debug("created schema write-back pull request", slog.Int("issue_uid", issue.UID), slog.String("pull_request", pullRequestURL))
+		return nil
+	default:
+		return errors.Errorf("unsupported schema write-back mode %q", schemaWriteBack.GetMode())
+	}
+}
+
+func isSchemaChangeTask(taskType api.TaskType) bool {
+	switch taskType {
+	case api.TaskDatabaseSchemaBaseline,
+		api.TaskDatabaseSchemaUpdate,
+		api.TaskDatabaseSchemaUpdateSDL,
+		api.TaskDatabaseSchemaUpdateGhostCutover:
+		return true
+	default:
+		return false
+	}
+}
+
+// getSchemaWriteBackFiles gets the schema files of the database changed by the task.
+// For the per-object layout, the stale schema files of the dropped objects in the branch are deleted.
+func (s *SchedulerV2) getSchemaWriteBackFiles(ctx context.Context, provider vcs.Provider, repositoryID, branch string, task *store.TaskMessage, schemaWriteBack *storepb.VCSConnector_SchemaWriteBack) ([]*vcs.CommitFile, error) {
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %d", *task.DatabaseID)
+	}
+	if database == nil {
+		return nil, nil
+	}
+	filePath := renderSchemaWriteBackFilePath(schemaWriteBack.GetFilePathTemplate(), database)
+
+	if !schemaWriteBack.GetPerObject() {
+		// The schema dump after the latest migration is the canonical schema of the database.
+		done := db.Done
+		limit := 1
+		changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+			DatabaseID: &database.UID,
+			Status:     &done,
+			Limit:      &limit,
+			ShowFull:   true,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list change history for database %q", database.DatabaseName)
+		}
+		if len(changeHistories) == 0 {
+			return nil, nil
+		}
+		return []*vcs.CommitFile{{Path: filePath, Content: changeHistories[0].Schema}}, nil
+	}
+
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %d", task.InstanceID)
+	}
+	if instance == nil {
+		return nil, nil
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schema for database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return nil, nil
+	}
+	directory := path.Dir(filePath)
+	files, err := getPerObjectSchemaFiles(instance.Engine, directory, dbSchema.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	existingFiles, err := provider.ListDirectoryFile(ctx, repositoryID, directory, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branch})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list files in directory %q", directory)
+	}
+	return append(files, getStaleSchemaFiles(existingFiles, files)...), nil
+}
+
+// getStaleSchemaFiles gets the files deleting the existing SQL files which are not written back any more,
+// i.e. the schema files of the dropped objects.
+func getStaleSchemaFiles(existingFiles []string, files []*vcs.CommitFile) []*vcs.CommitFile {
+	written := make(map[string]bool)
+	for _, file := range files {
+		written[file.Path] = true
+	}
+	var staleFiles []*vcs.CommitFile
+	for _, existingFile := range existingFiles {
+		if written[existingFile] || !strings.HasSuffix(existingFile, ".sql") {
+			continue
+		}
+		staleFiles = append(staleFiles, &vcs.CommitFile{Path: existingFile, Delete: true})
+	}
+	return staleFiles
+}
+
+// getPerObjectSchemaFiles gets one schema file per object in the directory. The files are grouped into the
+// subdirectories by the object type, e.g. tables and views, under the subdirectory named after the schema for the engines with schemas.
+// The overloaded functions share the same file.
+func getPerObjectSchemaFiles(engine storepb.Engine, directory string, metadata *storepb.DatabaseSchemaMetadata) ([]*vcs.CommitFile, error) {
+	var files []*vcs.CommitFile
+	for _, schemaMetadata := range metadata.GetSchemas() {
+		schemaDirectory := path.Join(directory, schemaMetadata.GetName())
+		getFilePath := func(objectType, name string) string {
+			return path.Join(schemaDirectory, objectType, fmt.Sprintf("%s.sql", name))
+		}
+		for _, table := range schemaMetadata.GetTables() {
+			tableMetadata := &storepb.DatabaseSchemaMetadata{
+				Name: metadata.GetName(),
+				Schemas: []*storepb.SchemaMetadata{
+					{
+						Name:   schemaMetadata.GetName(),
+						Tables: []*storepb.TableMetadata{proto.Clone(table).(*storepb.TableMetadata)},
+					},
+				},
+			}
+			content, err := schema.GetDesignSchema(engine, "" /* defaultSchema */, "" /* baseline */, tableMetadata)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get schema of table %q", table.GetName())
+			}
+			files = append(files, &vcs.CommitFile{Path: getFilePath("tables", table.GetName()), Content: content})
+		}
+		for _, view := range schemaMetadata.GetViews() {
+			name := getQualifiedObjectName(engine, schemaMetadata.GetName(), view.GetName())
+			content := fmt.Sprintf("CREATE VIEW %s AS\n%s;\n", name, trimStatement(view.GetDefinition()))
+			files = append(files, &vcs.CommitFile{Path: getFilePath("views", view.GetName()), Content: content})
+		}
+		for _, view := range schemaMetadata.GetMaterializedViews() {
+			name := getQualifiedObjectName(engine, schemaMetadata.GetName(), view.GetName())
+			content := fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS\n%s;\n", name, trimStatement(view.GetDefinition()))
+			files = append(files, &vcs.CommitFile{Path: getFilePath("materialized_views", view.GetName()), Content: content})
+		}
+		functionFiles := make(map[string]*vcs.CommitFile)
+		for _, function := range schemaMetadata.GetFunctions() {
+			content := fmt.Sprintf("%s;\n", trimStatement(function.GetDefinition()))
+			if file, ok := functionFiles[function.GetName()]; ok {
+				file.Content = fmt.Sprintf("%s\n%s", file.Content, content)
+				continue
+			}
+			file := &vcs.CommitFile{Path: getFilePath("functions", function.GetName()), Content: content}
+			functionFiles[function.GetName()] = file
+			files = append(files, file)
+		}
+		for _, procedure := range schemaMetadata.GetProcedures() {
+			content := fmt.Sprintf("%s;\n", trimStatement(procedure.GetDefinition()))
+			files = append(files, &vcs.CommitFile{Path: getFilePath("procedures", procedure.GetName()), Content: content})
+		}
+		for _, sequence := range schemaMetadata.GetSequences() {
+			content := fmt.Sprintf("CREATE SEQUENCE %s;\n", getQualifiedObjectName(engine, schemaMetadata.GetName(), sequence.GetName()))
+			if sequence.GetDataType() != "" {
+				content = fmt.Sprintf("CREATE SEQUENCE %s AS %s;\n", getQualifiedObjectName(engine, schemaMetadata.GetName(), sequence.GetName()), sequence.GetDataType())
+			}
+			files = append(files, &vcs.CommitFile{Path: getFilePath("sequences", sequence.GetName()), Content: content})
+		}
+	}
+	return files, nil
+}
+
+// trimStatement trims the spaces and the trailing semicolon of the object definition.
+func trimStatement(statement string) string {
+	return strings.TrimSuffix(strings.TrimSpace(statement), ";")
+}
+
+func getQualifiedObjectName(engine storepb.Engine, schemaName, name string) string {
+	quote := func(identifier string) string {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(name, "`", "``"))
+	default:
+		if schemaName == "" {
+			return quote(name)
+		}
+		return fmt.Sprintf("%s.%s", quote(schemaName), quote(name))
+	}
+}
+
+func renderSchemaWriteBackFilePath(filePathTemplate string, database *store.DatabaseMessage) string {
+	return strings.NewReplacer(
+		"{{ENV_ID}}", database.EffectiveEnvironmentID,
+		"{{DB_NAME}}", database.DatabaseName,
+	).Replace(filePathTemplate)
+}
+
+// filterUnchangedFiles filters out the files whose content in the branch is the same.
+func filterUnchangedFiles(ctx context.Context, provider vcs.Provider, repositoryID, branch string, files []*vcs.CommitFile) ([]*vcs.CommitFile, error) {
+	var changed []*vcs.CommitFile
+	for _, file := range files {
+		if file.Delete {
+			changed = append(changed, file)
+			continue
+		}
+		content, err := provider.ReadFileContent(ctx, repositoryID, file.Path, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branch})
+		if err != nil {
+			if common.ErrorCode(err) != common.NotFound {
+				return nil, errors.Wrapf(err, "failed to read file %q", file.Path)
+			}
+		} else if content == file.Content {
+			continue
+		}
+		changed = append(changed, file)
+	}
+	return changed, nil
+}
+
+func (s *SchedulerV2) getSchemaWriteBackPullRequestBody(ctx context.Context, issue *store.IssueMessage) string {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil || setting.ExternalUrl == "" {
+		return fmt.Sprintf("Update the schema files after the rollout of %q.", issue.Title)
+	}
+	return fmt.Sprintf("Update the schema files after the rollout of %s/%s.", setting.ExternalUrl, common.FormatIssue(issue.Project.ResourceID, issue.UID))
+}
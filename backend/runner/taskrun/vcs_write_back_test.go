@@ -0,0 +1,94 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+
+	// Register the PostgreSQL design schema generator.
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
+)
+
+func TestRenderSchemaWriteBackFilePath(t *testing.T) {
+	a := require.New(t)
+	database := &store.DatabaseMessage{
+		DatabaseName:           "employee",
+		EffectiveEnvironmentID: "prod",
+	}
+	a.Equal("schema/prod/employee/LATEST.sql", renderSchemaWriteBackFilePath("schema/{{ENV_ID}}/{{DB_NAME}}/LATEST.sql", database))
+	a.Equal("employee.sql", renderSchemaWriteBackFilePath("{{DB_NAME}}.sql", database))
+}
+
+func TestGetPerObjectSchemaFiles(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "employee",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+						},
+					},
+					{
+						Name: "t2",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "name", Type: "text", Nullable: true},
+						},
+					},
+				},
+				Views: []*storepb.ViewMetadata{
+					{Name: "v1", Definition: " SELECT t1.id\n   FROM t1;"},
+				},
+				Functions: []*storepb.FunctionMetadata{
+					{Name: "f1", Definition: "CREATE OR REPLACE FUNCTION public.f1()\n RETURNS integer\n LANGUAGE sql\nAS $function$SELECT 1$function$\n"},
+					{Name: "f1", Definition: "CREATE OR REPLACE FUNCTION public.f1(a integer)\n RETURNS integer\n LANGUAGE sql\nAS $function$SELECT a$function$\n"},
+				},
+				Sequences: []*storepb.SequenceMetadata{
+					{Name: "s1", DataType: "bigint"},
+				},
+			},
+		},
+	}
+
+	files, err := getPerObjectSchemaFiles(storepb.Engine_POSTGRES, "schema/employee", metadata)
+	a.NoError(err)
+	a.Len(files, 5)
+	a.Equal("schema/employee/public/tables/t1.sql", files[0].Path)
+	a.Contains(files[0].Content, "t1")
+	a.NotContains(files[0].Content, "t2")
+	a.Equal("schema/employee/public/tables/t2.sql", files[1].Path)
+	a.Contains(files[1].Content, "t2")
+	a.NotContains(files[1].Content, "t1")
+	a.Equal("schema/employee/public/views/v1.sql", files[2].Path)
+	a.Equal("CREATE VIEW \"public\".\"v1\" AS\nSELECT t1.id\n   FROM t1;\n", files[2].Content)
+	// The overloaded functions share the same file.
+	a.Equal("schema/employee/public/functions/f1.sql", files[3].Path)
+	a.Contains(files[3].Content, "public.f1()")
+	a.Contains(files[3].Content, "public.f1(a integer)")
+	a.Equal("schema/employee/public/sequences/s1.sql", files[4].Path)
+	a.Equal("CREATE SEQUENCE \"public\".\"s1\" AS bigint;\n", files[4].Content)
+}
+
+func TestGetStaleSchemaFiles(t *testing.T) {
+	a := require.New(t)
+	files := []*vcs.CommitFile{
+		{Path: "schema/employee/public/tables/t1.sql"},
+		{Path: "schema/employee/public/views/v1.sql"},
+	}
+	existingFiles := []string{
+		"schema/employee/public/tables/t1.sql",
+		"schema/employee/public/tables/t2.sql",
+		"schema/employee/public/views/v1.sql",
+		"schema/employee/README.md",
+	}
+	// The schema file of the dropped table t2 is deleted, and the files other than SQL are kept.
+	a.Equal([]*vcs.CommitFile{{Path: "schema/employee/public/tables/t2.sql", Delete: true}}, getStaleSchemaFiles(existingFiles, files))
+}
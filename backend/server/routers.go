@@ -20,9 +20,10 @@ import (
 	"github.com/bytebase/bytebase/backend/api/lsp"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/runner/relay"
 )
 
-func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.Server, gitOpsServer *gitops.Service, mux *grpcruntime.ServeMux, profile config.Profile) {
+func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.Server, gitOpsServer *gitops.Service, relayRunner *relay.Runner, mux *grpcruntime.ServeMux, profile config.Profile) {
 	// Embed frontend.
 	embedFrontend(e)
 
@@ -107,6 +108,11 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.
 	// GitOps Webhook server.
 	webhookGroup := e.Group(webhookAPIPrefix)
 	gitOpsServer.RegisterWebhookRoutes(webhookGroup)
+	// External approval webhook server.
+	// The relay runner is not started in the readonly mode.
+	if relayRunner != nil {
+		relayRunner.RegisterWebhookRoutes(webhookGroup)
+	}
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
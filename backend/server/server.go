@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"github.com/soheilhy/cmux"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
@@ -30,8 +32,10 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/metrics"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/tracing"
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/demo"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
@@ -44,10 +48,12 @@ import (
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/grantexpiration"
 	"github.com/bytebase/bytebase/backend/runner/mail"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
 	"github.com/bytebase/bytebase/backend/runner/relay"
+	"github.com/bytebase/bytebase/backend/runner/retention"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/runner/slowquerysync"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
@@ -66,15 +72,17 @@ const (
 // Server is the Bytebase server.
 type Server struct {
 	// Asynchronous runners.
-	taskSchedulerV2    *taskrun.SchedulerV2
-	planCheckScheduler *plancheck.Scheduler
-	metricReporter     *metricreport.Reporter
-	schemaSyncer       *schemasync.Syncer
-	slowQuerySyncer    *slowquerysync.Syncer
-	mailSender         *mail.SlowQueryWeeklyMailSender
-	approvalRunner     *approval.Runner
-	relayRunner        *relay.Runner
-	runnerWG           sync.WaitGroup
+	taskSchedulerV2       *taskrun.SchedulerV2
+	planCheckScheduler    *plancheck.Scheduler
+	metricReporter        *metricreport.Reporter
+	schemaSyncer          *schemasync.Syncer
+	slowQuerySyncer       *slowquerysync.Syncer
+	mailSender            *mail.SlowQueryWeeklyMailSender
+	approvalRunner        *approval.Runner
+	grantExpirationRunner *grantexpiration.Runner
+	relayRunner           *relay.Runner
+	retentionRunner       *retention.Runner
+	runnerWG              sync.WaitGroup
 
 	webhookManager *webhook.Manager
 	iamManager     *iam.Manager
@@ -85,6 +93,7 @@ type Server struct {
 	echoServer      *echo.Echo
 	grpcServer      *grpc.Server
 	muxServer       cmux.CMux
+	metricsServer   *http.Server
 	lspServer       *lsp.Server
 	store           *store.Store
 	sheetManager    *sheet.Manager
@@ -106,6 +115,8 @@ type Server struct {
 	pgBinDir string
 	// PG server stoppers.
 	stopper []func()
+	// shutdownTracing flushes and shuts down the tracing exporter.
+	shutdownTracing func(context.Context) error
 
 	s3Client *bbs3.Client
 
@@ -129,6 +140,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	slog.Info(fmt.Sprintf("mode=%s", profile.Mode))
 	slog.Info(fmt.Sprintf("dataDir=%s", profile.DataDir))
 	slog.Info(fmt.Sprintf("resourceDir=%s", profile.ResourceDir))
+	slog.Info(fmt.Sprintf("metricsPort=%d", profile.MetricsPort))
+	slog.Info(fmt.Sprintf("otlpEndpoint=%s", profile.OTLPEndpoint))
 	slog.Info(fmt.Sprintf("readonly=%t", profile.Readonly))
 	slog.Info(fmt.Sprintf("demoName=%s", profile.DemoName))
 	slog.Info(fmt.Sprintf("backupStorageBackend=%s", profile.BackupStorageBackend))
@@ -145,6 +158,10 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	}()
 
 	var err error
+	s.shutdownTracing, err = tracing.Init(ctx, profile.OTLPEndpoint, profile.Version)
+	if err != nil {
+		return nil, err
+	}
 	if err = os.MkdirAll(profile.ResourceDir, os.ModePerm); err != nil {
 		return nil, errors.Wrapf(err, "failed to create directory: %q", profile.ResourceDir)
 	}
@@ -267,13 +284,20 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile, false)
 	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService)
+	s.schemaSyncer.RegisterInstanceDetector(api.AnomalyInstanceReplicationLag, schemasync.NewReplicationLagDetector(s.dbFactory))
+	s.schemaSyncer.RegisterDatabaseDetector(api.AnomalyDatabaseTableWithoutPrimaryKey, schemasync.NewTableWithoutPrimaryKeyDetector())
+	s.schemaSyncer.RegisterDatabaseDetector(api.AnomalyDatabaseUnusedIndex, schemasync.NewUnusedIndexDetector())
+	s.schemaSyncer.RegisterDatabaseDetector(api.AnomalyDatabaseDuplicateIndex, schemasync.NewDuplicateIndexDetector())
+	s.schemaSyncer.RegisterDatabaseDetector(api.AnomalyDatabaseAutoIncrementExhaustion, schemasync.NewAutoIncrementExhaustionDetector())
 	if !profile.Readonly {
 		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
 		s.mailSender = mail.NewSender(s.store, s.stateCfg)
 		s.relayRunner = relay.NewRunner(storeInstance, s.webhookManager, s.stateCfg)
+		s.retentionRunner = retention.NewRunner(storeInstance)
+		s.grantExpirationRunner = grantexpiration.NewRunner(storeInstance, s.webhookManager)
 		s.approvalRunner = approval.NewRunner(storeInstance, s.sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.relayRunner, s.licenseService)
 
-		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.webhookManager)
+		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.webhookManager, s.dbFactory)
 		s.taskSchedulerV2.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
 		s.taskSchedulerV2.Register(api.TaskDatabaseCreate, taskrun.NewDatabaseCreateExecutor(storeInstance, s.dbFactory, s.schemaSyncer, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaBaseline, taskrun.NewSchemaBaselineExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
@@ -284,7 +308,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 
-		s.planCheckScheduler = plancheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg)
+		s.planCheckScheduler = plancheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg, s.dbFactory)
 		databaseConnectExecutor := plancheck.NewDatabaseConnectExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseConnect, databaseConnectExecutor)
 		statementAdviseExecutor := plancheck.NewStatementAdviseExecutor(storeInstance, s.sheetManager, s.dbFactory, s.licenseService)
@@ -314,6 +338,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	recoveryStreamInterceptor := recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(onPanic))
 	grpc.EnableTracing = true
 	s.grpcServer = grpc.NewServer(
+		// Trace the gRPC calls and propagate the span to the runners and database drivers.
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		// Override the maximum receiving message size to 100M for uploading large sheets.
 		grpc.MaxRecvMsgSize(100*1024*1024),
 		grpc.InitialWindowSize(100000000),
@@ -365,7 +391,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	gitOpsServer := gitops.NewService(s.store, s.dbFactory, s.stateCfg, s.licenseService, planService, rolloutService, issueService, s.sheetManager)
 
 	// Configure echo server routes.
-	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, mux, profile)
+	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, s.relayRunner, mux, profile)
 
 	serverStarted = true
 	return s, nil
@@ -375,8 +401,11 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 func (s *Server) Run(ctx context.Context, port int) error {
 	ctx, cancel := context.WithCancel(ctx)
 	s.cancel = cancel
+	// runnerWG waits for all goroutines to complete.
+	// The database driver pool serves the readonly server as well.
+	s.runnerWG.Add(1)
+	go s.dbFactory.Run(ctx, &s.runnerWG)
 	if !s.profile.Readonly {
-		// runnerWG waits for all goroutines to complete.
 		s.runnerWG.Add(1)
 		go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
@@ -389,6 +418,10 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		go s.approvalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.relayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.retentionRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.grantExpirationRunner.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.metricReporter.Run(ctx, &s.runnerWG)
@@ -424,6 +457,21 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		}
 	}()
 
+	if s.profile.MetricsPort != 0 {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		s.metricsServer = &http.Server{
+			Addr:              fmt.Sprintf(":%d", s.profile.MetricsPort),
+			Handler:           metricsMux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := s.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("metrics server listen error", log.BBError(err))
+			}
+		}()
+	}
+
 	return nil
 }
 
@@ -469,6 +517,16 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	if s.muxServer != nil {
 		s.muxServer.Close()
 	}
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			slog.Error("failed to shutdown metrics server", log.BBError(err))
+		}
+	}
+	if s.shutdownTracing != nil {
+		if err := s.shutdownTracing(ctx); err != nil {
+			slog.Error("failed to shutdown tracing", log.BBError(err))
+		}
+	}
 
 	// Wait for all runners to exit.
 	s.runnerWG.Wait()
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// DatabaseSizeHistoryMessage is the message for a database size sample.
+type DatabaseSizeHistoryMessage struct {
+	DatabaseUID int
+	Payload     *storepb.DatabaseSizeSnapshot
+
+	// Output only fields.
+	ID        int64
+	CreatedTs int64
+}
+
+// FindDatabaseSizeHistoryMessage is the message for finding database size samples.
+type FindDatabaseSizeHistoryMessage struct {
+	DatabaseUID int
+	// StartTs is the inclusive lower bound of the created timestamp.
+	StartTs *int64
+}
+
+// CreateDatabaseSizeHistory creates a database size sample.
+func (s *Store) CreateDatabaseSizeHistory(ctx context.Context, create *DatabaseSizeHistoryMessage) error {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal payload")
+	}
+	query := `
+		INSERT INTO database_size_history (database_id, payload) VALUES ($1, $2)
+	`
+	if _, err := s.db.db.ExecContext(ctx, query, create.DatabaseUID, payload); err != nil {
+		return errors.Wrapf(err, "failed to create database size history")
+	}
+	return nil
+}
+
+// ListDatabaseSizeHistory lists the database size samples ordered by the created timestamp ascendingly.
+func (s *Store) ListDatabaseSizeHistory(ctx context.Context, find *FindDatabaseSizeHistoryMessage) ([]*DatabaseSizeHistoryMessage, error) {
+	query := `
+		SELECT
+			id,
+			created_ts,
+			database_id,
+			payload
+		FROM database_size_history
+		WHERE database_id = $1 AND created_ts >= $2
+		ORDER BY created_ts ASC
+	`
+	var startTs int64
+	if v := find.StartTs; v != nil {
+		startTs = *v
+	}
+	rows, err := s.db.db.QueryContext(ctx, query, find.DatabaseUID, startTs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query database size history")
+	}
+	defer rows.Close()
+
+	var histories []*DatabaseSizeHistoryMessage
+	for rows.Next() {
+		history := &DatabaseSizeHistoryMessage{
+			Payload: &storepb.DatabaseSizeSnapshot{},
+		}
+		var payload []byte
+		if err := rows.Scan(
+			&history.ID,
+			&history.CreatedTs,
+			&history.DatabaseUID,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan")
+		}
+		if err := protojsonUnmarshaler.Unmarshal(payload, history.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		histories = append(histories, history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to query")
+	}
+	return histories, nil
+}
+
+// PruneDatabaseSizeHistoryMessage is the message for pruning the database size samples of a database.
+type PruneDatabaseSizeHistoryMessage struct {
+	DatabaseUID int
+	// RollupBeforeTs is the exclusive upper bound of the created timestamp of the samples to roll up,
+	// only the last sample of each day is kept for them.
+	RollupBeforeTs int64
+	// DeleteBeforeTs is the exclusive upper bound of the created timestamp of the samples to delete.
+	DeleteBeforeTs int64
+}
+
+// PruneDatabaseSizeHistory rolls up the old database size samples to daily ones and deletes the expired samples.
+func (s *Store) PruneDatabaseSizeHistory(ctx context.Context, prune *PruneDatabaseSizeHistoryMessage) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM database_size_history
+		WHERE database_id = $1 AND created_ts < $2
+	`, prune.DatabaseUID, prune.DeleteBeforeTs); err != nil {
+		return errors.Wrapf(err, "failed to delete expired database size history")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM database_size_history
+		WHERE database_id = $1 AND created_ts < $2 AND id NOT IN (
+			SELECT DISTINCT ON (created_ts / 86400) id
+			FROM database_size_history
+			WHERE database_id = $1 AND created_ts < $2
+			ORDER BY created_ts / 86400, created_ts DESC
+		)
+	`, prune.DatabaseUID, prune.RollupBeforeTs); err != nil {
+		return errors.Wrapf(err, "failed to roll up database size history")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	return nil
+}
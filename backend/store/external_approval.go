@@ -48,6 +48,8 @@ type ListExternalApprovalMessage struct {
 	// IssueUID is the unique identifier of the issue.
 	IssueUID *int
 	Type     *api.ExternalApprovalType
+	// ShowArchived also lists the archived external approvals, e.g. the approved or rejected ones.
+	ShowArchived bool
 }
 
 // CreateExternalApprovalV2 creates an ExternalApproval.
@@ -90,7 +92,7 @@ func (s *Store) CreateExternalApprovalV2(ctx context.Context, create *ExternalAp
 	return &externalApproval, nil
 }
 
-// ListExternalApprovalV2 finds a list of ExternalApproval by find and whose RowStatus == NORMAL.
+// ListExternalApprovalV2 finds a list of ExternalApproval by find and whose RowStatus == NORMAL unless ShowArchived is set.
 func (s *Store) ListExternalApprovalV2(ctx context.Context, find *ListExternalApprovalMessage) ([]*ExternalApprovalMessage, error) {
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
@@ -171,7 +173,9 @@ func (s *Store) UpdateExternalApprovalV2(ctx context.Context, update *UpdateExte
 
 func (*Store) findExternalApprovalImplV2(ctx context.Context, tx *Tx, find *ListExternalApprovalMessage) ([]*ExternalApprovalMessage, error) {
 	where, args := []string{"TRUE"}, []any{}
-	where, args = append(where, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, api.Normal)
+	if !find.ShowArchived {
+		where, args = append(where, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, api.Normal)
+	}
 	if v := find.IssueUID; v != nil {
 		where, args = append(where, fmt.Sprintf("issue_id = $%d", len(args)+1)), append(args, *v)
 	}
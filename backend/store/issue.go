@@ -117,6 +117,8 @@ type FindIssueMessage struct {
 	LabelList []string
 
 	NoPipeline bool
+	// HasApprovalStepTimeout filters the issues whose approval flow has any step with a timeout.
+	HasApprovalStepTimeout bool
 }
 
 // GetIssueV2 gets issue by issue UID.
@@ -474,6 +476,9 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 	if find.NoPipeline {
 		where = append(where, "issue.pipeline_id IS NULL")
 	}
+	if find.HasApprovalStepTimeout {
+		where = append(where, "jsonb_path_exists(issue.payload, '$.approval.approvalTemplates[*].flow.steps[*].timeout')")
+	}
 
 	var issues []*IssueMessage
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
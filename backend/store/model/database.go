@@ -233,10 +233,11 @@ func (d *DatabaseConfig) BuildDatabaseConfig() *storepb.DatabaseConfig {
 
 			for colName, colConfig := range tConfig.internal {
 				tableConfig.ColumnConfigs = append(tableConfig.ColumnConfigs, &storepb.ColumnConfig{
-					Name:             colName,
-					SemanticTypeId:   colConfig.SemanticTypeId,
-					Labels:           colConfig.Labels,
-					ClassificationId: colConfig.ClassificationId,
+					Name:                     colName,
+					SemanticTypeId:           colConfig.SemanticTypeId,
+					Labels:                   colConfig.Labels,
+					ClassificationId:         colConfig.ClassificationId,
+					ClassificationSuggestion: colConfig.ClassificationSuggestion,
 				})
 			}
 			schemaConfig.TableConfigs = append(schemaConfig.TableConfigs, tableConfig)
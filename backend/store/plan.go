@@ -299,3 +299,25 @@ func (s *Store) UpdatePlan(ctx context.Context, patch *UpdatePlanMessage) error
 
 	return nil
 }
+
+// DeletePlan deletes the plan and its plan check runs.
+// It is used to clean up a plan that is never attached to an issue.
+func (s *Store) DeletePlan(ctx context.Context, uid int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM plan_check_run WHERE plan_id = $1`, uid); err != nil {
+		return errors.Wrapf(err, "failed to delete plan check runs")
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM plan WHERE id = $1`, uid); err != nil {
+		return errors.Wrapf(err, "failed to delete plan")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	return nil
+}
@@ -38,6 +38,63 @@ func (s *Store) GetProjectIamPolicy(ctx context.Context, projectUID int) (*store
 	return p, nil
 }
 
+// UpdateProjectIamPolicy reads and updates the project IAM policy in one transaction with the policy row locked,
+// so that the changes made by others between the read and the write are never overwritten.
+// The update function changes the policy in place and returns whether the policy is changed.
+func (s *Store) UpdateProjectIamPolicy(ctx context.Context, projectUID int, update func(*storepb.ProjectIamPolicy) bool, updaterID int) error {
+	resourceType := api.PolicyResourceTypeProject
+	pType := api.PolicyTypeProjectIAM
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	p := &storepb.ProjectIamPolicy{}
+	var payload string
+	if err := tx.QueryRowContext(ctx, `
+		SELECT payload
+		FROM policy
+		WHERE resource_type = $1 AND resource_id = $2 AND type = $3
+		FOR UPDATE
+	`, resourceType, projectUID, pType).Scan(&payload); err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+	} else if err := protojson.Unmarshal([]byte(payload), p); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal iam policy")
+	}
+
+	if !update(p) {
+		return nil
+	}
+	policyPayload, err := protojson.Marshal(p)
+	if err != nil {
+		return err
+	}
+	policy, err := upsertPolicyV2Impl(ctx, tx, &PolicyMessage{
+		ResourceUID:       projectUID,
+		ResourceType:      resourceType,
+		Payload:           string(policyPayload),
+		Type:              pType,
+		InheritFromParent: false,
+		// Enforce cannot be false while creating a policy.
+		Enforce: true,
+	}, updaterID)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.policyCache.Add(getPolicyCacheKey(policy.ResourceType, policy.ResourceUID, policy.Type), policy)
+
+	return nil
+}
+
 func (s *Store) GetRolloutPolicy(ctx context.Context, environmentID int) (*storepb.RolloutPolicy, error) {
 	resourceType := api.PolicyResourceTypeEnvironment
 	pType := api.PolicyTypeRollout
@@ -181,6 +238,32 @@ func (s *Store) GetMaskingExceptionPolicyByProjectUID(ctx context.Context, proje
 	return p, nil
 }
 
+// GetQueryGuardPolicy gets the query guard policy for an environment.
+// An empty policy is returned if the policy is not set or not enforced.
+func (s *Store) GetQueryGuardPolicy(ctx context.Context, environmentID int) (*storepb.QueryGuardPolicy, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	pType := api.PolicyTypeQueryGuard
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil || !policy.Enforce {
+		return &storepb.QueryGuardPolicy{}, nil
+	}
+
+	p := new(storepb.QueryGuardPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal query guard policy")
+	}
+
+	return p, nil
+}
+
 // PolicyMessage is the mssage for policy.
 type PolicyMessage struct {
 	ResourceUID       int
@@ -31,6 +31,8 @@ type FindUserMessage struct {
 	ShowDeleted bool
 	Type        *api.PrincipalType
 	Limit       *int
+	// ApprovalDelegateID filters the users who delegate their approvals to the user, regardless of the delegation period.
+	ApprovalDelegateID *int
 }
 
 // UpdateUserMessage is the message to update a user.
@@ -41,6 +43,7 @@ type UpdateUserMessage struct {
 	Roles        *[]api.Role
 	Delete       *bool
 	MFAConfig    *storepb.MFAConfig
+	Profile      *storepb.UserProfile
 	Phone        *string
 }
 
@@ -58,7 +61,8 @@ type UserMessage struct {
 	MemberDeleted bool
 	MFAConfig     *storepb.MFAConfig
 	// Phone conforms E.164 format.
-	Phone string
+	Phone   string
+	Profile *storepb.UserProfile
 }
 
 // GetUserByID gets the user by ID.
@@ -169,6 +173,9 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 	if v := find.Role; v != nil {
 		where, args = append(where, fmt.Sprintf("$%d = ANY(member_roles.roles)", len(args)+1)), append(args, *v)
 	}
+	if v := find.ApprovalDelegateID; v != nil {
+		where, args = append(where, fmt.Sprintf("(principal.profile->'approvalDelegation'->>'delegateId')::INTEGER = $%d", len(args)+1)), append(args, *v)
+	}
 	if !find.ShowDeleted {
 		where, args = append(where, fmt.Sprintf("principal.row_status = $%d", len(args)+1)), append(args, api.Normal)
 	}
@@ -183,6 +190,7 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 		principal.password_hash,
 		principal.mfa_config,
 		principal.phone,
+		principal.profile,
 		member_roles.roles
 	FROM principal
 	LEFT JOIN LATERAL (SELECT ARRAY(SELECT member.role FROM member WHERE member.principal_id = principal.id ORDER BY member.role)) AS member_roles(roles) ON TRUE
@@ -202,7 +210,7 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 		var userMessage UserMessage
 		var roles pgtype.TextArray
 		var rowStatus string
-		var mfaConfigBytes []byte
+		var mfaConfigBytes, profileBytes []byte
 		if err := rows.Scan(
 			&userMessage.ID,
 			&rowStatus,
@@ -212,6 +220,7 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 			&userMessage.PasswordHash,
 			&mfaConfigBytes,
 			&userMessage.Phone,
+			&profileBytes,
 			&roles,
 		); err != nil {
 			return nil, err
@@ -238,6 +247,11 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 			return nil, err
 		}
 		userMessage.MFAConfig = &mfaConfig
+		profile := storepb.UserProfile{}
+		if err := decoder.Unmarshal(profileBytes, &profile); err != nil {
+			return nil, err
+		}
+		userMessage.Profile = &profile
 		userMessages = append(userMessages, &userMessage)
 	}
 	if err := rows.Err(); err != nil {
@@ -362,6 +376,13 @@ func (s *Store) UpdateUser(ctx context.Context, currentUser *UserMessage, patch
 		}
 		principalSet, principalArgs = append(principalSet, fmt.Sprintf("mfa_config = $%d", len(principalArgs)+1)), append(principalArgs, mfaConfigBytes)
 	}
+	if v := patch.Profile; v != nil {
+		profileBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		principalSet, principalArgs = append(principalSet, fmt.Sprintf("profile = $%d", len(principalArgs)+1)), append(principalArgs, profileBytes)
+	}
 	principalArgs = append(principalArgs, currentUser.ID)
 
 	tx, err := s.db.BeginTx(ctx, nil)
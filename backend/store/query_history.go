@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -52,11 +54,62 @@ type FindQueryHistoryMessage struct {
 	// Database is database resource name like instances/{instance}/databases/{database}.
 	Database *string
 	Type     *QueryHistoryType
+	// ProjectIDs is the project resource ids that the query histories belong to.
+	ProjectIDs *[]string
+	// Statement is the full-text search on the statement.
+	Statement *string
+	// Schema, Table and Column are the accessed resources of the query histories.
+	Schema          *string
+	Table           *string
+	Column          *string
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
 
 	Limit  *int
 	Offset *int
+	// OrderByKeys supports created_ts and duration, the default is created_ts DESC.
+	OrderByKeys []OrderByKey
 }
 
+// QueryHistoryGroupBy is the group by of the query history aggregation.
+type QueryHistoryGroupBy string
+
+const (
+	// QueryHistoryGroupByUser groups the query histories by the creator.
+	QueryHistoryGroupByUser QueryHistoryGroupBy = "USER"
+	// QueryHistoryGroupByDatabase groups the query histories by the database.
+	QueryHistoryGroupByDatabase QueryHistoryGroupBy = "DATABASE"
+	// QueryHistoryGroupByInstance groups the query histories by the instance.
+	QueryHistoryGroupByInstance QueryHistoryGroupBy = "INSTANCE"
+	// QueryHistoryGroupByTable groups the query histories by the accessed table.
+	QueryHistoryGroupByTable QueryHistoryGroupBy = "TABLE"
+)
+
+// QueryHistoryAggregationMessage is the aggregation of the query histories in a group.
+type QueryHistoryAggregationMessage struct {
+	// CreatorUID is set for the USER group.
+	CreatorUID int
+	// Instance is the instance resource name, set for the INSTANCE group.
+	Instance string
+	// Database is the database resource name, set for the DATABASE and TABLE groups.
+	Database string
+	// Schema and Table are set for the TABLE group.
+	Schema string
+	Table  string
+
+	Count      int64
+	ErrorCount int64
+	// The durations are in seconds.
+	P50Duration float64
+	P90Duration float64
+	P99Duration float64
+	MaxDuration float64
+	LastQueryTs int64
+}
+
+// queryHistoryDuration is the duration of the query history in seconds, protojson encodes the duration as the string like "1.5s".
+const queryHistoryDuration = `COALESCE(RTRIM(query_history.payload->>'duration', 's')::NUMERIC, 0)`
+
 // CreateQueryHistory creates the query history.
 func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMessage) (*QueryHistoryMessage, error) {
 	payload, err := protojson.Marshal(create.Payload)
@@ -105,8 +158,7 @@ func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMess
 	return create, nil
 }
 
-// ListQueryHistories lists the query history.
-func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMessage) ([]*QueryHistoryMessage, error) {
+func getQueryHistoryWhere(find *FindQueryHistoryMessage) ([]string, []any, error) {
 	where, args := []string{"TRUE"}, []any{}
 
 	if v := find.CreatorUID; v != nil {
@@ -115,6 +167,9 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 	if v := find.ProjectID; v != nil {
 		where, args = append(where, fmt.Sprintf("query_history.project_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.ProjectIDs; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.project_id = ANY($%d)", len(args)+1)), append(args, *v)
+	}
 	if v := find.Instance; v != nil {
 		where, args = append(where, fmt.Sprintf("query_history.database LIKE $%d", len(args)+1)), append(args, *v)
 	} else if v := find.Database; v != nil {
@@ -123,6 +178,57 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 	if v := find.Type; v != nil {
 		where, args = append(where, fmt.Sprintf("query_history.type = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.Statement; v != nil {
+		// The expression must match the full-text index idx_query_history_statement_fts.
+		where, args = append(where, fmt.Sprintf("to_tsvector('simple', query_history.statement) @@ websearch_to_tsquery('simple', $%d)", len(args)+1)), append(args, *v)
+	}
+	if find.Schema != nil || find.Table != nil || find.Column != nil {
+		// A single accessed column must match all the schema, table and column conditions.
+		accessedColumn := map[string]string{}
+		if v := find.Schema; v != nil {
+			accessedColumn["schema"] = *v
+		}
+		if v := find.Table; v != nil {
+			accessedColumn["table"] = *v
+		}
+		if v := find.Column; v != nil {
+			accessedColumn["column"] = *v
+		}
+		contained, err := json.Marshal([]map[string]string{accessedColumn})
+		if err != nil {
+			return nil, nil, err
+		}
+		where, args = append(where, fmt.Sprintf("query_history.payload->'accessedColumns' @> $%d::JSONB", len(args)+1)), append(args, string(contained))
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.created_ts >= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.created_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	return where, args, nil
+}
+
+// ListQueryHistories lists the query history.
+func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMessage) ([]*QueryHistoryMessage, error) {
+	where, args, err := getQueryHistoryWhere(find)
+	if err != nil {
+		return nil, err
+	}
+	orderBy := []string{}
+	for _, v := range find.OrderByKeys {
+		switch v.Key {
+		case "created_ts":
+			orderBy = append(orderBy, fmt.Sprintf("query_history.created_ts %s", v.SortOrder.String()))
+		case "duration":
+			orderBy = append(orderBy, fmt.Sprintf("%s %s", queryHistoryDuration, v.SortOrder.String()))
+		default:
+			return nil, errors.Errorf("invalid order by key %q", v.Key)
+		}
+	}
+	if len(orderBy) == 0 {
+		orderBy = append(orderBy, "query_history.created_ts DESC")
+	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -142,8 +248,8 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 			query_history.payload
 		FROM query_history
 		WHERE %s
-		ORDER BY created_ts DESC
-	`, strings.Join(where, " AND "))
+		ORDER BY %s
+	`, strings.Join(where, " AND "), strings.Join(orderBy, ", "))
 	if v := find.Limit; v != nil {
 		query += fmt.Sprintf(" LIMIT %d", *v)
 	}
@@ -193,3 +299,131 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 
 	return queryHistories, nil
 }
+
+// AggregateQueryHistories aggregates the query histories by the group.
+// The orderBy supports count, error_count, p50_duration, p90_duration, p99_duration, max_duration and last_query_ts, the default is count DESC.
+func (s *Store) AggregateQueryHistories(ctx context.Context, find *FindQueryHistoryMessage, groupBy QueryHistoryGroupBy, orderBy []OrderByKey, limit int) ([]*QueryHistoryAggregationMessage, error) {
+	where, args, err := getQueryHistoryWhere(find)
+	if err != nil {
+		return nil, err
+	}
+
+	from := "query_history"
+	var keys []string
+	switch groupBy {
+	case QueryHistoryGroupByUser:
+		keys = []string{"query_history.creator_id"}
+	case QueryHistoryGroupByDatabase:
+		keys = []string{"query_history.database"}
+	case QueryHistoryGroupByInstance:
+		keys = []string{"SPLIT_PART(query_history.database, '/', 1) || '/' || SPLIT_PART(query_history.database, '/', 2)"}
+	case QueryHistoryGroupByTable:
+		// The query history is joined with its distinct accessed tables,
+		// so that a query accessing multiple columns of a table is counted only once for the table.
+		from = `query_history
+			CROSS JOIN LATERAL (
+				SELECT DISTINCT
+					accessed_column->>'database' AS database,
+					COALESCE(accessed_column->>'schema', '') AS schema,
+					accessed_column->>'table' AS "table"
+				FROM jsonb_array_elements(COALESCE(query_history.payload->'accessedColumns', '[]'::JSONB)) AS accessed_column
+			) AS accessed_table`
+		keys = []string{"accessed_table.database", "accessed_table.schema", `accessed_table."table"`}
+	default:
+		return nil, errors.Errorf("invalid group by %q", groupBy)
+	}
+
+	orderByClauses := []string{}
+	for _, v := range orderBy {
+		switch v.Key {
+		case "count", "error_count", "p50_duration", "p90_duration", "p99_duration", "max_duration", "last_query_ts":
+			orderByClauses = append(orderByClauses, fmt.Sprintf("%s %s", v.Key, v.SortOrder.String()))
+		default:
+			return nil, errors.Errorf("invalid order by key %q", v.Key)
+		}
+	}
+	if len(orderByClauses) == 0 {
+		orderByClauses = append(orderByClauses, "count DESC")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%s,
+			COUNT(*) AS count,
+			COUNT(*) FILTER (WHERE query_history.payload ? 'error') AS error_count,
+			PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY %s) AS p50_duration,
+			PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY %s) AS p90_duration,
+			PERCENTILE_CONT(0.99) WITHIN GROUP (ORDER BY %s) AS p99_duration,
+			MAX(%s)::FLOAT AS max_duration,
+			MAX(query_history.created_ts) AS last_query_ts
+		FROM %s
+		WHERE %s
+		GROUP BY %s
+		ORDER BY %s
+		LIMIT %d`,
+		strings.Join(keys, ", "),
+		queryHistoryDuration, queryHistoryDuration, queryHistoryDuration, queryHistoryDuration,
+		from,
+		strings.Join(where, " AND "),
+		strings.Join(keys, ", "),
+		strings.Join(orderByClauses, ", "),
+		limit,
+	)
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var aggregations []*QueryHistoryAggregationMessage
+	for rows.Next() {
+		aggregation := &QueryHistoryAggregationMessage{}
+		var dest []any
+		switch groupBy {
+		case QueryHistoryGroupByUser:
+			dest = append(dest, &aggregation.CreatorUID)
+		case QueryHistoryGroupByDatabase:
+			dest = append(dest, &aggregation.Database)
+		case QueryHistoryGroupByInstance:
+			dest = append(dest, &aggregation.Instance)
+		case QueryHistoryGroupByTable:
+			dest = append(dest, &aggregation.Database, &aggregation.Schema, &aggregation.Table)
+		}
+		dest = append(dest,
+			&aggregation.Count,
+			&aggregation.ErrorCount,
+			&aggregation.P50Duration,
+			&aggregation.P90Duration,
+			&aggregation.P99Duration,
+			&aggregation.MaxDuration,
+			&aggregation.LastQueryTs,
+		)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		aggregations = append(aggregations, aggregation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return aggregations, nil
+}
+
+// DeleteQueryHistoriesBefore deletes the query histories created before the timestamp, and returns the number of the deleted query histories.
+func (s *Store) DeleteQueryHistoriesBefore(ctx context.Context, createdTs int64) (int64, error) {
+	result, err := s.db.db.ExecContext(ctx, `DELETE FROM query_history WHERE created_ts < $1`, createdTs)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
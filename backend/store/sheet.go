@@ -274,6 +274,26 @@ func (s *Store) PatchSheet(ctx context.Context, patch *PatchSheetMessage) (*Shee
 	return sheet, nil
 }
 
+// DeleteSheet deletes a sheet.
+// It is used to clean up a sheet that is never referenced by a task.
+func (s *Store) DeleteSheet(ctx context.Context, uid int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM sheet WHERE id = $1`, uid); err != nil {
+		return errors.Wrapf(err, "failed to delete sheet")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	s.sheetCache.Remove(uid)
+	return nil
+}
+
 // patchSheetImpl updates a sheet's name/statement/payload/database_id/project_id.
 func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*SheetMessage, error) {
 	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
@@ -45,9 +45,12 @@ type UpdateVCSConnectorMessage struct {
 	UID       int
 
 	// Domain specific fields
-	Branch        *string
-	BaseDirectory *string
-	DatabaseGroup *string
+	Branch                *string
+	BaseDirectory         *string
+	DatabaseGroup         *string
+	FileLayout            *storepb.VCSConnector_FileLayout
+	IncludeSubdirectories *bool
+	SchemaWriteBack       *storepb.VCSConnector_SchemaWriteBack
 }
 
 // GetVCSConnector gets a VCS connector.
@@ -205,6 +208,19 @@ func (s *Store) UpdateVCSConnector(ctx context.Context, update *UpdateVCSConnect
 	if v := update.DatabaseGroup; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('databaseGroup', $%d::TEXT)", len(args)+1)), append(args, *v)
 	}
+	if v := update.FileLayout; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('fileLayout', $%d::TEXT)", len(args)+1)), append(args, v.String())
+	}
+	if v := update.IncludeSubdirectories; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('includeSubdirectories', $%d::BOOLEAN)", len(args)+1)), append(args, *v)
+	}
+	if v := update.SchemaWriteBack; v != nil {
+		schemaWriteBack, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal schema write-back")
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('schemaWriteBack', $%d::JSONB)", len(args)+1)), append(args, string(schemaWriteBack))
+	}
 	if len(payloadSet) != 0 {
 		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
 	}
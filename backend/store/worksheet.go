@@ -40,6 +40,7 @@ type WorkSheetMessage struct {
 	Title      string
 	Statement  string
 	Visibility WorkSheetVisibility
+	Payload    *storepb.WorksheetPayload
 
 	// Output only fields
 	UID         int
@@ -84,6 +85,7 @@ type PatchWorkSheetMessage struct {
 	Statement   *string
 	Visibility  *string
 	DatabaseUID *int
+	Payload     *storepb.WorksheetPayload
 }
 
 // GetWorkSheet gets a sheet.
@@ -156,6 +158,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 			worksheet.name,
 			%s,
 			worksheet.visibility,
+			worksheet.payload,
 			OCTET_LENGTH(worksheet.statement),
 			COALESCE(worksheet_organizer.starred, FALSE)
 		FROM worksheet
@@ -171,6 +174,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 	var sheets []*WorkSheetMessage
 	for rows.Next() {
 		var sheet WorkSheetMessage
+		var payload []byte
 		if err := rows.Scan(
 			&sheet.UID,
 			&sheet.CreatorID,
@@ -182,11 +186,17 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 			&sheet.Title,
 			&sheet.Statement,
 			&sheet.Visibility,
+			&payload,
 			&sheet.Size,
 			&sheet.Starred,
 		); err != nil {
 			return nil, err
 		}
+		sheetPayload := &storepb.WorksheetPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payload, sheetPayload); err != nil {
+			return nil, err
+		}
+		sheet.Payload = sheetPayload
 
 		sheets = append(sheets, &sheet)
 	}
@@ -207,7 +217,10 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 
 // CreateWorkSheet creates a new sheet.
 func (s *Store) CreateWorkSheet(ctx context.Context, create *WorkSheetMessage) (*WorkSheetMessage, error) {
-	payload, err := protojson.Marshal(&storepb.SheetPayload{})
+	if create.Payload == nil {
+		create.Payload = &storepb.WorksheetPayload{}
+	}
+	payload, err := protojson.Marshal(create.Payload)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +311,7 @@ func (s *Store) DeleteWorkSheet(ctx context.Context, sheetUID int) error {
 	return tx.Commit()
 }
 
-// patchWorkSheetImpl updates a sheet's name/statement/visibility/database_id/project_id.
+// patchWorkSheetImpl updates a sheet's name/statement/visibility/database_id/payload.
 func patchWorkSheetImpl(ctx context.Context, tx *Tx, patch *PatchWorkSheetMessage) error {
 	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
 	if v := patch.Title; v != nil {
@@ -313,6 +326,13 @@ func patchWorkSheetImpl(ctx context.Context, tx *Tx, patch *PatchWorkSheetMessag
 	if v := patch.DatabaseUID; v != nil {
 		set, args = append(set, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
 	args = append(args, patch.UID)
 
 	query := fmt.Sprintf(`
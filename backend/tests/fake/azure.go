@@ -40,6 +40,9 @@ type azureRepositoryData struct {
 	commits map[string]struct {
 		Changes []*azure.CommitChange
 	}
+	// commitStatuses is the map for the reported commit statuses.
+	// the map key is the commit id.
+	commitStatuses map[string][]*vcs.CommitStatus
 }
 
 // NewAzure creates a new fake implementation of Azure VCS provider.
@@ -64,6 +67,7 @@ func NewAzure(port int) VCSProvider {
 	repo.GET("/stats/branches", az.getRepositoryBranch)
 	repo.POST("/pullRequests/:pr/threads", az.createIssueComment)
 	repo.GET("/commits/:commit/changes", az.getCommitChanges)
+	repo.POST("/commits/:commit/statuses", az.createCommitStatus)
 	repo.GET("/items", az.readRepositoryFile)
 
 	return az
@@ -221,6 +225,42 @@ func (*Azure) createIssueComment(echo.Context) error {
 	return nil
 }
 
+func (az *Azure) createCommitStatus(c echo.Context) error {
+	r, err := az.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	b, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read create commit status request body: %v", err))
+	}
+	var status azure.CommitStatus
+	if err := json.Unmarshal(b, &status); err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unmarshal create commit status request body: %v", err))
+	}
+
+	state := vcs.CommitStatusSuccess
+	switch status.State {
+	case "pending":
+		state = vcs.CommitStatusPending
+	case "failed", "error":
+		state = vcs.CommitStatusFailure
+	}
+	statusContext := status.Context.Name
+	if status.Context.Genre != "" {
+		statusContext = fmt.Sprintf("%s/%s", status.Context.Genre, status.Context.Name)
+	}
+	commitID := c.Param("commit")
+	r.commitStatuses[commitID] = append(r.commitStatuses[commitID], &vcs.CommitStatus{
+		State:       state,
+		Context:     statusContext,
+		Description: status.Description,
+		TargetURL:   status.TargetURL,
+	})
+	return c.String(http.StatusCreated, "")
+}
+
 func (az *Azure) validRepository(c echo.Context) (*azureRepositoryData, error) {
 	repositoryID := fmt.Sprintf("%s/%s/%s", c.Param("organization"), c.Param("project"), c.Param("repo"))
 	r, ok := az.repositories[repositoryID]
@@ -260,9 +300,10 @@ func (az *Azure) CreateRepository(repository *vcs.Repository) error {
 				State: "wellFormed",
 			},
 		},
-		files:   make(map[string]string),
-		refs:    map[string]*azure.Branch{},
-		commits: make(map[string]struct{ Changes []*azure.CommitChange }),
+		files:          make(map[string]string),
+		refs:           map[string]*azure.Branch{},
+		commits:        make(map[string]struct{ Changes []*azure.CommitChange }),
+		commitStatuses: make(map[string][]*vcs.CommitStatus),
 	}
 	return nil
 }
@@ -382,3 +423,12 @@ func (*Azure) validFilePath(filePath string) string {
 	}
 	return filePath
 }
+
+// ListCommitStatuses lists the statuses reported to the commit.
+func (az *Azure) ListCommitStatuses(repositoryID, commitID string) ([]*vcs.CommitStatus, error) {
+	r, ok := az.repositories[repositoryID]
+	if !ok {
+		return nil, errors.Errorf("azure repository %q does not exist", repositoryID)
+	}
+	return r.commitStatuses[commitID], nil
+}
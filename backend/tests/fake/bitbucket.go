@@ -43,6 +43,9 @@ type bitbucketRepositoryData struct {
 	pullRequests map[int]struct {
 		Files []*bitbucket.CommitDiffStat
 	}
+	// commitStatuses is the map for the reported commit statuses.
+	// the map key is the commit hash.
+	commitStatuses map[string][]*vcs.CommitStatus
 }
 
 // NewBitbucket creates a new fake implementation of Bitbucket VCS provider.
@@ -65,6 +68,8 @@ func NewBitbucket(port int) VCSProvider {
 	g.GET("/repositories/:owner/:repo/refs/branches/:branchName", bb.getRepositoryBranch)
 	g.GET("/repositories/:owner/:repo/pullrequests/:prID/diffstat", bb.listPullRequestFile)
 	g.GET("/repositories/:owner/:repo/pullrequests/:prID/comments", bb.createPullRequestComment)
+	g.POST("/repositories/:owner/:repo/pullrequests/:prID/comments", bb.createPullRequestComment)
+	g.POST("/repositories/:owner/:repo/commit/:commitID/statuses/build", bb.createCommitStatus)
 	return bb
 }
 
@@ -212,6 +217,7 @@ func (bb *Bitbucket) CreateRepository(repository *vcs.Repository) error {
 		pullRequests: map[int]struct {
 			Files []*bitbucket.CommitDiffStat
 		}{},
+		commitStatuses: make(map[string][]*vcs.CommitStatus),
 	}
 	return nil
 }
@@ -244,6 +250,15 @@ func (bb *Bitbucket) SendWebhookPush(repositoryID string, payload []byte) error
 		return errors.Errorf("Bitbucket repository %q does not exist", repositoryID)
 	}
 
+	var event bitbucket.PullRequestPushEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return errors.Wrap(err, "failed to unmarshal pull request event")
+	}
+	eventKey := "pullrequest:updated"
+	if event.PullRequest.State == bitbucket.PullRequestStateMerged {
+		eventKey = "pullrequest:fulfilled"
+	}
+
 	// Trigger all webhooks
 	for _, webhook := range r.webhooks {
 		if err := func() error {
@@ -251,7 +266,7 @@ func (bb *Bitbucket) SendWebhookPush(repositoryID string, payload []byte) error
 			if err != nil {
 				return errors.Wrapf(err, "failed to create a new POST request to %q", webhook.URL)
 			}
-			req.Header.Set("X-Event-Key", "pullrequest:fulfilled")
+			req.Header.Set("X-Event-Key", eventKey)
 			resp, err := bb.client.Do(req)
 			if err != nil {
 				return errors.Wrapf(err, "failed to send POST request to %q", webhook.URL)
@@ -342,6 +357,47 @@ func (bb *Bitbucket) listPullRequestFile(c echo.Context) error {
 	return c.String(http.StatusOK, string(buf))
 }
 
-func (*Bitbucket) createPullRequestComment(echo.Context) error {
-	return nil
+func (*Bitbucket) createPullRequestComment(c echo.Context) error {
+	return c.String(http.StatusCreated, "")
+}
+
+func (bb *Bitbucket) createCommitStatus(c echo.Context) error {
+	r, err := bb.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	b, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read create commit status request body: %v", err))
+	}
+	var status bitbucket.CommitStatus
+	if err := json.Unmarshal(b, &status); err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unmarshal create commit status request body: %v", err))
+	}
+
+	state := vcs.CommitStatusSuccess
+	switch status.State {
+	case "INPROGRESS":
+		state = vcs.CommitStatusPending
+	case "FAILED", "STOPPED":
+		state = vcs.CommitStatusFailure
+	}
+	commitID := c.Param("commitID")
+	r.commitStatuses[commitID] = append(r.commitStatuses[commitID], &vcs.CommitStatus{
+		State:       state,
+		Context:     status.Key,
+		Description: status.Description,
+		TargetURL:   status.URL,
+	})
+	return c.String(http.StatusCreated, "")
+}
+
+// ListCommitStatuses lists the statuses reported to the commit.
+func (bb *Bitbucket) ListCommitStatuses(repositoryID, commitID string) ([]*vcs.CommitStatus, error) {
+	r, ok := bb.repositories[repositoryID]
+	if !ok {
+		return nil, errors.Errorf("bitbucket repository %q does not exist", repositoryID)
+	}
+	return r.commitStatuses[commitID], nil
 }
@@ -47,6 +47,9 @@ type githubRepositoryData struct {
 		Files []*github.PullRequestFile
 		*github.PullRequest
 	}
+	// commitStatuses is the map for the reported commit statuses.
+	// the map key is the commit SHA.
+	commitStatuses map[string][]*vcs.CommitStatus
 }
 
 // NewGitHub creates a new fake implementation of GitHub VCS provider.
@@ -69,6 +72,8 @@ func NewGitHub(port int) VCSProvider {
 	g.GET("/repos/:owner/:repo/git/ref/heads/:branchName", gh.getRepositoryBranch)
 	g.GET("/repos/:owner/:repo/pulls/:prID/files", gh.listPullRequestFile)
 	g.POST("/repos/:owner/:repo/issues/:prID/comments", gh.createIssueComment)
+	g.POST("/repos/:owner/:repo/pulls/:prID/comments", gh.createPullRequestReviewComment)
+	g.POST("/repos/:owner/:repo/statuses/:sha", gh.createCommitStatus)
 	return gh
 }
 
@@ -212,6 +217,42 @@ func (*GitHub) createIssueComment(echo.Context) error {
 	return nil
 }
 
+func (*GitHub) createPullRequestReviewComment(c echo.Context) error {
+	return c.String(http.StatusCreated, "")
+}
+
+func (gh *GitHub) createCommitStatus(c echo.Context) error {
+	r, err := gh.validRepository(c)
+	if err != nil {
+		return err
+	}
+
+	b, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read create commit status request body: %v", err))
+	}
+	var status github.CommitStatus
+	if err := json.Unmarshal(b, &status); err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unmarshal create commit status request body: %v", err))
+	}
+
+	state := vcs.CommitStatusSuccess
+	switch status.State {
+	case "pending":
+		state = vcs.CommitStatusPending
+	case "failure", "error":
+		state = vcs.CommitStatusFailure
+	}
+	sha := c.Param("sha")
+	r.commitStatuses[sha] = append(r.commitStatuses[sha], &vcs.CommitStatus{
+		State:       state,
+		Context:     status.Context,
+		Description: status.Description,
+		TargetURL:   status.TargetURL,
+	})
+	return c.String(http.StatusCreated, "")
+}
+
 func (gh *GitHub) validRepository(c echo.Context) (*githubRepositoryData, error) {
 	repositoryID := fmt.Sprintf("%s/%s", c.Param("owner"), c.Param("repo"))
 	r, ok := gh.repositories[repositoryID]
@@ -251,6 +292,7 @@ func (gh *GitHub) CreateRepository(repository *vcs.Repository) error {
 			Files []*github.PullRequestFile
 			*github.PullRequest
 		}{},
+		commitStatuses: make(map[string][]*vcs.CommitStatus),
 	}
 	return nil
 }
@@ -368,3 +410,12 @@ func (gh *GitHub) AddPullRequest(repositoryID string, prID int, files []*vcs.Pul
 
 	return nil
 }
+
+// ListCommitStatuses lists the statuses reported to the commit.
+func (gh *GitHub) ListCommitStatuses(repositoryID, commitID string) ([]*vcs.CommitStatus, error) {
+	r, ok := gh.repositories[repositoryID]
+	if !ok {
+		return nil, errors.Errorf("github repository %q does not exist", repositoryID)
+	}
+	return r.commitStatuses[commitID], nil
+}
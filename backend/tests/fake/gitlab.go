@@ -45,6 +45,9 @@ type projectData struct {
 		*gitlab.MergeRequestChange
 		*gitlab.MergeRequest
 	}
+	// commitStatuses is the map for the reported commit statuses.
+	// the map key is the commit SHA.
+	commitStatuses map[string][]*vcs.CommitStatus
 }
 
 // NewGitLab creates a new fake implementation of GitLab VCS provider.
@@ -70,6 +73,9 @@ func NewGitLab(port int) VCSProvider {
 	projectGroup.POST("/projects/:id/merge_requests", gl.createProjectPullRequest)
 	projectGroup.GET("/projects/:id/merge_requests/:mrID/changes", gl.getMergeRequestChanges)
 	projectGroup.GET("/projects/:id/merge_requests/:mrID/notes", gl.createMergeRequestComment)
+	projectGroup.GET("/projects/:id/merge_requests/:mrID", gl.getMergeRequest)
+	projectGroup.POST("/projects/:id/merge_requests/:mrID/discussions", gl.createMergeRequestDiscussion)
+	projectGroup.POST("/projects/:id/statuses/:sha", gl.createCommitStatus)
 
 	return gl
 }
@@ -108,6 +114,7 @@ func (gl *GitLab) CreateRepository(repository *vcs.Repository) error {
 			*gitlab.MergeRequestChange
 			*gitlab.MergeRequest
 		}{},
+		commitStatuses: map[string][]*vcs.CommitStatus{},
 	}
 	return nil
 }
@@ -353,6 +360,65 @@ func (*GitLab) createMergeRequestComment(echo.Context) error {
 	return nil
 }
 
+func (gl *GitLab) getMergeRequest(c echo.Context) error {
+	pd, err := gl.validProject(c)
+	if err != nil {
+		return err
+	}
+
+	mrNumber, err := strconv.Atoi(c.Param("mrID"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("The merge request id is invalid: %v", c.Param("mrID")))
+	}
+
+	mergeRequest, ok := pd.mergeRequests[mrNumber]
+	if !ok {
+		return c.String(http.StatusNotFound, fmt.Sprintf("Cannot find the merge request: %v", c.Param("mrID")))
+	}
+
+	buf, err := json.Marshal(mergeRequest.MergeRequest)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to marshal response body: %v", err))
+	}
+	return c.String(http.StatusOK, string(buf))
+}
+
+func (*GitLab) createMergeRequestDiscussion(c echo.Context) error {
+	return c.String(http.StatusCreated, "")
+}
+
+func (gl *GitLab) createCommitStatus(c echo.Context) error {
+	pd, err := gl.validProject(c)
+	if err != nil {
+		return err
+	}
+
+	b, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read create commit status request body: %v", err))
+	}
+	var status gitlab.CommitStatus
+	if err := json.Unmarshal(b, &status); err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unmarshal create commit status request body: %v", err))
+	}
+
+	state := vcs.CommitStatusSuccess
+	switch status.State {
+	case "pending", "running":
+		state = vcs.CommitStatusPending
+	case "failed", "canceled":
+		state = vcs.CommitStatusFailure
+	}
+	sha := c.Param("sha")
+	pd.commitStatuses[sha] = append(pd.commitStatuses[sha], &vcs.CommitStatus{
+		State:       state,
+		Context:     status.Name,
+		Description: status.Description,
+		TargetURL:   status.TargetURL,
+	})
+	return c.String(http.StatusCreated, "")
+}
+
 // SendWebhookPush sends out a webhook for a push event for the GitLab project
 // using given payload.
 func (gl *GitLab) SendWebhookPush(projectID string, payload []byte) error {
@@ -443,3 +509,12 @@ func (gl *GitLab) AddPullRequest(projectID string, mrID int, files []*vcs.PullRe
 	}
 	return nil
 }
+
+// ListCommitStatuses lists the statuses reported to the commit.
+func (gl *GitLab) ListCommitStatuses(projectID, commitID string) ([]*vcs.CommitStatus, error) {
+	pd, ok := gl.projects[projectID]
+	if !ok {
+		return nil, errors.Errorf("gitlab project %q doesn't exist", projectID)
+	}
+	return pd.commitStatuses[commitID], nil
+}
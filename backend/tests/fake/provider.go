@@ -26,6 +26,8 @@ type VCSProvider interface {
 	AddFiles(repositoryID string, files map[string]string) error
 	// AddPullRequest creates a new pull request and add changed files to it.
 	AddPullRequest(repositoryID string, prID int, files []*vcs.PullRequestFile) error
+	// ListCommitStatuses lists the statuses reported to the commit in the repository.
+	ListCommitStatuses(repositoryID, commitID string) ([]*vcs.CommitStatus, error)
 }
 
 // VCSProviderCreator a function to create a new VCSProvider.
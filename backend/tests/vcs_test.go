@@ -13,6 +13,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/tests/fake"
@@ -40,7 +41,10 @@ func TestVCS(t *testing.T) {
 		vcsProviderCreator fake.VCSProviderCreator
 		vcsType            v1pb.VCSType
 		repository         *vcs.Repository
-		webhookPushEvent   any
+		// webhookOpenEvent is sent before merging the pull request.
+		webhookOpenEvent any
+		webhookPushEvent any
+		commitID         string
 	}{
 		{
 			name:               "GitLab",
@@ -51,6 +55,21 @@ func TestVCS(t *testing.T) {
 				Name:     "test/vcs",
 				FullPath: "test/vcs",
 			},
+			commitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+			webhookOpenEvent: gitlab.MergeRequestPushEvent{
+				ObjectKind: "merge_request",
+				ObjectAttributes: gitlab.EventObjectAttributes{
+					IID:          pullRequestID,
+					URL:          "https://gitlab.com/test/vcs/-/merge_requests/2250",
+					TargetBranch: branchName,
+					Action:       "open",
+					Title:        pullRequestTitle,
+					Description:  pullRequestDescription,
+					LastCommit: gitlab.LastCommit{
+						ID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+					},
+				},
+			},
 			webhookPushEvent: gitlab.MergeRequestPushEvent{
 				ObjectKind: "merge_request",
 				ObjectAttributes: gitlab.EventObjectAttributes{
@@ -75,6 +94,24 @@ func TestVCS(t *testing.T) {
 				Name:     "octocat/Hello-World",
 				FullPath: "octocat/Hello-World",
 			},
+			commitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+			webhookOpenEvent: github.PullRequestPushEvent{
+				Action: "opened",
+				Number: pullRequestID,
+				PullRequest: github.EventPullRequest{
+					HTMLURL: fmt.Sprintf("https://github.com/test/vcs/pull/%d", pullRequestID),
+					Title:   pullRequestTitle,
+					Body:    pullRequestDescription,
+					Base: github.EventBranch{
+						Ref: branchName,
+						SHA: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b0",
+					},
+					Head: github.EventBranch{
+						Ref: "test-branch",
+						SHA: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+					},
+				},
+			},
 			webhookPushEvent: github.PullRequestPushEvent{
 				Action: "closed",
 				Number: pullRequestID,
@@ -103,9 +140,26 @@ func TestVCS(t *testing.T) {
 				Name:     "octocat/Hello-World",
 				FullPath: "octocat/Hello-World",
 			},
+			commitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+			webhookOpenEvent: bitbucket.PullRequestPushEvent{
+				PullRequest: bitbucket.EventPullRequest{
+					ID:          pullRequestID,
+					Title:       pullRequestTitle,
+					Description: pullRequestDescription,
+					State:       bitbucket.PullRequestStateOpen,
+					Destination: bitbucket.EventBranch{
+						Branch: bitbucket.EventBranchName{Name: branchName},
+					},
+					Source: bitbucket.EventBranch{
+						Commit: bitbucket.EventCommit{Hash: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1"},
+					},
+					Links: bitbucket.EventLinks{HTML: bitbucket.EventHTML{Href: fmt.Sprintf("https://bitbucket.org/test/vcs/pull-requests/%d", pullRequestID)}},
+				},
+			},
 			webhookPushEvent: bitbucket.PullRequestPushEvent{
 				PullRequest: bitbucket.EventPullRequest{
 					ID:          pullRequestID,
+					State:       bitbucket.PullRequestStateMerged,
 					Title:       pullRequestTitle,
 					Description: pullRequestDescription,
 					Destination: bitbucket.EventBranch{
@@ -118,6 +172,52 @@ func TestVCS(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:               "Gitea",
+			vcsProviderCreator: fake.NewGitea,
+			vcsType:            v1pb.VCSType_GITEA,
+			repository: &vcs.Repository{
+				ID:       "octocat/Hello-World",
+				Name:     "Hello-World",
+				FullPath: "octocat/Hello-World",
+			},
+			commitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+			webhookOpenEvent: gitea.PullRequestEvent{
+				Action: "opened",
+				Number: pullRequestID,
+				PullRequest: gitea.EventPullRequest{
+					HTMLURL: fmt.Sprintf("https://gitea.com/test/vcs/pulls/%d", pullRequestID),
+					Title:   pullRequestTitle,
+					Body:    pullRequestDescription,
+					Base: gitea.EventBranch{
+						Ref: branchName,
+						SHA: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b0",
+					},
+					Head: gitea.EventBranch{
+						Ref: "test-branch",
+						SHA: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+					},
+				},
+			},
+			webhookPushEvent: gitea.PullRequestEvent{
+				Action: "closed",
+				Number: pullRequestID,
+				PullRequest: gitea.EventPullRequest{
+					HTMLURL: fmt.Sprintf("https://gitea.com/test/vcs/pulls/%d", pullRequestID),
+					Title:   pullRequestTitle,
+					Body:    pullRequestDescription,
+					Base: gitea.EventBranch{
+						Ref: branchName,
+						SHA: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b0",
+					},
+					Head: gitea.EventBranch{
+						Ref: "test-branch",
+						SHA: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+					},
+					Merged: true,
+				},
+			},
+		},
 		{
 			name:               "Azure",
 			vcsProviderCreator: fake.NewAzure,
@@ -127,6 +227,32 @@ func TestVCS(t *testing.T) {
 				Name:     "bytebase/project/bb",
 				FullPath: "bytebase/project/bb",
 			},
+			commitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+			webhookOpenEvent: azure.PullRequestEvent{
+				ID:        "mock_event_id",
+				EventType: "git.pullrequest.merged",
+				Resource: &azure.PullRequestResource{
+					Repository: &azure.Repository{},
+					Links: &azure.PullRequestLinks{
+						Web: &azure.PullRequestWeb{
+							Href: fmt.Sprintf("https://dev.azure.com/test/vcs/pull-requests/%d", pullRequestID),
+						},
+					},
+					PullRequestID: pullRequestID,
+					Status:        "active",
+					Title:         pullRequestTitle,
+					Description:   pullRequestDescription,
+					SourceRefName: "mock-source-branch",
+					TargetRefName: fmt.Sprintf("refs/heads/%s", branchName),
+					MergeStatus:   "succeeded",
+					LastMergeCommit: &azure.PullRequestEventLastMergeCommit{
+						CommitID: fmt.Sprintf("%d", pullRequestID),
+					},
+					LastMergeSourceCommit: &azure.PullRequestEventLastMergeCommit{
+						CommitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+					},
+				},
+			},
 			webhookPushEvent: azure.PullRequestEvent{
 				ID:        "mock_event_id",
 				EventType: "git.pullrequest.merged",
@@ -147,6 +273,9 @@ func TestVCS(t *testing.T) {
 					LastMergeCommit: &azure.PullRequestEventLastMergeCommit{
 						CommitID: fmt.Sprintf("%d", pullRequestID),
 					},
+					LastMergeSourceCommit: &azure.PullRequestEventLastMergeCommit{
+						CommitID: "cc63b0592388a7ab1b05b005ad8c8dc14ce432b1",
+					},
 				},
 			},
 		},
@@ -238,13 +367,26 @@ func TestVCS(t *testing.T) {
 			err = ctl.vcsProvider.AddFiles(test.repository.ID, fileContentMap)
 			a.NoError(err)
 
-			payload, err := json.Marshal(test.webhookPushEvent)
+			// The SQL review result is reported to the head commit before merging, and no issue is created.
+			payload, err := json.Marshal(test.webhookOpenEvent)
+			a.NoError(err)
+			err = ctl.vcsProvider.SendWebhookPush(test.repository.ID, payload)
+			a.NoError(err)
+			statuses, err := ctl.vcsProvider.ListCommitStatuses(test.repository.ID, test.commitID)
+			a.NoError(err)
+			a.NotEmpty(statuses)
+			a.Equal("bytebase/sql-review", statuses[len(statuses)-1].Context)
+			issue, err := ctl.getLastOpenIssue(ctx, ctl.project)
+			a.NoError(err)
+			a.Nil(issue)
+
+			payload, err = json.Marshal(test.webhookPushEvent)
 			a.NoError(err)
 			err = ctl.vcsProvider.SendWebhookPush(test.repository.ID, payload)
 			a.NoError(err)
 
 			// Get schema update issue.
-			issue, err := ctl.getLastOpenIssue(ctx, ctl.project)
+			issue, err = ctl.getLastOpenIssue(ctx, ctl.project)
 			a.NoError(err)
 			a.NotNil(issue)
 			err = ctl.waitRollout(ctx, issue.Name, issue.Rollout)
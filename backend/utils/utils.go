@@ -16,6 +16,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/gosimple/slug"
 	"github.com/pkg/errors"
 	"golang.org/x/text/encoding"
 	"golang.org/x/text/encoding/charmap"
@@ -26,12 +27,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/app/jira"
 	"github.com/bytebase/bytebase/backend/plugin/app/relay"
+	"github.com/bytebase/bytebase/backend/plugin/app/servicenow"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -401,27 +405,73 @@ func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int
 
 // FindNextPendingStep finds the next pending step in the approval flow.
 func FindNextPendingStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
-	// We can do the finding like this for now because we are presuming that
-	// one step is approved by one approver.
-	// and the approver status is either
-	// APPROVED or REJECTED.
-	if len(approvers) >= len(template.Flow.Steps) {
+	stepIndex, _, _, _ := FindPendingStepApprovers(template, approvers)
+	if stepIndex >= len(template.Flow.Steps) {
 		return nil
 	}
-	return template.Flow.Steps[len(approvers)]
+	return template.Flow.Steps[stepIndex]
 }
 
 // FindRejectedStep finds the rejected step in the approval flow.
 func FindRejectedStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
-	for i, approver := range approvers {
-		if i >= len(template.Flow.Steps) {
-			return nil
-		}
+	stepIndex, rejected, _, _ := FindPendingStepApprovers(template, approvers)
+	if !rejected {
+		return nil
+	}
+	return template.Flow.Steps[stepIndex]
+}
+
+// FindPendingStepApprovers walks through the approval flow with the approvers in order.
+// It returns the index of the pending step, whether the pending step is rejected,
+// the approvers of the pending step and the approvers of the previous steps.
+// The index equals to the step count if all steps are approved.
+func FindPendingStepApprovers(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) (int, bool, []*storepb.IssuePayloadApproval_Approver, []*storepb.IssuePayloadApproval_Approver) {
+	steps := template.GetFlow().GetSteps()
+	var stepIndex, approvedCount int
+	var stepApprovers, previousApprovers []*storepb.IssuePayloadApproval_Approver
+	for _, approver := range approvers {
+		if stepIndex >= len(steps) {
+			break
+		}
+		stepApprovers = append(stepApprovers, approver)
 		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
-			return template.Flow.Steps[i]
+			return stepIndex, true, stepApprovers, previousApprovers
+		}
+		if approver.Status == storepb.IssuePayloadApproval_Approver_APPROVED {
+			approvedCount++
+		}
+		if approvedCount >= GetApprovalStepQuorum(steps[stepIndex]) {
+			previousApprovers = append(previousApprovers, stepApprovers...)
+			stepApprovers = nil
+			approvedCount = 0
+			stepIndex++
 		}
 	}
-	return nil
+	return stepIndex, false, stepApprovers, previousApprovers
+}
+
+// GetApprovalStepQuorum returns the number of distinct approvers required to pass the step.
+func GetApprovalStepQuorum(step *storepb.ApprovalStep) int {
+	if step.GetQuorum() > 1 {
+		return int(step.GetQuorum())
+	}
+	return 1
+}
+
+// GetApprovalDelegateID returns the principal id of the backup approver of the user if the approval delegation is effective at the time.
+// It returns 0 if there is no effective delegation.
+func GetApprovalDelegateID(user *store.UserMessage, t time.Time) int {
+	delegation := user.Profile.GetApprovalDelegation()
+	if delegation == nil || delegation.DelegateId == 0 {
+		return 0
+	}
+	if delegation.StartTime != nil && t.Before(delegation.StartTime.AsTime()) {
+		return 0
+	}
+	if delegation.EndTime != nil && !t.Before(delegation.EndTime.AsTime()) {
+		return 0
+	}
+	return int(delegation.DelegateId)
 }
 
 // CheckApprovalApproved checks if the approval is approved.
@@ -510,16 +560,44 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	if node == nil {
 		return errors.Errorf("external approval node %s not found", externalNodeID)
 	}
-	id, err := relayClient.Create(node.Endpoint, &relay.CreatePayload{
-		IssueID:     fmt.Sprintf("%d", issue.UID),
-		Title:       issue.Title,
-		Description: issue.Description,
-		Project:     issue.Project.ResourceID,
-		CreateTime:  issue.CreatedTime,
-		Creator:     issue.Creator.Email,
-	})
-	if err != nil {
-		return errors.Wrapf(err, "failed to create external approval")
+	var id string
+	switch node.Type {
+	case storepb.ExternalApprovalSetting_Node_JIRA:
+		description, err := getExternalApprovalTicketDescription(ctx, s, issue)
+		if err != nil {
+			return err
+		}
+		id, err = jira.NewClient(node.JiraConfig).CreateIssue(ctx, &jira.CreateIssuePayload{
+			Summary:     fmt.Sprintf("[Bytebase] %s", issue.Title),
+			Description: description,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create external approval")
+		}
+	case storepb.ExternalApprovalSetting_Node_SERVICE_NOW:
+		description, err := getExternalApprovalTicketDescription(ctx, s, issue)
+		if err != nil {
+			return err
+		}
+		id, err = servicenow.NewClient(node.ServiceNowConfig).CreateChangeRequest(ctx, &servicenow.CreateChangeRequestPayload{
+			ShortDescription: fmt.Sprintf("[Bytebase] %s", issue.Title),
+			Description:      description,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create external approval")
+		}
+	default:
+		id, err = relayClient.Create(node.Endpoint, &relay.CreatePayload{
+			IssueID:     fmt.Sprintf("%d", issue.UID),
+			Title:       issue.Title,
+			Description: issue.Description,
+			Project:     issue.Project.ResourceID,
+			CreateTime:  issue.CreatedTime,
+			Creator:     issue.Creator.Email,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create external approval")
+		}
 	}
 	payload, err := json.Marshal(&api.ExternalApprovalPayloadRelay{
 		ExternalApprovalNodeID: node.Id,
@@ -540,6 +618,26 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	return nil
 }
 
+// getExternalApprovalTicketDescription returns the description of the Jira issue or the ServiceNow change request created for the issue.
+func getExternalApprovalTicketDescription(ctx context.Context, s *store.Store, issue *store.IssueMessage) (string, error) {
+	setting, err := s.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get workspace general setting")
+	}
+	var lines []string
+	if setting.ExternalUrl != "" {
+		lines = append(lines, fmt.Sprintf("Issue: %s/projects/%s/issues/%s-%d", setting.ExternalUrl, issue.Project.ResourceID, slug.Make(issue.Title), issue.UID))
+	}
+	lines = append(lines,
+		fmt.Sprintf("Project: %s", issue.Project.Title),
+		fmt.Sprintf("Creator: %s", issue.Creator.Email),
+	)
+	if issue.Description != "" {
+		lines = append(lines, "", issue.Description)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
 // UpdateProjectPolicyFromGrantIssue updates the project policy from grant issue.
 func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store, issue *store.IssueMessage, grantRequest *storepb.GrantRequest) error {
 	policy, err := stores.GetProjectIamPolicy(ctx, issue.Project.UID)
@@ -547,10 +645,19 @@ func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store,
 		return errors.Wrapf(err, "failed to get project policy for project %q", issue.Project.UID)
 	}
 
-	var newConditionExpr string
+	condition := &expr.Expr{}
 	if grantRequest.Condition != nil {
-		newConditionExpr = grantRequest.Condition.Expression
+		condition = proto.Clone(grantRequest.Condition).(*expr.Expr)
 	}
+	// The expiration starts from the time the request is granted rather than requested.
+	if grantRequest.Expiration != nil && grantRequest.Expiration.AsDuration() > 0 {
+		expression, err := common.SetBindingExpireTime(condition.Expression, time.Now().Add(grantRequest.Expiration.AsDuration()))
+		if err != nil {
+			return errors.Wrapf(err, "failed to set expire time for grant request")
+		}
+		condition.Expression = expression
+	}
+	newConditionExpr := condition.Expression
 	updated := false
 
 	userID, err := strconv.Atoi(strings.TrimPrefix(grantRequest.User, "users/"))
@@ -581,10 +688,6 @@ func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store,
 		break
 	}
 	if !updated {
-		condition := grantRequest.Condition
-		if condition == nil {
-			condition = &expr.Expr{}
-		}
 		condition.Description = fmt.Sprintf("#%d", issue.UID)
 		policy.Bindings = append(policy.Bindings, &storepb.Binding{
 			Role:      grantRequest.Role,
@@ -2,9 +2,11 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -466,3 +468,126 @@ func TestConvertBytesToUTF8String(t *testing.T) {
 		assert.Equal(t, test.expected, actual)
 	}
 }
+
+func TestFindPendingStepApprovers(t *testing.T) {
+	template := &storepb.ApprovalTemplate{
+		Flow: &storepb.ApprovalFlow{
+			Steps: []*storepb.ApprovalStep{
+				{Type: storepb.ApprovalStep_ANY},
+				{Type: storepb.ApprovalStep_ANY, Quorum: 2},
+			},
+		},
+	}
+	approved := func(id int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: id}
+	}
+	rejected := func(id int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_REJECTED, PrincipalId: id}
+	}
+
+	tests := []struct {
+		approvers             []*storepb.IssuePayloadApproval_Approver
+		wantStepIndex         int
+		wantRejected          bool
+		wantStepApprovers     int
+		wantPreviousApprovers int
+	}{
+		{
+			approvers:     nil,
+			wantStepIndex: 0,
+		},
+		{
+			approvers:             []*storepb.IssuePayloadApproval_Approver{approved(101)},
+			wantStepIndex:         1,
+			wantPreviousApprovers: 1,
+		},
+		{
+			approvers:             []*storepb.IssuePayloadApproval_Approver{approved(101), approved(102)},
+			wantStepIndex:         1,
+			wantStepApprovers:     1,
+			wantPreviousApprovers: 1,
+		},
+		{
+			approvers:             []*storepb.IssuePayloadApproval_Approver{approved(101), approved(102), approved(103)},
+			wantStepIndex:         2,
+			wantPreviousApprovers: 3,
+		},
+		{
+			approvers:             []*storepb.IssuePayloadApproval_Approver{approved(101), approved(102), rejected(103)},
+			wantStepIndex:         1,
+			wantRejected:          true,
+			wantStepApprovers:     2,
+			wantPreviousApprovers: 1,
+		},
+	}
+
+	for i, test := range tests {
+		stepIndex, rejected, stepApprovers, previousApprovers := FindPendingStepApprovers(template, test.approvers)
+		assert.Equal(t, test.wantStepIndex, stepIndex, i)
+		assert.Equal(t, test.wantRejected, rejected, i)
+		assert.Len(t, stepApprovers, test.wantStepApprovers, i)
+		assert.Len(t, previousApprovers, test.wantPreviousApprovers, i)
+	}
+
+	approval := &storepb.IssuePayloadApproval{
+		ApprovalFindingDone: true,
+		ApprovalTemplates:   []*storepb.ApprovalTemplate{template},
+		Approvers:           []*storepb.IssuePayloadApproval_Approver{approved(101), approved(102)},
+	}
+	ok, err := CheckApprovalApproved(approval)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	approval.Approvers = append(approval.Approvers, approved(103))
+	ok, err = CheckApprovalApproved(approval)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestGetApprovalDelegateID(t *testing.T) {
+	now := time.Now()
+	newUser := func(delegation *storepb.UserProfile_ApprovalDelegation) *store.UserMessage {
+		return &store.UserMessage{Profile: &storepb.UserProfile{ApprovalDelegation: delegation}}
+	}
+
+	tests := []struct {
+		user *store.UserMessage
+		want int
+	}{
+		{
+			user: &store.UserMessage{},
+			want: 0,
+		},
+		{
+			user: newUser(&storepb.UserProfile_ApprovalDelegation{DelegateId: 102}),
+			want: 102,
+		},
+		{
+			user: newUser(&storepb.UserProfile_ApprovalDelegation{
+				DelegateId: 102,
+				StartTime:  timestamppb.New(now.Add(-time.Hour)),
+				EndTime:    timestamppb.New(now.Add(time.Hour)),
+			}),
+			want: 102,
+		},
+		// The delegation has not started.
+		{
+			user: newUser(&storepb.UserProfile_ApprovalDelegation{
+				DelegateId: 102,
+				StartTime:  timestamppb.New(now.Add(time.Hour)),
+			}),
+			want: 0,
+		},
+		// The delegation has ended.
+		{
+			user: newUser(&storepb.UserProfile_ApprovalDelegation{
+				DelegateId: 102,
+				EndTime:    timestamppb.New(now),
+			}),
+			want: 0,
+		},
+	}
+
+	for i, test := range tests {
+		assert.Equal(t, test.want, GetApprovalDelegateID(test.user, now), i)
+	}
+}
@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: store/database_size.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DatabaseSizeSnapshot is the size statistics of a database sampled at a schema sync.
+type DatabaseSizeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The row_count is the estimated number of rows of all tables in the database.
+	RowCount int64 `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// The data_size is the estimated data size of all tables in the database.
+	DataSize int64 `protobuf:"varint,2,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	// The index_size is the estimated index size of all tables in the database.
+	IndexSize int64                `protobuf:"varint,3,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	Tables    []*TableSizeSnapshot `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *DatabaseSizeSnapshot) Reset() {
+	*x = DatabaseSizeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_database_size_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseSizeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSizeSnapshot) ProtoMessage() {}
+
+func (x *DatabaseSizeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_store_database_size_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSizeSnapshot.ProtoReflect.Descriptor instead.
+func (*DatabaseSizeSnapshot) Descriptor() ([]byte, []int) {
+	return file_store_database_size_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DatabaseSizeSnapshot) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *DatabaseSizeSnapshot) GetDataSize() int64 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+func (x *DatabaseSizeSnapshot) GetIndexSize() int64 {
+	if x != nil {
+		return x.IndexSize
+	}
+	return 0
+}
+
+func (x *DatabaseSizeSnapshot) GetTables() []*TableSizeSnapshot {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// TableSizeSnapshot is the size statistics of a table sampled at a schema sync.
+type TableSizeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The schema name of the table, it's empty for engines without schemas.
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The row_count is the estimated number of rows of the table.
+	RowCount int64 `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// The data_size is the estimated data size of the table.
+	DataSize int64 `protobuf:"varint,4,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	// The index_size is the estimated index size of the table.
+	IndexSize int64 `protobuf:"varint,5,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+}
+
+func (x *TableSizeSnapshot) Reset() {
+	*x = TableSizeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_database_size_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableSizeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSizeSnapshot) ProtoMessage() {}
+
+func (x *TableSizeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_store_database_size_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSizeSnapshot.ProtoReflect.Descriptor instead.
+func (*TableSizeSnapshot) Descriptor() ([]byte, []int) {
+	return file_store_database_size_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TableSizeSnapshot) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *TableSizeSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableSizeSnapshot) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *TableSizeSnapshot) GetDataSize() int64 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+func (x *TableSizeSnapshot) GetIndexSize() int64 {
+	if x != nil {
+		return x.IndexSize
+	}
+	return 0
+}
+
+var File_store_database_size_proto protoreflect.FileDescriptor
+
+var file_store_database_size_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x14,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a,
+	0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_store_database_size_proto_rawDescOnce sync.Once
+	file_store_database_size_proto_rawDescData = file_store_database_size_proto_rawDesc
+)
+
+func file_store_database_size_proto_rawDescGZIP() []byte {
+	file_store_database_size_proto_rawDescOnce.Do(func() {
+		file_store_database_size_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_database_size_proto_rawDescData)
+	})
+	return file_store_database_size_proto_rawDescData
+}
+
+var file_store_database_size_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_database_size_proto_goTypes = []any{
+	(*DatabaseSizeSnapshot)(nil), // 0: bytebase.store.DatabaseSizeSnapshot
+	(*TableSizeSnapshot)(nil),    // 1: bytebase.store.TableSizeSnapshot
+}
+var file_store_database_size_proto_depIdxs = []int32{
+	1, // 0: bytebase.store.DatabaseSizeSnapshot.tables:type_name -> bytebase.store.TableSizeSnapshot
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_database_size_proto_init() }
+func file_store_database_size_proto_init() {
+	if File_store_database_size_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_database_size_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*DatabaseSizeSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_database_size_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TableSizeSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_database_size_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_database_size_proto_goTypes,
+		DependencyIndexes: file_store_database_size_proto_depIdxs,
+		MessageInfos:      file_store_database_size_proto_msgTypes,
+	}.Build()
+	File_store_database_size_proto = out.File
+	file_store_database_size_proto_rawDesc = nil
+	file_store_database_size_proto_goTypes = nil
+	file_store_database_size_proto_depIdxs = nil
+}
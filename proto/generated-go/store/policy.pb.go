@@ -607,6 +607,115 @@ func (x *TagPolicy) GetTags() map[string]string {
 	return nil
 }
 
+// QueryGuardPolicy is the policy to reject the expensive ad-hoc queries by their estimated cost from EXPLAIN.
+type QueryGuardPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum estimated number of rows of a query.
+	// 0 means no limit.
+	MaximumEstimatedRows int64 `protobuf:"varint,1,opt,name=maximum_estimated_rows,json=maximumEstimatedRows,proto3" json:"maximum_estimated_rows,omitempty"`
+	// The maximum estimated cost of a query in the unit of the database engine.
+	// 0 means no limit.
+	MaximumEstimatedCost float64 `protobuf:"fixed64,2,opt,name=maximum_estimated_cost,json=maximumEstimatedCost,proto3" json:"maximum_estimated_cost,omitempty"`
+	// The maximum estimated bytes processed by a query, e.g. from BigQuery dry-run and Snowflake EXPLAIN.
+	// 0 means no limit.
+	MaximumEstimatedBytes int64 `protobuf:"varint,3,opt,name=maximum_estimated_bytes,json=maximumEstimatedBytes,proto3" json:"maximum_estimated_bytes,omitempty"`
+	// Full table scans on the tables with more rows are rejected.
+	// 0 means no limit.
+	MaximumFullScanRows int64 `protobuf:"varint,4,opt,name=maximum_full_scan_rows,json=maximumFullScanRows,proto3" json:"maximum_full_scan_rows,omitempty"`
+	// The members exempt from the policy.
+	// Format: users/{uid}
+	ExemptMembers []string `protobuf:"bytes,5,rep,name=exempt_members,json=exemptMembers,proto3" json:"exempt_members,omitempty"`
+	// The link for users to request an exemption, shown with the rejected queries.
+	ExemptionRequestUrl string `protobuf:"bytes,6,opt,name=exemption_request_url,json=exemptionRequestUrl,proto3" json:"exemption_request_url,omitempty"`
+	// Reject the queries whose cost cannot be estimated, e.g. EXPLAIN fails.
+	// By default, such queries are allowed to run.
+	RejectOnEstimationFailure bool `protobuf:"varint,7,opt,name=reject_on_estimation_failure,json=rejectOnEstimationFailure,proto3" json:"reject_on_estimation_failure,omitempty"`
+}
+
+func (x *QueryGuardPolicy) Reset() {
+	*x = QueryGuardPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_policy_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGuardPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGuardPolicy) ProtoMessage() {}
+
+func (x *QueryGuardPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGuardPolicy.ProtoReflect.Descriptor instead.
+func (*QueryGuardPolicy) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryGuardPolicy) GetMaximumEstimatedRows() int64 {
+	if x != nil {
+		return x.MaximumEstimatedRows
+	}
+	return 0
+}
+
+func (x *QueryGuardPolicy) GetMaximumEstimatedCost() float64 {
+	if x != nil {
+		return x.MaximumEstimatedCost
+	}
+	return 0
+}
+
+func (x *QueryGuardPolicy) GetMaximumEstimatedBytes() int64 {
+	if x != nil {
+		return x.MaximumEstimatedBytes
+	}
+	return 0
+}
+
+func (x *QueryGuardPolicy) GetMaximumFullScanRows() int64 {
+	if x != nil {
+		return x.MaximumFullScanRows
+	}
+	return 0
+}
+
+func (x *QueryGuardPolicy) GetExemptMembers() []string {
+	if x != nil {
+		return x.ExemptMembers
+	}
+	return nil
+}
+
+func (x *QueryGuardPolicy) GetExemptionRequestUrl() string {
+	if x != nil {
+		return x.ExemptionRequestUrl
+	}
+	return ""
+}
+
+func (x *QueryGuardPolicy) GetRejectOnEstimationFailure() bool {
+	if x != nil {
+		return x.RejectOnEstimationFailure
+	}
+	return false
+}
+
 type MaskingExceptionPolicy_MaskingException struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -627,7 +736,7 @@ type MaskingExceptionPolicy_MaskingException struct {
 func (x *MaskingExceptionPolicy_MaskingException) Reset() {
 	*x = MaskingExceptionPolicy_MaskingException{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_policy_proto_msgTypes[8]
+		mi := &file_store_policy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -640,7 +749,7 @@ func (x *MaskingExceptionPolicy_MaskingException) String() string {
 func (*MaskingExceptionPolicy_MaskingException) ProtoMessage() {}
 
 func (x *MaskingExceptionPolicy_MaskingException) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[8]
+	mi := &file_store_policy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +807,7 @@ type MaskingRulePolicy_MaskingRule struct {
 func (x *MaskingRulePolicy_MaskingRule) Reset() {
 	*x = MaskingRulePolicy_MaskingRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_policy_proto_msgTypes[9]
+		mi := &file_store_policy_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -711,7 +820,7 @@ func (x *MaskingRulePolicy_MaskingRule) String() string {
 func (*MaskingRulePolicy_MaskingRule) ProtoMessage() {}
 
 func (x *MaskingRulePolicy_MaskingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[9]
+	mi := &file_store_policy_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -856,14 +965,38 @@ var file_store_policy_proto_rawDesc = []byte{
 	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x2a, 0x51, 0x0a, 0x12, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x75, 0x6c, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56,
-	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57,
-	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41,
-	0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x38, 0x01, 0x22, 0x87, 0x03, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f,
+	0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x61,
+	0x6e, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x6f, 0x77,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x6d, 0x70,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x1c,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x19, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x6e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x2a, 0x51, 0x0a,
+	0x12, 0x53, 0x51, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -879,7 +1012,7 @@ func file_store_policy_proto_rawDescGZIP() []byte {
 }
 
 var file_store_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_store_policy_proto_goTypes = []any{
 	(SQLReviewRuleLevel)(0),                             // 0: bytebase.store.SQLReviewRuleLevel
 	(MaskingExceptionPolicy_MaskingException_Action)(0), // 1: bytebase.store.MaskingExceptionPolicy.MaskingException.Action
@@ -891,27 +1024,28 @@ var file_store_policy_proto_goTypes = []any{
 	(*SQLReviewPolicy)(nil),                             // 7: bytebase.store.SQLReviewPolicy
 	(*SQLReviewRule)(nil),                               // 8: bytebase.store.SQLReviewRule
 	(*TagPolicy)(nil),                                   // 9: bytebase.store.TagPolicy
-	(*MaskingExceptionPolicy_MaskingException)(nil),     // 10: bytebase.store.MaskingExceptionPolicy.MaskingException
-	(*MaskingRulePolicy_MaskingRule)(nil),               // 11: bytebase.store.MaskingRulePolicy.MaskingRule
-	nil,                                                 // 12: bytebase.store.TagPolicy.TagsEntry
-	(MaskingLevel)(0),                                   // 13: bytebase.store.MaskingLevel
-	(Engine)(0),                                         // 14: bytebase.store.Engine
-	(*expr.Expr)(nil),                                   // 15: google.type.Expr
+	(*QueryGuardPolicy)(nil),                            // 10: bytebase.store.QueryGuardPolicy
+	(*MaskingExceptionPolicy_MaskingException)(nil),     // 11: bytebase.store.MaskingExceptionPolicy.MaskingException
+	(*MaskingRulePolicy_MaskingRule)(nil),               // 12: bytebase.store.MaskingRulePolicy.MaskingRule
+	nil,                                                 // 13: bytebase.store.TagPolicy.TagsEntry
+	(MaskingLevel)(0),                                   // 14: bytebase.store.MaskingLevel
+	(Engine)(0),                                         // 15: bytebase.store.Engine
+	(*expr.Expr)(nil),                                   // 16: google.type.Expr
 }
 var file_store_policy_proto_depIdxs = []int32{
 	4,  // 0: bytebase.store.MaskingPolicy.mask_data:type_name -> bytebase.store.MaskData
-	13, // 1: bytebase.store.MaskData.masking_level:type_name -> bytebase.store.MaskingLevel
-	10, // 2: bytebase.store.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException
-	11, // 3: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
+	14, // 1: bytebase.store.MaskData.masking_level:type_name -> bytebase.store.MaskingLevel
+	11, // 2: bytebase.store.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException
+	12, // 3: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
 	8,  // 4: bytebase.store.SQLReviewPolicy.rule_list:type_name -> bytebase.store.SQLReviewRule
 	0,  // 5: bytebase.store.SQLReviewRule.level:type_name -> bytebase.store.SQLReviewRuleLevel
-	14, // 6: bytebase.store.SQLReviewRule.engine:type_name -> bytebase.store.Engine
-	12, // 7: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
+	15, // 6: bytebase.store.SQLReviewRule.engine:type_name -> bytebase.store.Engine
+	13, // 7: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
 	1,  // 8: bytebase.store.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException.Action
-	13, // 9: bytebase.store.MaskingExceptionPolicy.MaskingException.masking_level:type_name -> bytebase.store.MaskingLevel
-	15, // 10: bytebase.store.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
-	15, // 11: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
-	13, // 12: bytebase.store.MaskingRulePolicy.MaskingRule.masking_level:type_name -> bytebase.store.MaskingLevel
+	14, // 9: bytebase.store.MaskingExceptionPolicy.MaskingException.masking_level:type_name -> bytebase.store.MaskingLevel
+	16, // 10: bytebase.store.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
+	16, // 11: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
+	14, // 12: bytebase.store.MaskingRulePolicy.MaskingRule.masking_level:type_name -> bytebase.store.MaskingLevel
 	13, // [13:13] is the sub-list for method output_type
 	13, // [13:13] is the sub-list for method input_type
 	13, // [13:13] is the sub-list for extension type_name
@@ -1023,7 +1157,7 @@ func file_store_policy_proto_init() {
 			}
 		}
 		file_store_policy_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*MaskingExceptionPolicy_MaskingException); i {
+			switch v := v.(*QueryGuardPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1035,6 +1169,18 @@ func file_store_policy_proto_init() {
 			}
 		}
 		file_store_policy_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*MaskingExceptionPolicy_MaskingException); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_policy_proto_msgTypes[10].Exporter = func(v any, i int) any {
 			switch v := v.(*MaskingRulePolicy_MaskingRule); i {
 			case 0:
 				return &v.state
@@ -1053,7 +1199,7 @@ func file_store_policy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_policy_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
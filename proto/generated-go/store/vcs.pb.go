@@ -20,6 +20,117 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VCSConnector_FileLayout int32
+
+const (
+	VCSConnector_FILE_LAYOUT_UNSPECIFIED VCSConnector_FileLayout = 0
+	// Bytebase layout, e.g. 0001_ddl_create_table.sql.
+	VCSConnector_BYTEBASE VCSConnector_FileLayout = 1
+	// Flyway layout, e.g. V1.2__create_table.sql for versioned and R__create_view.sql for repeatable migrations.
+	VCSConnector_FLYWAY VCSConnector_FileLayout = 2
+	// Liquibase changelog layout. The sql changes of the changeSets in XML, YAML, JSON and formatted SQL changelogs are applied.
+	VCSConnector_LIQUIBASE VCSConnector_FileLayout = 3
+	// Declarative schema layout. All SQL files under the base directory together hold the desired full schema of the databases,
+	// the DDL is computed against the current database schema. Pull requests get a preview of the DDL as a comment.
+	VCSConnector_SDL VCSConnector_FileLayout = 4
+)
+
+// Enum value maps for VCSConnector_FileLayout.
+var (
+	VCSConnector_FileLayout_name = map[int32]string{
+		0: "FILE_LAYOUT_UNSPECIFIED",
+		1: "BYTEBASE",
+		2: "FLYWAY",
+		3: "LIQUIBASE",
+		4: "SDL",
+	}
+	VCSConnector_FileLayout_value = map[string]int32{
+		"FILE_LAYOUT_UNSPECIFIED": 0,
+		"BYTEBASE":                1,
+		"FLYWAY":                  2,
+		"LIQUIBASE":               3,
+		"SDL":                     4,
+	}
+)
+
+func (x VCSConnector_FileLayout) Enum() *VCSConnector_FileLayout {
+	p := new(VCSConnector_FileLayout)
+	*p = x
+	return p
+}
+
+func (x VCSConnector_FileLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VCSConnector_FileLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_vcs_proto_enumTypes[0].Descriptor()
+}
+
+func (VCSConnector_FileLayout) Type() protoreflect.EnumType {
+	return &file_store_vcs_proto_enumTypes[0]
+}
+
+func (x VCSConnector_FileLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VCSConnector_FileLayout.Descriptor instead.
+func (VCSConnector_FileLayout) EnumDescriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type VCSConnector_SchemaWriteBack_Mode int32
+
+const (
+	VCSConnector_SchemaWriteBack_MODE_UNSPECIFIED VCSConnector_SchemaWriteBack_Mode = 0
+	// Commit the schema files to the branch directly.
+	VCSConnector_SchemaWriteBack_COMMIT VCSConnector_SchemaWriteBack_Mode = 1
+	// Open a pull request against the branch with the schema files.
+	VCSConnector_SchemaWriteBack_PULL_REQUEST VCSConnector_SchemaWriteBack_Mode = 2
+)
+
+// Enum value maps for VCSConnector_SchemaWriteBack_Mode.
+var (
+	VCSConnector_SchemaWriteBack_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "COMMIT",
+		2: "PULL_REQUEST",
+	}
+	VCSConnector_SchemaWriteBack_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"COMMIT":           1,
+		"PULL_REQUEST":     2,
+	}
+)
+
+func (x VCSConnector_SchemaWriteBack_Mode) Enum() *VCSConnector_SchemaWriteBack_Mode {
+	p := new(VCSConnector_SchemaWriteBack_Mode)
+	*p = x
+	return p
+}
+
+func (x VCSConnector_SchemaWriteBack_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VCSConnector_SchemaWriteBack_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_vcs_proto_enumTypes[1].Descriptor()
+}
+
+func (VCSConnector_SchemaWriteBack_Mode) Type() protoreflect.EnumType {
+	return &file_store_vcs_proto_enumTypes[1]
+}
+
+func (x VCSConnector_SchemaWriteBack_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VCSConnector_SchemaWriteBack_Mode.Descriptor instead.
+func (VCSConnector_SchemaWriteBack_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
 type VCSConnector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -48,6 +159,12 @@ type VCSConnector struct {
 	// Apply changes to the database group. Optional, if not set, will apply changes to all databases in the project.
 	// Format: projects/{project}/databaseGroups/{databaseGroup}
 	DatabaseGroup string `protobuf:"bytes,9,opt,name=database_group,json=databaseGroup,proto3" json:"database_group,omitempty"`
+	// The layout of the migration files. Bytebase layout is used if unspecified.
+	FileLayout VCSConnector_FileLayout `protobuf:"varint,10,opt,name=file_layout,json=fileLayout,proto3,enum=bytebase.store.VCSConnector_FileLayout" json:"file_layout,omitempty"`
+	// Observe the migration files in the subdirectories of the base directory as well.
+	IncludeSubdirectories bool `protobuf:"varint,11,opt,name=include_subdirectories,json=includeSubdirectories,proto3" json:"include_subdirectories,omitempty"`
+	// Write the latest schema of the changed databases back to the repository after the rollout.
+	SchemaWriteBack *VCSConnector_SchemaWriteBack `protobuf:"bytes,12,opt,name=schema_write_back,json=schemaWriteBack,proto3" json:"schema_write_back,omitempty"`
 }
 
 func (x *VCSConnector) Reset() {
@@ -145,12 +262,102 @@ func (x *VCSConnector) GetDatabaseGroup() string {
 	return ""
 }
 
+func (x *VCSConnector) GetFileLayout() VCSConnector_FileLayout {
+	if x != nil {
+		return x.FileLayout
+	}
+	return VCSConnector_FILE_LAYOUT_UNSPECIFIED
+}
+
+func (x *VCSConnector) GetIncludeSubdirectories() bool {
+	if x != nil {
+		return x.IncludeSubdirectories
+	}
+	return false
+}
+
+func (x *VCSConnector) GetSchemaWriteBack() *VCSConnector_SchemaWriteBack {
+	if x != nil {
+		return x.SchemaWriteBack
+	}
+	return nil
+}
+
+type VCSConnector_SchemaWriteBack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The write-back is disabled if unspecified.
+	Mode VCSConnector_SchemaWriteBack_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=bytebase.store.VCSConnector_SchemaWriteBack_Mode" json:"mode,omitempty"`
+	// The path template of the schema file relative to the repository root.
+	// Supported placeholders are {{ENV_ID}} and {{DB_NAME}}, e.g. schema/{{ENV_ID}}/{{DB_NAME}}/LATEST.sql.
+	FilePathTemplate string `protobuf:"bytes,2,opt,name=file_path_template,json=filePathTemplate,proto3" json:"file_path_template,omitempty"`
+	// Write one schema file per object, e.g. table, view, function and sequence, under the directory of the file path instead of the single file.
+	// The files are placed in <schema>/<object type>/<name>.sql, and the stale SQL files of the dropped objects in the directory are deleted.
+	// The directory should contain {{DB_NAME}}.
+	PerObject bool `protobuf:"varint,3,opt,name=per_object,json=perObject,proto3" json:"per_object,omitempty"`
+}
+
+func (x *VCSConnector_SchemaWriteBack) Reset() {
+	*x = VCSConnector_SchemaWriteBack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_vcs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VCSConnector_SchemaWriteBack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VCSConnector_SchemaWriteBack) ProtoMessage() {}
+
+func (x *VCSConnector_SchemaWriteBack) ProtoReflect() protoreflect.Message {
+	mi := &file_store_vcs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VCSConnector_SchemaWriteBack.ProtoReflect.Descriptor instead.
+func (*VCSConnector_SchemaWriteBack) Descriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetMode() VCSConnector_SchemaWriteBack_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return VCSConnector_SchemaWriteBack_MODE_UNSPECIFIED
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetFilePathTemplate() string {
+	if x != nil {
+		return x.FilePathTemplate
+	}
+	return ""
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetPerObject() bool {
+	if x != nil {
+		return x.PerObject
+	}
+	return false
+}
+
 var File_store_vcs_proto protoreflect.FileDescriptor
 
 var file_store_vcs_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x22, 0xc3, 0x02, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x22, 0xdf, 0x06, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
 	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
@@ -170,9 +377,43 @@ var file_store_vcs_proto_rawDesc = []byte{
 	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43,
+	0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x35, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75, 0x62, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x1a, 0xe1, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x45, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3a, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x22, 0x5b, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4c, 0x41, 0x59,
+	0x4f, 0x55, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x59, 0x54, 0x45, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x4c, 0x59, 0x57, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c,
+	0x49, 0x51, 0x55, 0x49, 0x42, 0x41, 0x53, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x44,
+	0x4c, 0x10, 0x04, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -187,16 +428,23 @@ func file_store_vcs_proto_rawDescGZIP() []byte {
 	return file_store_vcs_proto_rawDescData
 }
 
-var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_vcs_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_vcs_proto_goTypes = []any{
-	(*VCSConnector)(nil), // 0: bytebase.store.VCSConnector
+	(VCSConnector_FileLayout)(0),           // 0: bytebase.store.VCSConnector.FileLayout
+	(VCSConnector_SchemaWriteBack_Mode)(0), // 1: bytebase.store.VCSConnector.SchemaWriteBack.Mode
+	(*VCSConnector)(nil),                   // 2: bytebase.store.VCSConnector
+	(*VCSConnector_SchemaWriteBack)(nil),   // 3: bytebase.store.VCSConnector.SchemaWriteBack
 }
 var file_store_vcs_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: bytebase.store.VCSConnector.file_layout:type_name -> bytebase.store.VCSConnector.FileLayout
+	3, // 1: bytebase.store.VCSConnector.schema_write_back:type_name -> bytebase.store.VCSConnector.SchemaWriteBack
+	1, // 2: bytebase.store.VCSConnector.SchemaWriteBack.mode:type_name -> bytebase.store.VCSConnector.SchemaWriteBack.Mode
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_vcs_proto_init() }
@@ -217,19 +465,32 @@ func file_store_vcs_proto_init() {
 				return nil
 			}
 		}
+		file_store_vcs_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VCSConnector_SchemaWriteBack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_vcs_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_vcs_proto_goTypes,
 		DependencyIndexes: file_store_vcs_proto_depIdxs,
+		EnumInfos:         file_store_vcs_proto_enumTypes,
 		MessageInfos:      file_store_vcs_proto_msgTypes,
 	}.Build()
 	File_store_vcs_proto = out.File
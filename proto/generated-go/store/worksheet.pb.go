@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: store/worksheet.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorksheetParameter_Type int32
+
+const (
+	WorksheetParameter_TYPE_UNSPECIFIED WorksheetParameter_Type = 0
+	WorksheetParameter_STRING           WorksheetParameter_Type = 1
+	WorksheetParameter_INT              WorksheetParameter_Type = 2
+	// The date in the format of YYYY-MM-DD.
+	WorksheetParameter_DATE WorksheetParameter_Type = 3
+	WorksheetParameter_ENUM WorksheetParameter_Type = 4
+)
+
+// Enum value maps for WorksheetParameter_Type.
+var (
+	WorksheetParameter_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "STRING",
+		2: "INT",
+		3: "DATE",
+		4: "ENUM",
+	}
+	WorksheetParameter_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"STRING":           1,
+		"INT":              2,
+		"DATE":             3,
+		"ENUM":             4,
+	}
+)
+
+func (x WorksheetParameter_Type) Enum() *WorksheetParameter_Type {
+	p := new(WorksheetParameter_Type)
+	*p = x
+	return p
+}
+
+func (x WorksheetParameter_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorksheetParameter_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_worksheet_proto_enumTypes[0].Descriptor()
+}
+
+func (WorksheetParameter_Type) Type() protoreflect.EnumType {
+	return &file_store_worksheet_proto_enumTypes[0]
+}
+
+func (x WorksheetParameter_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorksheetParameter_Type.Descriptor instead.
+func (WorksheetParameter_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_worksheet_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type WorksheetPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parameters referenced as {{name}} in the worksheet statement.
+	Parameters []*WorksheetParameter `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *WorksheetPayload) Reset() {
+	*x = WorksheetPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_worksheet_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorksheetPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorksheetPayload) ProtoMessage() {}
+
+func (x *WorksheetPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_worksheet_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorksheetPayload.ProtoReflect.Descriptor instead.
+func (*WorksheetPayload) Descriptor() ([]byte, []int) {
+	return file_store_worksheet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorksheetPayload) GetParameters() []*WorksheetParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type WorksheetParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the parameter, referenced as {{name}} in the statement.
+	Name        string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        WorksheetParameter_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.WorksheetParameter_Type" json:"type,omitempty"`
+	Description string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The default value is used if the value is not provided when running the worksheet.
+	// The parameter is required if the default value is empty.
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// The regular expression that the STRING value must fully match.
+	Pattern string `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// The inclusive range of the INT value.
+	Minimum *int64 `protobuf:"varint,6,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Maximum *int64 `protobuf:"varint,7,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	// The allowed values of the ENUM value.
+	AllowedValues []string `protobuf:"bytes,8,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+}
+
+func (x *WorksheetParameter) Reset() {
+	*x = WorksheetParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_worksheet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorksheetParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorksheetParameter) ProtoMessage() {}
+
+func (x *WorksheetParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_worksheet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorksheetParameter.ProtoReflect.Descriptor instead.
+func (*WorksheetParameter) Descriptor() ([]byte, []int) {
+	return file_store_worksheet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorksheetParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetType() WorksheetParameter_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WorksheetParameter_TYPE_UNSPECIFIED
+}
+
+func (x *WorksheetParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetMinimum() int64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return 0
+}
+
+func (x *WorksheetParameter) GetMaximum() int64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return 0
+}
+
+func (x *WorksheetParameter) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+var File_store_worksheet_proto protoreflect.FileDescriptor
+
+var file_store_worksheet_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x56, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x8a, 0x03, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x45, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e,
+	0x55, 0x4d, 0x10, 0x04, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x5a, 0x12,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_worksheet_proto_rawDescOnce sync.Once
+	file_store_worksheet_proto_rawDescData = file_store_worksheet_proto_rawDesc
+)
+
+func file_store_worksheet_proto_rawDescGZIP() []byte {
+	file_store_worksheet_proto_rawDescOnce.Do(func() {
+		file_store_worksheet_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_worksheet_proto_rawDescData)
+	})
+	return file_store_worksheet_proto_rawDescData
+}
+
+var file_store_worksheet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_worksheet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_worksheet_proto_goTypes = []any{
+	(WorksheetParameter_Type)(0), // 0: bytebase.store.WorksheetParameter.Type
+	(*WorksheetPayload)(nil),     // 1: bytebase.store.WorksheetPayload
+	(*WorksheetParameter)(nil),   // 2: bytebase.store.WorksheetParameter
+}
+var file_store_worksheet_proto_depIdxs = []int32{
+	2, // 0: bytebase.store.WorksheetPayload.parameters:type_name -> bytebase.store.WorksheetParameter
+	0, // 1: bytebase.store.WorksheetParameter.type:type_name -> bytebase.store.WorksheetParameter.Type
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_store_worksheet_proto_init() }
+func file_store_worksheet_proto_init() {
+	if File_store_worksheet_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_worksheet_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*WorksheetPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_worksheet_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*WorksheetParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_store_worksheet_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_worksheet_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_worksheet_proto_goTypes,
+		DependencyIndexes: file_store_worksheet_proto_depIdxs,
+		EnumInfos:         file_store_worksheet_proto_enumTypes,
+		MessageInfos:      file_store_worksheet_proto_msgTypes,
+	}.Build()
+	File_store_worksheet_proto = out.File
+	file_store_worksheet_proto_rawDesc = nil
+	file_store_worksheet_proto_goTypes = nil
+	file_store_worksheet_proto_depIdxs = nil
+}
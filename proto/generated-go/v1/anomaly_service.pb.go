@@ -34,6 +34,8 @@ const (
 	Anomaly_INSTANCE_CONNECTION Anomaly_AnomalyType = 1
 	// MIGRATION_SCHEMA is the anomaly type for migration schema, e.g. the migration schema in the instance is missing.
 	Anomaly_MIGRATION_SCHEMA Anomaly_AnomalyType = 2
+	// INSTANCE_REPLICATION_LAG is the anomaly type for replication lag, e.g. the replica behind a read-only data source lags behind the primary.
+	Anomaly_INSTANCE_REPLICATION_LAG Anomaly_AnomalyType = 11
 	// Database level anomaly.
 	//
 	// DATABASE_CONNECTION is the anomaly type for database connection, e.g. the database had been deleted.
@@ -41,23 +43,41 @@ const (
 	// DATABASE_SCHEMA_DRIFT is the anomaly type for database schema drift,
 	// e.g. the database schema had been changed without bytebase migration.
 	Anomaly_DATABASE_SCHEMA_DRIFT Anomaly_AnomalyType = 6
+	// DATABASE_TABLE_WITHOUT_PRIMARY_KEY is the anomaly type for tables without a primary key.
+	Anomaly_DATABASE_TABLE_WITHOUT_PRIMARY_KEY Anomaly_AnomalyType = 12
+	// DATABASE_UNUSED_INDEX is the anomaly type for indexes that have never been scanned since the statistics were reset.
+	Anomaly_DATABASE_UNUSED_INDEX Anomaly_AnomalyType = 13
+	// DATABASE_DUPLICATE_INDEX is the anomaly type for indexes that are covered by another index on the same table.
+	Anomaly_DATABASE_DUPLICATE_INDEX Anomaly_AnomalyType = 14
+	// DATABASE_AUTO_INCREMENT_EXHAUSTION is the anomaly type for auto-increment columns and sequences nearing their maximum value.
+	Anomaly_DATABASE_AUTO_INCREMENT_EXHAUSTION Anomaly_AnomalyType = 15
 )
 
 // Enum value maps for Anomaly_AnomalyType.
 var (
 	Anomaly_AnomalyType_name = map[int32]string{
-		0: "ANOMALY_TYPE_UNSPECIFIED",
-		1: "INSTANCE_CONNECTION",
-		2: "MIGRATION_SCHEMA",
-		5: "DATABASE_CONNECTION",
-		6: "DATABASE_SCHEMA_DRIFT",
+		0:  "ANOMALY_TYPE_UNSPECIFIED",
+		1:  "INSTANCE_CONNECTION",
+		2:  "MIGRATION_SCHEMA",
+		11: "INSTANCE_REPLICATION_LAG",
+		5:  "DATABASE_CONNECTION",
+		6:  "DATABASE_SCHEMA_DRIFT",
+		12: "DATABASE_TABLE_WITHOUT_PRIMARY_KEY",
+		13: "DATABASE_UNUSED_INDEX",
+		14: "DATABASE_DUPLICATE_INDEX",
+		15: "DATABASE_AUTO_INCREMENT_EXHAUSTION",
 	}
 	Anomaly_AnomalyType_value = map[string]int32{
-		"ANOMALY_TYPE_UNSPECIFIED": 0,
-		"INSTANCE_CONNECTION":      1,
-		"MIGRATION_SCHEMA":         2,
-		"DATABASE_CONNECTION":      5,
-		"DATABASE_SCHEMA_DRIFT":    6,
+		"ANOMALY_TYPE_UNSPECIFIED":           0,
+		"INSTANCE_CONNECTION":                1,
+		"MIGRATION_SCHEMA":                   2,
+		"INSTANCE_REPLICATION_LAG":           11,
+		"DATABASE_CONNECTION":                5,
+		"DATABASE_SCHEMA_DRIFT":              6,
+		"DATABASE_TABLE_WITHOUT_PRIMARY_KEY": 12,
+		"DATABASE_UNUSED_INDEX":              13,
+		"DATABASE_DUPLICATE_INDEX":           14,
+		"DATABASE_AUTO_INCREMENT_EXHAUSTION": 15,
 	}
 )
 
@@ -302,6 +322,10 @@ type Anomaly struct {
 	//	*Anomaly_InstanceConnectionDetail_
 	//	*Anomaly_DatabaseConnectionDetail_
 	//	*Anomaly_DatabaseSchemaDriftDetail_
+	//	*Anomaly_InstanceReplicationLagDetail_
+	//	*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_
+	//	*Anomaly_DatabaseIndexDetail_
+	//	*Anomaly_DatabaseAutoIncrementExhaustionDetail_
 	Detail     isAnomaly_Detail       `protobuf_oneof:"detail"`
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
@@ -388,6 +412,34 @@ func (x *Anomaly) GetDatabaseSchemaDriftDetail() *Anomaly_DatabaseSchemaDriftDet
 	return nil
 }
 
+func (x *Anomaly) GetInstanceReplicationLagDetail() *Anomaly_InstanceReplicationLagDetail {
+	if x, ok := x.GetDetail().(*Anomaly_InstanceReplicationLagDetail_); ok {
+		return x.InstanceReplicationLagDetail
+	}
+	return nil
+}
+
+func (x *Anomaly) GetDatabaseTableWithoutPrimaryKeyDetail() *Anomaly_DatabaseTableWithoutPrimaryKeyDetail {
+	if x, ok := x.GetDetail().(*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_); ok {
+		return x.DatabaseTableWithoutPrimaryKeyDetail
+	}
+	return nil
+}
+
+func (x *Anomaly) GetDatabaseIndexDetail() *Anomaly_DatabaseIndexDetail {
+	if x, ok := x.GetDetail().(*Anomaly_DatabaseIndexDetail_); ok {
+		return x.DatabaseIndexDetail
+	}
+	return nil
+}
+
+func (x *Anomaly) GetDatabaseAutoIncrementExhaustionDetail() *Anomaly_DatabaseAutoIncrementExhaustionDetail {
+	if x, ok := x.GetDetail().(*Anomaly_DatabaseAutoIncrementExhaustionDetail_); ok {
+		return x.DatabaseAutoIncrementExhaustionDetail
+	}
+	return nil
+}
+
 func (x *Anomaly) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateTime
@@ -418,12 +470,36 @@ type Anomaly_DatabaseSchemaDriftDetail_ struct {
 	DatabaseSchemaDriftDetail *Anomaly_DatabaseSchemaDriftDetail `protobuf:"bytes,8,opt,name=database_schema_drift_detail,json=databaseSchemaDriftDetail,proto3,oneof"`
 }
 
+type Anomaly_InstanceReplicationLagDetail_ struct {
+	InstanceReplicationLagDetail *Anomaly_InstanceReplicationLagDetail `protobuf:"bytes,11,opt,name=instance_replication_lag_detail,json=instanceReplicationLagDetail,proto3,oneof"`
+}
+
+type Anomaly_DatabaseTableWithoutPrimaryKeyDetail_ struct {
+	DatabaseTableWithoutPrimaryKeyDetail *Anomaly_DatabaseTableWithoutPrimaryKeyDetail `protobuf:"bytes,12,opt,name=database_table_without_primary_key_detail,json=databaseTableWithoutPrimaryKeyDetail,proto3,oneof"`
+}
+
+type Anomaly_DatabaseIndexDetail_ struct {
+	DatabaseIndexDetail *Anomaly_DatabaseIndexDetail `protobuf:"bytes,13,opt,name=database_index_detail,json=databaseIndexDetail,proto3,oneof"`
+}
+
+type Anomaly_DatabaseAutoIncrementExhaustionDetail_ struct {
+	DatabaseAutoIncrementExhaustionDetail *Anomaly_DatabaseAutoIncrementExhaustionDetail `protobuf:"bytes,14,opt,name=database_auto_increment_exhaustion_detail,json=databaseAutoIncrementExhaustionDetail,proto3,oneof"`
+}
+
 func (*Anomaly_InstanceConnectionDetail_) isAnomaly_Detail() {}
 
 func (*Anomaly_DatabaseConnectionDetail_) isAnomaly_Detail() {}
 
 func (*Anomaly_DatabaseSchemaDriftDetail_) isAnomaly_Detail() {}
 
+func (*Anomaly_InstanceReplicationLagDetail_) isAnomaly_Detail() {}
+
+func (*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_) isAnomaly_Detail() {}
+
+func (*Anomaly_DatabaseIndexDetail_) isAnomaly_Detail() {}
+
+func (*Anomaly_DatabaseAutoIncrementExhaustionDetail_) isAnomaly_Detail() {}
+
 // Instance level anomaly detail.
 //
 // InstanceConnectionDetail is the detail for instance connection anomaly.
@@ -475,6 +551,55 @@ func (x *Anomaly_InstanceConnectionDetail) GetDetail() string {
 	return ""
 }
 
+// InstanceReplicationLagDetail is the detail for instance replication lag anomaly.
+type Anomaly_InstanceReplicationLagDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data_source_lags are the lagging read-only data sources.
+	DataSourceLags []*Anomaly_InstanceReplicationLagDetail_DataSourceLag `protobuf:"bytes,1,rep,name=data_source_lags,json=dataSourceLags,proto3" json:"data_source_lags,omitempty"`
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail) Reset() {
+	*x = Anomaly_InstanceReplicationLagDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_InstanceReplicationLagDetail) ProtoMessage() {}
+
+func (x *Anomaly_InstanceReplicationLagDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_InstanceReplicationLagDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_InstanceReplicationLagDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail) GetDataSourceLags() []*Anomaly_InstanceReplicationLagDetail_DataSourceLag {
+	if x != nil {
+		return x.DataSourceLags
+	}
+	return nil
+}
+
 // Database level anomaly detial.
 //
 // DatbaaseConnectionDetail is the detail for database connection anomaly.
@@ -490,7 +615,7 @@ type Anomaly_DatabaseConnectionDetail struct {
 func (x *Anomaly_DatabaseConnectionDetail) Reset() {
 	*x = Anomaly_DatabaseConnectionDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_anomaly_service_proto_msgTypes[4]
+		mi := &file_v1_anomaly_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -503,7 +628,7 @@ func (x *Anomaly_DatabaseConnectionDetail) String() string {
 func (*Anomaly_DatabaseConnectionDetail) ProtoMessage() {}
 
 func (x *Anomaly_DatabaseConnectionDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_anomaly_service_proto_msgTypes[4]
+	mi := &file_v1_anomaly_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +641,7 @@ func (x *Anomaly_DatabaseConnectionDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Anomaly_DatabaseConnectionDetail.ProtoReflect.Descriptor instead.
 func (*Anomaly_DatabaseConnectionDetail) Descriptor() ([]byte, []int) {
-	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 1}
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 2}
 }
 
 func (x *Anomaly_DatabaseConnectionDetail) GetDetail() string {
@@ -543,7 +668,7 @@ type Anomaly_DatabaseSchemaDriftDetail struct {
 func (x *Anomaly_DatabaseSchemaDriftDetail) Reset() {
 	*x = Anomaly_DatabaseSchemaDriftDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_anomaly_service_proto_msgTypes[5]
+		mi := &file_v1_anomaly_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -556,7 +681,7 @@ func (x *Anomaly_DatabaseSchemaDriftDetail) String() string {
 func (*Anomaly_DatabaseSchemaDriftDetail) ProtoMessage() {}
 
 func (x *Anomaly_DatabaseSchemaDriftDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_anomaly_service_proto_msgTypes[5]
+	mi := &file_v1_anomaly_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +694,7 @@ func (x *Anomaly_DatabaseSchemaDriftDetail) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Anomaly_DatabaseSchemaDriftDetail.ProtoReflect.Descriptor instead.
 func (*Anomaly_DatabaseSchemaDriftDetail) Descriptor() ([]byte, []int) {
-	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 2}
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 3}
 }
 
 func (x *Anomaly_DatabaseSchemaDriftDetail) GetRecordVersion() string {
@@ -593,6 +718,427 @@ func (x *Anomaly_DatabaseSchemaDriftDetail) GetActualSchema() string {
 	return ""
 }
 
+// DatabaseTableWithoutPrimaryKeyDetail is the detail for database table without primary key anomaly.
+type Anomaly_DatabaseTableWithoutPrimaryKeyDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tables are the tables without a primary key.
+	Tables []*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail) Reset() {
+	*x = Anomaly_DatabaseTableWithoutPrimaryKeyDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseTableWithoutPrimaryKeyDetail) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseTableWithoutPrimaryKeyDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseTableWithoutPrimaryKeyDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail) GetTables() []*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// DatabaseIndexDetail is the detail for database unused and duplicate index anomalies.
+type Anomaly_DatabaseIndexDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// indexes are the unused or duplicate indexes.
+	Indexes []*Anomaly_DatabaseIndexDetail_Index `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (x *Anomaly_DatabaseIndexDetail) Reset() {
+	*x = Anomaly_DatabaseIndexDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseIndexDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseIndexDetail) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseIndexDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseIndexDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseIndexDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Anomaly_DatabaseIndexDetail) GetIndexes() []*Anomaly_DatabaseIndexDetail_Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// DatabaseAutoIncrementExhaustionDetail is the detail for database auto-increment exhaustion anomaly.
+type Anomaly_DatabaseAutoIncrementExhaustionDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// columns are the auto-increment columns and sequences nearing their maximum value.
+	Columns []*Anomaly_DatabaseAutoIncrementExhaustionDetail_Column `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail) Reset() {
+	*x = Anomaly_DatabaseAutoIncrementExhaustionDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseAutoIncrementExhaustionDetail) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseAutoIncrementExhaustionDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseAutoIncrementExhaustionDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail) GetColumns() []*Anomaly_DatabaseAutoIncrementExhaustionDetail_Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type Anomaly_InstanceReplicationLagDetail_DataSourceLag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data_source_id is the id of the read-only data source.
+	DataSourceId string `protobuf:"bytes,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// lag_seconds is the replication lag in seconds, it's -1 if the replication is not running.
+	LagSeconds int64 `protobuf:"varint,2,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail_DataSourceLag) Reset() {
+	*x = Anomaly_InstanceReplicationLagDetail_DataSourceLag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail_DataSourceLag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_InstanceReplicationLagDetail_DataSourceLag) ProtoMessage() {}
+
+func (x *Anomaly_InstanceReplicationLagDetail_DataSourceLag) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_InstanceReplicationLagDetail_DataSourceLag.ProtoReflect.Descriptor instead.
+func (*Anomaly_InstanceReplicationLagDetail_DataSourceLag) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail_DataSourceLag) GetDataSourceId() string {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceReplicationLagDetail_DataSourceLag) GetLagSeconds() int64 {
+	if x != nil {
+		return x.LagSeconds
+	}
+	return 0
+}
+
+type Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) Reset() {
+	*x = Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 4, 0}
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+type Anomaly_DatabaseIndexDetail_Index struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Index  string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// covering_index is the index covering the duplicate index, it's empty for unused indexes.
+	CoveringIndex string `protobuf:"bytes,4,opt,name=covering_index,json=coveringIndex,proto3" json:"covering_index,omitempty"`
+}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) Reset() {
+	*x = Anomaly_DatabaseIndexDetail_Index{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseIndexDetail_Index) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseIndexDetail_Index.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseIndexDetail_Index) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 5, 0}
+}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseIndexDetail_Index) GetCoveringIndex() string {
+	if x != nil {
+		return x.CoveringIndex
+	}
+	return ""
+}
+
+type Anomaly_DatabaseAutoIncrementExhaustionDetail_Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Column string `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	// sequence is the sequence name for engines using sequences, e.g. PostgreSQL.
+	Sequence string `protobuf:"bytes,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// current_value is the next value to be generated.
+	CurrentValue uint64 `protobuf:"varint,5,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	// maximum_value is the maximum value of the column or sequence.
+	MaximumValue uint64 `protobuf:"varint,6,opt,name=maximum_value,json=maximumValue,proto3" json:"maximum_value,omitempty"`
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) Reset() {
+	*x = Anomaly_DatabaseAutoIncrementExhaustionDetail_Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseAutoIncrementExhaustionDetail_Column.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 6, 0}
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) GetSequence() string {
+	if x != nil {
+		return x.Sequence
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) GetCurrentValue() uint64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *Anomaly_DatabaseAutoIncrementExhaustionDetail_Column) GetMaximumValue() uint64 {
+	if x != nil {
+		return x.MaximumValue
+	}
+	return 0
+}
+
 var File_v1_anomaly_service_proto protoreflect.FileDescriptor
 
 var file_v1_anomaly_service_proto_rawDesc = []byte{
@@ -618,7 +1164,7 @@ var file_v1_anomaly_service_proto_rawDesc = []byte{
 	0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61,
 	0x6c, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
 	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe9, 0x08, 0x0a,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcd, 0x15, 0x0a,
 	0x07, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
 	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79,
@@ -650,18 +1196,65 @@ var file_v1_anomaly_service_proto_rawDesc = []byte{
 	0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74,
 	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x19, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
 	0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x32, 0x0a, 0x18, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x32, 0x0a,
+	0x61, 0x69, 0x6c, 0x12, 0x7a, 0x0a, 0x1f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61,
+	0x6c, 0x79, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48,
+	0x00, 0x52, 0x1c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x94, 0x01, 0x0a, 0x29, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00,
+	0x52, 0x24, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x5e, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48,
+	0x00, 0x52, 0x13, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x96, 0x01, 0x0a, 0x29, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x25, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x32, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0xe1, 0x01, 0x0a, 0x1c, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x69, 0x0a, 0x10, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61,
+	0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4c, 0x61, 0x67, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4c, 0x61, 0x67, 0x73, 0x1a, 0x56, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x32, 0x0a,
 	0x18, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74,
 	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
@@ -674,33 +1267,88 @@ var file_v1_anomaly_service_proto_rawDesc = []byte{
 	0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
 	0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43,
-	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d,
-	0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10,
-	0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f,
-	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41,
-	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x52,
-	0x49, 0x46, 0x54, 0x10, 0x06, 0x22, 0x57, 0x0a, 0x0f, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
-	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x4e, 0x4f, 0x4d,
-	0x41, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45,
-	0x44, 0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02,
-	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x42, 0x08,
-	0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x32, 0x8c, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x6f,
-	0x6d, 0x61, 0x6c, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x0f, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x23,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73,
-	0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x68, 0x65, 0x6d, 0x61, 0x1a, 0xb6, 0x01, 0x0a, 0x24, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x57, 0x0a,
+	0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b,
+	0x65, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x35, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0xd3, 0x01,
+	0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x48, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x1a,
+	0x72, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x1a, 0xbb, 0x02, 0x0a, 0x25, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x68,
+	0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x5b, 0x0a,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74,
+	0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x1a, 0xb4, 0x01, 0x0a, 0x06, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xb5, 0x02, 0x0a, 0x0b, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x47, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x41, 0x47, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53,
+	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x10, 0x06,
+	0x12, 0x26, 0x0a, 0x22, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x54, 0x41, 0x42,
+	0x4c, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41,
+	0x52, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x0c, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41,
+	0x42, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x44, 0x45,
+	0x58, 0x10, 0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10,
+	0x0e, 0x12, 0x26, 0x0a, 0x22, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x41, 0x55,
+	0x54, 0x4f, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x48,
+	0x41, 0x55, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0f, 0x22, 0x57, 0x0a, 0x0f, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c,
+	0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49,
+	0x47, 0x48, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c,
+	0x10, 0x03, 0x42, 0x08, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x32, 0x8c, 0x01, 0x0a,
+	0x0e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x7a, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6f, 0x6d, 0x61,
+	0x6c, 0x69, 0x65, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x11, 0x5a, 0x0f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -716,34 +1364,50 @@ func file_v1_anomaly_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_anomaly_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_anomaly_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_v1_anomaly_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_v1_anomaly_service_proto_goTypes = []any{
-	(Anomaly_AnomalyType)(0),                  // 0: bytebase.v1.Anomaly.AnomalyType
-	(Anomaly_AnomalySeverity)(0),              // 1: bytebase.v1.Anomaly.AnomalySeverity
-	(*SearchAnomaliesRequest)(nil),            // 2: bytebase.v1.SearchAnomaliesRequest
-	(*SearchAnomaliesResponse)(nil),           // 3: bytebase.v1.SearchAnomaliesResponse
-	(*Anomaly)(nil),                           // 4: bytebase.v1.Anomaly
-	(*Anomaly_InstanceConnectionDetail)(nil),  // 5: bytebase.v1.Anomaly.InstanceConnectionDetail
-	(*Anomaly_DatabaseConnectionDetail)(nil),  // 6: bytebase.v1.Anomaly.DatabaseConnectionDetail
-	(*Anomaly_DatabaseSchemaDriftDetail)(nil), // 7: bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
-	(*timestamppb.Timestamp)(nil),             // 8: google.protobuf.Timestamp
+	(Anomaly_AnomalyType)(0),                                     // 0: bytebase.v1.Anomaly.AnomalyType
+	(Anomaly_AnomalySeverity)(0),                                 // 1: bytebase.v1.Anomaly.AnomalySeverity
+	(*SearchAnomaliesRequest)(nil),                               // 2: bytebase.v1.SearchAnomaliesRequest
+	(*SearchAnomaliesResponse)(nil),                              // 3: bytebase.v1.SearchAnomaliesResponse
+	(*Anomaly)(nil),                                              // 4: bytebase.v1.Anomaly
+	(*Anomaly_InstanceConnectionDetail)(nil),                     // 5: bytebase.v1.Anomaly.InstanceConnectionDetail
+	(*Anomaly_InstanceReplicationLagDetail)(nil),                 // 6: bytebase.v1.Anomaly.InstanceReplicationLagDetail
+	(*Anomaly_DatabaseConnectionDetail)(nil),                     // 7: bytebase.v1.Anomaly.DatabaseConnectionDetail
+	(*Anomaly_DatabaseSchemaDriftDetail)(nil),                    // 8: bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
+	(*Anomaly_DatabaseTableWithoutPrimaryKeyDetail)(nil),         // 9: bytebase.v1.Anomaly.DatabaseTableWithoutPrimaryKeyDetail
+	(*Anomaly_DatabaseIndexDetail)(nil),                          // 10: bytebase.v1.Anomaly.DatabaseIndexDetail
+	(*Anomaly_DatabaseAutoIncrementExhaustionDetail)(nil),        // 11: bytebase.v1.Anomaly.DatabaseAutoIncrementExhaustionDetail
+	(*Anomaly_InstanceReplicationLagDetail_DataSourceLag)(nil),   // 12: bytebase.v1.Anomaly.InstanceReplicationLagDetail.DataSourceLag
+	(*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table)(nil),   // 13: bytebase.v1.Anomaly.DatabaseTableWithoutPrimaryKeyDetail.Table
+	(*Anomaly_DatabaseIndexDetail_Index)(nil),                    // 14: bytebase.v1.Anomaly.DatabaseIndexDetail.Index
+	(*Anomaly_DatabaseAutoIncrementExhaustionDetail_Column)(nil), // 15: bytebase.v1.Anomaly.DatabaseAutoIncrementExhaustionDetail.Column
+	(*timestamppb.Timestamp)(nil),                                // 16: google.protobuf.Timestamp
 }
 var file_v1_anomaly_service_proto_depIdxs = []int32{
-	4, // 0: bytebase.v1.SearchAnomaliesResponse.anomalies:type_name -> bytebase.v1.Anomaly
-	0, // 1: bytebase.v1.Anomaly.type:type_name -> bytebase.v1.Anomaly.AnomalyType
-	1, // 2: bytebase.v1.Anomaly.severity:type_name -> bytebase.v1.Anomaly.AnomalySeverity
-	5, // 3: bytebase.v1.Anomaly.instance_connection_detail:type_name -> bytebase.v1.Anomaly.InstanceConnectionDetail
-	6, // 4: bytebase.v1.Anomaly.database_connection_detail:type_name -> bytebase.v1.Anomaly.DatabaseConnectionDetail
-	7, // 5: bytebase.v1.Anomaly.database_schema_drift_detail:type_name -> bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
-	8, // 6: bytebase.v1.Anomaly.create_time:type_name -> google.protobuf.Timestamp
-	8, // 7: bytebase.v1.Anomaly.update_time:type_name -> google.protobuf.Timestamp
-	2, // 8: bytebase.v1.AnomalyService.SearchAnomalies:input_type -> bytebase.v1.SearchAnomaliesRequest
-	3, // 9: bytebase.v1.AnomalyService.SearchAnomalies:output_type -> bytebase.v1.SearchAnomaliesResponse
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4,  // 0: bytebase.v1.SearchAnomaliesResponse.anomalies:type_name -> bytebase.v1.Anomaly
+	0,  // 1: bytebase.v1.Anomaly.type:type_name -> bytebase.v1.Anomaly.AnomalyType
+	1,  // 2: bytebase.v1.Anomaly.severity:type_name -> bytebase.v1.Anomaly.AnomalySeverity
+	5,  // 3: bytebase.v1.Anomaly.instance_connection_detail:type_name -> bytebase.v1.Anomaly.InstanceConnectionDetail
+	7,  // 4: bytebase.v1.Anomaly.database_connection_detail:type_name -> bytebase.v1.Anomaly.DatabaseConnectionDetail
+	8,  // 5: bytebase.v1.Anomaly.database_schema_drift_detail:type_name -> bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
+	6,  // 6: bytebase.v1.Anomaly.instance_replication_lag_detail:type_name -> bytebase.v1.Anomaly.InstanceReplicationLagDetail
+	9,  // 7: bytebase.v1.Anomaly.database_table_without_primary_key_detail:type_name -> bytebase.v1.Anomaly.DatabaseTableWithoutPrimaryKeyDetail
+	10, // 8: bytebase.v1.Anomaly.database_index_detail:type_name -> bytebase.v1.Anomaly.DatabaseIndexDetail
+	11, // 9: bytebase.v1.Anomaly.database_auto_increment_exhaustion_detail:type_name -> bytebase.v1.Anomaly.DatabaseAutoIncrementExhaustionDetail
+	16, // 10: bytebase.v1.Anomaly.create_time:type_name -> google.protobuf.Timestamp
+	16, // 11: bytebase.v1.Anomaly.update_time:type_name -> google.protobuf.Timestamp
+	12, // 12: bytebase.v1.Anomaly.InstanceReplicationLagDetail.data_source_lags:type_name -> bytebase.v1.Anomaly.InstanceReplicationLagDetail.DataSourceLag
+	13, // 13: bytebase.v1.Anomaly.DatabaseTableWithoutPrimaryKeyDetail.tables:type_name -> bytebase.v1.Anomaly.DatabaseTableWithoutPrimaryKeyDetail.Table
+	14, // 14: bytebase.v1.Anomaly.DatabaseIndexDetail.indexes:type_name -> bytebase.v1.Anomaly.DatabaseIndexDetail.Index
+	15, // 15: bytebase.v1.Anomaly.DatabaseAutoIncrementExhaustionDetail.columns:type_name -> bytebase.v1.Anomaly.DatabaseAutoIncrementExhaustionDetail.Column
+	2,  // 16: bytebase.v1.AnomalyService.SearchAnomalies:input_type -> bytebase.v1.SearchAnomaliesRequest
+	3,  // 17: bytebase.v1.AnomalyService.SearchAnomalies:output_type -> bytebase.v1.SearchAnomaliesResponse
+	17, // [17:18] is the sub-list for method output_type
+	16, // [16:17] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_v1_anomaly_service_proto_init() }
@@ -801,7 +1465,7 @@ func file_v1_anomaly_service_proto_init() {
 			}
 		}
 		file_v1_anomaly_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Anomaly_DatabaseConnectionDetail); i {
+			switch v := v.(*Anomaly_InstanceReplicationLagDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -813,6 +1477,18 @@ func file_v1_anomaly_service_proto_init() {
 			}
 		}
 		file_v1_anomaly_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseConnectionDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*Anomaly_DatabaseSchemaDriftDetail); i {
 			case 0:
 				return &v.state
@@ -824,11 +1500,99 @@ func file_v1_anomaly_service_proto_init() {
 				return nil
 			}
 		}
+		file_v1_anomaly_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseTableWithoutPrimaryKeyDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseIndexDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseAutoIncrementExhaustionDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_InstanceReplicationLagDetail_DataSourceLag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_Table); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseIndexDetail_Index); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseAutoIncrementExhaustionDetail_Column); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_v1_anomaly_service_proto_msgTypes[2].OneofWrappers = []any{
 		(*Anomaly_InstanceConnectionDetail_)(nil),
 		(*Anomaly_DatabaseConnectionDetail_)(nil),
 		(*Anomaly_DatabaseSchemaDriftDetail_)(nil),
+		(*Anomaly_InstanceReplicationLagDetail_)(nil),
+		(*Anomaly_DatabaseTableWithoutPrimaryKeyDetail_)(nil),
+		(*Anomaly_DatabaseIndexDetail_)(nil),
+		(*Anomaly_DatabaseAutoIncrementExhaustionDetail_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -836,7 +1600,7 @@ func file_v1_anomaly_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_anomaly_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,7 @@ const (
 	ChangelistService_ListChangelists_FullMethodName  = "/bytebase.v1.ChangelistService/ListChangelists"
 	ChangelistService_UpdateChangelist_FullMethodName = "/bytebase.v1.ChangelistService/UpdateChangelist"
 	ChangelistService_DeleteChangelist_FullMethodName = "/bytebase.v1.ChangelistService/DeleteChangelist"
+	ChangelistService_DryRunChangelist_FullMethodName = "/bytebase.v1.ChangelistService/DryRunChangelist"
 )
 
 // ChangelistServiceClient is the client API for ChangelistService service.
@@ -36,6 +37,11 @@ type ChangelistServiceClient interface {
 	ListChangelists(ctx context.Context, in *ListChangelistsRequest, opts ...grpc.CallOption) (*ListChangelistsResponse, error)
 	UpdateChangelist(ctx context.Context, in *UpdateChangelistRequest, opts ...grpc.CallOption) (*Changelist, error)
 	DeleteChangelist(ctx context.Context, in *DeleteChangelistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DryRunChangelist applies the changes of the changelist in order to an ephemeral database
+	// cloned from the schema of the target database, and reports the result of each change
+	// with the resulting schema diff. The changes are executed as a temporary user that only has
+	// privileges on the ephemeral database. The ephemeral database and the user are dropped afterwards.
+	DryRunChangelist(ctx context.Context, in *DryRunChangelistRequest, opts ...grpc.CallOption) (*DryRunChangelistResponse, error)
 }
 
 type changelistServiceClient struct {
@@ -96,6 +102,16 @@ func (c *changelistServiceClient) DeleteChangelist(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *changelistServiceClient) DryRunChangelist(ctx context.Context, in *DryRunChangelistRequest, opts ...grpc.CallOption) (*DryRunChangelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DryRunChangelistResponse)
+	err := c.cc.Invoke(ctx, ChangelistService_DryRunChangelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChangelistServiceServer is the server API for ChangelistService service.
 // All implementations must embed UnimplementedChangelistServiceServer
 // for forward compatibility
@@ -105,6 +121,11 @@ type ChangelistServiceServer interface {
 	ListChangelists(context.Context, *ListChangelistsRequest) (*ListChangelistsResponse, error)
 	UpdateChangelist(context.Context, *UpdateChangelistRequest) (*Changelist, error)
 	DeleteChangelist(context.Context, *DeleteChangelistRequest) (*emptypb.Empty, error)
+	// DryRunChangelist applies the changes of the changelist in order to an ephemeral database
+	// cloned from the schema of the target database, and reports the result of each change
+	// with the resulting schema diff. The changes are executed as a temporary user that only has
+	// privileges on the ephemeral database. The ephemeral database and the user are dropped afterwards.
+	DryRunChangelist(context.Context, *DryRunChangelistRequest) (*DryRunChangelistResponse, error)
 	mustEmbedUnimplementedChangelistServiceServer()
 }
 
@@ -127,6 +148,9 @@ func (UnimplementedChangelistServiceServer) UpdateChangelist(context.Context, *U
 func (UnimplementedChangelistServiceServer) DeleteChangelist(context.Context, *DeleteChangelistRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChangelist not implemented")
 }
+func (UnimplementedChangelistServiceServer) DryRunChangelist(context.Context, *DryRunChangelistRequest) (*DryRunChangelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunChangelist not implemented")
+}
 func (UnimplementedChangelistServiceServer) mustEmbedUnimplementedChangelistServiceServer() {}
 
 // UnsafeChangelistServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -230,6 +254,24 @@ func _ChangelistService_DeleteChangelist_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChangelistService_DryRunChangelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunChangelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangelistServiceServer).DryRunChangelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChangelistService_DryRunChangelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangelistServiceServer).DryRunChangelist(ctx, req.(*DryRunChangelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChangelistService_ServiceDesc is the grpc.ServiceDesc for ChangelistService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -257,6 +299,10 @@ var ChangelistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChangelist",
 			Handler:    _ChangelistService_DeleteChangelist_Handler,
 		},
+		{
+			MethodName: "DryRunChangelist",
+			Handler:    _ChangelistService_DryRunChangelist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/changelist_service.proto",
@@ -20,23 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	DatabaseService_GetDatabase_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_ListDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_SearchDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/SearchDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_GetDatabaseMetadata_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_UpdateDatabaseMetadata_FullMethodName = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_DiffSchema_FullMethodName             = "/bytebase.v1.DatabaseService/DiffSchema"
-	DatabaseService_ListSlowQueries_FullMethodName        = "/bytebase.v1.DatabaseService/ListSlowQueries"
-	DatabaseService_ListSecrets_FullMethodName            = "/bytebase.v1.DatabaseService/ListSecrets"
-	DatabaseService_UpdateSecret_FullMethodName           = "/bytebase.v1.DatabaseService/UpdateSecret"
-	DatabaseService_DeleteSecret_FullMethodName           = "/bytebase.v1.DatabaseService/DeleteSecret"
-	DatabaseService_AdviseIndex_FullMethodName            = "/bytebase.v1.DatabaseService/AdviseIndex"
-	DatabaseService_ListChangeHistories_FullMethodName    = "/bytebase.v1.DatabaseService/ListChangeHistories"
-	DatabaseService_GetChangeHistory_FullMethodName       = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_GetDatabase_FullMethodName                     = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_ListDatabases_FullMethodName                   = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_SearchDatabases_FullMethodName                 = "/bytebase.v1.DatabaseService/SearchDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName                  = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName            = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName                    = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_GetDatabaseMetadata_FullMethodName             = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_UpdateDatabaseMetadata_FullMethodName          = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
+	DatabaseService_ReviewClassificationSuggestions_FullMethodName = "/bytebase.v1.DatabaseService/ReviewClassificationSuggestions"
+	DatabaseService_GetDatabaseSchema_FullMethodName               = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_DiffSchema_FullMethodName                      = "/bytebase.v1.DatabaseService/DiffSchema"
+	DatabaseService_ResolveSchemaDrift_FullMethodName              = "/bytebase.v1.DatabaseService/ResolveSchemaDrift"
+	DatabaseService_GetDatabaseGrowth_FullMethodName               = "/bytebase.v1.DatabaseService/GetDatabaseGrowth"
+	DatabaseService_ListSlowQueries_FullMethodName                 = "/bytebase.v1.DatabaseService/ListSlowQueries"
+	DatabaseService_ListSecrets_FullMethodName                     = "/bytebase.v1.DatabaseService/ListSecrets"
+	DatabaseService_UpdateSecret_FullMethodName                    = "/bytebase.v1.DatabaseService/UpdateSecret"
+	DatabaseService_DeleteSecret_FullMethodName                    = "/bytebase.v1.DatabaseService/DeleteSecret"
+	DatabaseService_AdviseIndex_FullMethodName                     = "/bytebase.v1.DatabaseService/AdviseIndex"
+	DatabaseService_ListChangeHistories_FullMethodName             = "/bytebase.v1.DatabaseService/ListChangeHistories"
+	DatabaseService_GetChangeHistory_FullMethodName                = "/bytebase.v1.DatabaseService/GetChangeHistory"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -52,8 +55,15 @@ type DatabaseServiceClient interface {
 	SyncDatabase(ctx context.Context, in *SyncDatabaseRequest, opts ...grpc.CallOption) (*SyncDatabaseResponse, error)
 	GetDatabaseMetadata(ctx context.Context, in *GetDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
 	UpdateDatabaseMetadata(ctx context.Context, in *UpdateDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
+	ReviewClassificationSuggestions(ctx context.Context, in *ReviewClassificationSuggestionsRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
 	GetDatabaseSchema(ctx context.Context, in *GetDatabaseSchemaRequest, opts ...grpc.CallOption) (*DatabaseSchema, error)
 	DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error)
+	// ResolveSchemaDrift creates an issue to resolve the schema drift of a database,
+	// either by establishing a new baseline or by reverting the drifted schema.
+	ResolveSchemaDrift(ctx context.Context, in *ResolveSchemaDriftRequest, opts ...grpc.CallOption) (*ResolveSchemaDriftResponse, error)
+	// GetDatabaseGrowth returns the size history of a database and its tables sampled at every schema sync,
+	// together with the growth rates and the forecast of when the size limits will be hit.
+	GetDatabaseGrowth(ctx context.Context, in *GetDatabaseGrowthRequest, opts ...grpc.CallOption) (*DatabaseGrowth, error)
 	ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error)
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*Secret, error)
@@ -151,6 +161,16 @@ func (c *databaseServiceClient) UpdateDatabaseMetadata(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *databaseServiceClient) ReviewClassificationSuggestions(ctx context.Context, in *ReviewClassificationSuggestionsRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatabaseMetadata)
+	err := c.cc.Invoke(ctx, DatabaseService_ReviewClassificationSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) GetDatabaseSchema(ctx context.Context, in *GetDatabaseSchemaRequest, opts ...grpc.CallOption) (*DatabaseSchema, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DatabaseSchema)
@@ -171,6 +191,26 @@ func (c *databaseServiceClient) DiffSchema(ctx context.Context, in *DiffSchemaRe
 	return out, nil
 }
 
+func (c *databaseServiceClient) ResolveSchemaDrift(ctx context.Context, in *ResolveSchemaDriftRequest, opts ...grpc.CallOption) (*ResolveSchemaDriftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveSchemaDriftResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ResolveSchemaDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetDatabaseGrowth(ctx context.Context, in *GetDatabaseGrowthRequest, opts ...grpc.CallOption) (*DatabaseGrowth, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatabaseGrowth)
+	err := c.cc.Invoke(ctx, DatabaseService_GetDatabaseGrowth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSlowQueriesResponse)
@@ -254,8 +294,15 @@ type DatabaseServiceServer interface {
 	SyncDatabase(context.Context, *SyncDatabaseRequest) (*SyncDatabaseResponse, error)
 	GetDatabaseMetadata(context.Context, *GetDatabaseMetadataRequest) (*DatabaseMetadata, error)
 	UpdateDatabaseMetadata(context.Context, *UpdateDatabaseMetadataRequest) (*DatabaseMetadata, error)
+	ReviewClassificationSuggestions(context.Context, *ReviewClassificationSuggestionsRequest) (*DatabaseMetadata, error)
 	GetDatabaseSchema(context.Context, *GetDatabaseSchemaRequest) (*DatabaseSchema, error)
 	DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error)
+	// ResolveSchemaDrift creates an issue to resolve the schema drift of a database,
+	// either by establishing a new baseline or by reverting the drifted schema.
+	ResolveSchemaDrift(context.Context, *ResolveSchemaDriftRequest) (*ResolveSchemaDriftResponse, error)
+	// GetDatabaseGrowth returns the size history of a database and its tables sampled at every schema sync,
+	// together with the growth rates and the forecast of when the size limits will be hit.
+	GetDatabaseGrowth(context.Context, *GetDatabaseGrowthRequest) (*DatabaseGrowth, error)
 	ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error)
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*Secret, error)
@@ -294,12 +341,21 @@ func (UnimplementedDatabaseServiceServer) GetDatabaseMetadata(context.Context, *
 func (UnimplementedDatabaseServiceServer) UpdateDatabaseMetadata(context.Context, *UpdateDatabaseMetadataRequest) (*DatabaseMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDatabaseMetadata not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ReviewClassificationSuggestions(context.Context, *ReviewClassificationSuggestionsRequest) (*DatabaseMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewClassificationSuggestions not implemented")
+}
 func (UnimplementedDatabaseServiceServer) GetDatabaseSchema(context.Context, *GetDatabaseSchemaRequest) (*DatabaseSchema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseSchema not implemented")
 }
 func (UnimplementedDatabaseServiceServer) DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffSchema not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ResolveSchemaDrift(context.Context, *ResolveSchemaDriftRequest) (*ResolveSchemaDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSchemaDrift not implemented")
+}
+func (UnimplementedDatabaseServiceServer) GetDatabaseGrowth(context.Context, *GetDatabaseGrowthRequest) (*DatabaseGrowth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseGrowth not implemented")
+}
 func (UnimplementedDatabaseServiceServer) ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSlowQueries not implemented")
 }
@@ -478,6 +534,24 @@ func _DatabaseService_UpdateDatabaseMetadata_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ReviewClassificationSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewClassificationSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ReviewClassificationSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ReviewClassificationSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ReviewClassificationSuggestions(ctx, req.(*ReviewClassificationSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_GetDatabaseSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDatabaseSchemaRequest)
 	if err := dec(in); err != nil {
@@ -514,6 +588,42 @@ func _DatabaseService_DiffSchema_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ResolveSchemaDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSchemaDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ResolveSchemaDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ResolveSchemaDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ResolveSchemaDrift(ctx, req.(*ResolveSchemaDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_GetDatabaseGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetDatabaseGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetDatabaseGrowth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetDatabaseGrowth(ctx, req.(*GetDatabaseGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_ListSlowQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSlowQueriesRequest)
 	if err := dec(in); err != nil {
@@ -679,6 +789,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDatabaseMetadata",
 			Handler:    _DatabaseService_UpdateDatabaseMetadata_Handler,
 		},
+		{
+			MethodName: "ReviewClassificationSuggestions",
+			Handler:    _DatabaseService_ReviewClassificationSuggestions_Handler,
+		},
 		{
 			MethodName: "GetDatabaseSchema",
 			Handler:    _DatabaseService_GetDatabaseSchema_Handler,
@@ -687,6 +801,14 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiffSchema",
 			Handler:    _DatabaseService_DiffSchema_Handler,
 		},
+		{
+			MethodName: "ResolveSchemaDrift",
+			Handler:    _DatabaseService_ResolveSchemaDrift_Handler,
+		},
+		{
+			MethodName: "GetDatabaseGrowth",
+			Handler:    _DatabaseService_GetDatabaseGrowth_Handler,
+		},
 		{
 			MethodName: "ListSlowQueries",
 			Handler:    _DatabaseService_ListSlowQueries_Handler,
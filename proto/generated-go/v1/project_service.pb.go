@@ -305,6 +305,8 @@ const (
 	Activity_TYPE_NOTIFY_ISSUE_APPROVED Activity_Type = 23
 	// TYPE_NOTIFY_PIPELINE_ROLLOUT represents the pipeline rollout notification.
 	Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT Activity_Type = 24
+	// TYPE_NOTIFY_GRANT_EXPIRING represents the notification before the granted role of the grant request expires.
+	Activity_TYPE_NOTIFY_GRANT_EXPIRING Activity_Type = 25
 	// Issue related activity types.
 	//
 	// TYPE_ISSUE_CREATE represents creating an issue.
@@ -358,6 +360,7 @@ var (
 		0:  "TYPE_UNSPECIFIED",
 		23: "TYPE_NOTIFY_ISSUE_APPROVED",
 		24: "TYPE_NOTIFY_PIPELINE_ROLLOUT",
+		25: "TYPE_NOTIFY_GRANT_EXPIRING",
 		1:  "TYPE_ISSUE_CREATE",
 		2:  "TYPE_ISSUE_COMMENT_CREATE",
 		3:  "TYPE_ISSUE_FIELD_UPDATE",
@@ -382,6 +385,7 @@ var (
 		"TYPE_UNSPECIFIED":                                      0,
 		"TYPE_NOTIFY_ISSUE_APPROVED":                            23,
 		"TYPE_NOTIFY_PIPELINE_ROLLOUT":                          24,
+		"TYPE_NOTIFY_GRANT_EXPIRING":                            25,
 		"TYPE_ISSUE_CREATE":                                     1,
 		"TYPE_ISSUE_COMMENT_CREATE":                             2,
 		"TYPE_ISSUE_FIELD_UPDATE":                               3,
@@ -3307,13 +3311,15 @@ var file_v1_project_service_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
 	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x22, 0xfb, 0x05, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0xee,
-	0x05, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x73, 0x22, 0x9b, 0x06, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0x8e,
+	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f,
 	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a,
 	0x1a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x49, 0x53, 0x53,
 	0x55, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x17, 0x12, 0x20, 0x0a,
 	0x1c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x50, 0x49, 0x50,
 	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x10, 0x18, 0x12,
+	0x1e, 0x0a, 0x1a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x19, 0x12,
 	0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x43, 0x52,
 	0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49,
 	0x53, 0x53, 0x55, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45,
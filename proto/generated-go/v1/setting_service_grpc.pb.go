@@ -22,6 +22,7 @@ const (
 	SettingService_ListSettings_FullMethodName  = "/bytebase.v1.SettingService/ListSettings"
 	SettingService_GetSetting_FullMethodName    = "/bytebase.v1.SettingService/GetSetting"
 	SettingService_UpdateSetting_FullMethodName = "/bytebase.v1.SettingService/UpdateSetting"
+	SettingService_UnmaskValues_FullMethodName  = "/bytebase.v1.SettingService/UnmaskValues"
 )
 
 // SettingServiceClient is the client API for SettingService service.
@@ -31,6 +32,8 @@ type SettingServiceClient interface {
 	ListSettings(ctx context.Context, in *ListSettingsRequest, opts ...grpc.CallOption) (*ListSettingsResponse, error)
 	GetSetting(ctx context.Context, in *GetSettingRequest, opts ...grpc.CallOption) (*Setting, error)
 	UpdateSetting(ctx context.Context, in *UpdateSettingRequest, opts ...grpc.CallOption) (*Setting, error)
+	// UnmaskValues restores the values masked by a reversible masking algorithm with its key.
+	UnmaskValues(ctx context.Context, in *UnmaskValuesRequest, opts ...grpc.CallOption) (*UnmaskValuesResponse, error)
 }
 
 type settingServiceClient struct {
@@ -71,6 +74,16 @@ func (c *settingServiceClient) UpdateSetting(ctx context.Context, in *UpdateSett
 	return out, nil
 }
 
+func (c *settingServiceClient) UnmaskValues(ctx context.Context, in *UnmaskValuesRequest, opts ...grpc.CallOption) (*UnmaskValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmaskValuesResponse)
+	err := c.cc.Invoke(ctx, SettingService_UnmaskValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingServiceServer is the server API for SettingService service.
 // All implementations must embed UnimplementedSettingServiceServer
 // for forward compatibility
@@ -78,6 +91,8 @@ type SettingServiceServer interface {
 	ListSettings(context.Context, *ListSettingsRequest) (*ListSettingsResponse, error)
 	GetSetting(context.Context, *GetSettingRequest) (*Setting, error)
 	UpdateSetting(context.Context, *UpdateSettingRequest) (*Setting, error)
+	// UnmaskValues restores the values masked by a reversible masking algorithm with its key.
+	UnmaskValues(context.Context, *UnmaskValuesRequest) (*UnmaskValuesResponse, error)
 	mustEmbedUnimplementedSettingServiceServer()
 }
 
@@ -94,6 +109,9 @@ func (UnimplementedSettingServiceServer) GetSetting(context.Context, *GetSetting
 func (UnimplementedSettingServiceServer) UpdateSetting(context.Context, *UpdateSettingRequest) (*Setting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetting not implemented")
 }
+func (UnimplementedSettingServiceServer) UnmaskValues(context.Context, *UnmaskValuesRequest) (*UnmaskValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmaskValues not implemented")
+}
 func (UnimplementedSettingServiceServer) mustEmbedUnimplementedSettingServiceServer() {}
 
 // UnsafeSettingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -161,6 +179,24 @@ func _SettingService_UpdateSetting_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingService_UnmaskValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmaskValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).UnmaskValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_UnmaskValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).UnmaskValues(ctx, req.(*UnmaskValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SettingService_ServiceDesc is the grpc.ServiceDesc for SettingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -180,6 +216,10 @@ var SettingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSetting",
 			Handler:    _SettingService_UpdateSetting_Handler,
 		},
+		{
+			MethodName: "UnmaskValues",
+			Handler:    _SettingService_UnmaskValues_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/setting_service.proto",
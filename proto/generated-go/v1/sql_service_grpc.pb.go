@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,17 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	SQLService_Query_FullMethodName                = "/bytebase.v1.SQLService/Query"
-	SQLService_Execute_FullMethodName              = "/bytebase.v1.SQLService/Execute"
-	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
-	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
-	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
-	SQLService_DifferPreview_FullMethodName        = "/bytebase.v1.SQLService/DifferPreview"
-	SQLService_Check_FullMethodName                = "/bytebase.v1.SQLService/Check"
-	SQLService_ParseMyBatisMapper_FullMethodName   = "/bytebase.v1.SQLService/ParseMyBatisMapper"
-	SQLService_Pretty_FullMethodName               = "/bytebase.v1.SQLService/Pretty"
-	SQLService_StringifyMetadata_FullMethodName    = "/bytebase.v1.SQLService/StringifyMetadata"
-	SQLService_GenerateRestoreSQL_FullMethodName   = "/bytebase.v1.SQLService/GenerateRestoreSQL"
+	SQLService_Query_FullMethodName                   = "/bytebase.v1.SQLService/Query"
+	SQLService_FetchNext_FullMethodName               = "/bytebase.v1.SQLService/FetchNext"
+	SQLService_CloseQuerySession_FullMethodName       = "/bytebase.v1.SQLService/CloseQuerySession"
+	SQLService_FederatedQuery_FullMethodName          = "/bytebase.v1.SQLService/FederatedQuery"
+	SQLService_Execute_FullMethodName                 = "/bytebase.v1.SQLService/Execute"
+	SQLService_AdminExecute_FullMethodName            = "/bytebase.v1.SQLService/AdminExecute"
+	SQLService_SearchQueryHistories_FullMethodName    = "/bytebase.v1.SQLService/SearchQueryHistories"
+	SQLService_AggregateQueryHistories_FullMethodName = "/bytebase.v1.SQLService/AggregateQueryHistories"
+	SQLService_Export_FullMethodName                  = "/bytebase.v1.SQLService/Export"
+	SQLService_DifferPreview_FullMethodName           = "/bytebase.v1.SQLService/DifferPreview"
+	SQLService_Check_FullMethodName                   = "/bytebase.v1.SQLService/Check"
+	SQLService_ParseMyBatisMapper_FullMethodName      = "/bytebase.v1.SQLService/ParseMyBatisMapper"
+	SQLService_Pretty_FullMethodName                  = "/bytebase.v1.SQLService/Pretty"
+	SQLService_StringifyMetadata_FullMethodName       = "/bytebase.v1.SQLService/StringifyMetadata"
+	SQLService_GenerateRestoreSQL_FullMethodName      = "/bytebase.v1.SQLService/GenerateRestoreSQL"
 )
 
 // SQLServiceClient is the client API for SQLService service.
@@ -37,9 +42,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SQLServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// FetchNext fetches the next page of the rows from the query session opened by Query with page_size.
+	// The query session is kept in the memory of the server that opened it, so the requests must reach the same server replica.
+	FetchNext(ctx context.Context, in *FetchNextRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// CloseQuerySession closes the query session opened by Query with page_size, e.g. when the user closes the tab.
+	CloseQuerySession(ctx context.Context, in *CloseQuerySessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// FederatedQuery joins and aggregates the tables across instances and databases in an in-process engine.
+	FederatedQuery(ctx context.Context, in *FederatedQueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error)
 	SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error)
+	AggregateQueryHistories(ctx context.Context, in *AggregateQueryHistoriesRequest, opts ...grpc.CallOption) (*AggregateQueryHistoriesResponse, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
@@ -67,6 +80,36 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) FetchNext(ctx context.Context, in *FetchNextRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, SQLService_FetchNext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CloseQuerySession(ctx context.Context, in *CloseQuerySessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_CloseQuerySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) FederatedQuery(ctx context.Context, in *FederatedQueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, SQLService_FederatedQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteResponse)
@@ -119,6 +162,16 @@ func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, in *SearchQ
 	return out, nil
 }
 
+func (c *sQLServiceClient) AggregateQueryHistories(ctx context.Context, in *AggregateQueryHistoriesRequest, opts ...grpc.CallOption) (*AggregateQueryHistoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateQueryHistoriesResponse)
+	err := c.cc.Invoke(ctx, SQLService_AggregateQueryHistories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportResponse)
@@ -194,9 +247,17 @@ func (c *sQLServiceClient) GenerateRestoreSQL(ctx context.Context, in *GenerateR
 // for forward compatibility
 type SQLServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// FetchNext fetches the next page of the rows from the query session opened by Query with page_size.
+	// The query session is kept in the memory of the server that opened it, so the requests must reach the same server replica.
+	FetchNext(context.Context, *FetchNextRequest) (*QueryResponse, error)
+	// CloseQuerySession closes the query session opened by Query with page_size, e.g. when the user closes the tab.
+	CloseQuerySession(context.Context, *CloseQuerySessionRequest) (*emptypb.Empty, error)
+	// FederatedQuery joins and aggregates the tables across instances and databases in an in-process engine.
+	FederatedQuery(context.Context, *FederatedQueryRequest) (*QueryResponse, error)
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
 	AdminExecute(SQLService_AdminExecuteServer) error
 	SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error)
+	AggregateQueryHistories(context.Context, *AggregateQueryHistoriesRequest) (*AggregateQueryHistoriesResponse, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
@@ -214,6 +275,15 @@ type UnimplementedSQLServiceServer struct {
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) FetchNext(context.Context, *FetchNextRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchNext not implemented")
+}
+func (UnimplementedSQLServiceServer) CloseQuerySession(context.Context, *CloseQuerySessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseQuerySession not implemented")
+}
+func (UnimplementedSQLServiceServer) FederatedQuery(context.Context, *FederatedQueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederatedQuery not implemented")
+}
 func (UnimplementedSQLServiceServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
@@ -223,6 +293,9 @@ func (UnimplementedSQLServiceServer) AdminExecute(SQLService_AdminExecuteServer)
 func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchQueryHistories not implemented")
 }
+func (UnimplementedSQLServiceServer) AggregateQueryHistories(context.Context, *AggregateQueryHistoriesRequest) (*AggregateQueryHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateQueryHistories not implemented")
+}
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
@@ -275,6 +348,60 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_FetchNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchNextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).FetchNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_FetchNext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).FetchNext(ctx, req.(*FetchNextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CloseQuerySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseQuerySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CloseQuerySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CloseQuerySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CloseQuerySession(ctx, req.(*CloseQuerySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_FederatedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FederatedQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).FederatedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_FederatedQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).FederatedQuery(ctx, req.(*FederatedQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +464,24 @@ func _SQLService_SearchQueryHistories_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_AggregateQueryHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateQueryHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).AggregateQueryHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_AggregateQueryHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).AggregateQueryHistories(ctx, req.(*AggregateQueryHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportRequest)
 	if err := dec(in); err != nil {
@@ -474,6 +619,18 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "FetchNext",
+			Handler:    _SQLService_FetchNext_Handler,
+		},
+		{
+			MethodName: "CloseQuerySession",
+			Handler:    _SQLService_CloseQuerySession_Handler,
+		},
+		{
+			MethodName: "FederatedQuery",
+			Handler:    _SQLService_FederatedQuery_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _SQLService_Execute_Handler,
@@ -482,6 +639,10 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchQueryHistories",
 			Handler:    _SQLService_SearchQueryHistories_Handler,
 		},
+		{
+			MethodName: "AggregateQueryHistories",
+			Handler:    _SQLService_AggregateQueryHistories_Handler,
+		},
 		{
 			MethodName: "Export",
 			Handler:    _SQLService_Export_Handler,